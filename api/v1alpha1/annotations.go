@@ -0,0 +1,43 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ForceUpdateAnnotation lists, comma-separated, the immutable spec fields
+// (e.g. "spec.nodeType") that the next update is allowed to change. Changing
+// such a field is unsafe for existing data directories; the operator removes
+// the annotation once the change has been applied.
+const ForceUpdateAnnotation = "optimism.io/force-update"
+
+// ForceUpdateAllowed reports whether the force-update annotation of obj names fieldPath
+func ForceUpdateAllowed(obj metav1.Object, fieldPath string) bool {
+	value, ok := obj.GetAnnotations()[ForceUpdateAnnotation]
+	if !ok {
+		return false
+	}
+	for _, forced := range strings.Split(value, ",") {
+		if strings.TrimSpace(forced) == fieldPath {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "slices"
+
+// DefaultL1EndpointName names the endpoint given by l1RpcUrl
+const DefaultL1EndpointName = "default"
+
+// L1RpcEndpoints returns every L1 RPC endpoint of a network: l1RpcUrl or l1RpcUrlSecretRef,
+// if set, followed by the listed endpoints, ordered by priority
+func L1RpcEndpoints(network *OptimismNetwork) []L1RpcEndpoint {
+	var endpoints []L1RpcEndpoint
+	if network.Spec.L1RpcUrl != "" || network.Spec.L1RpcUrlSecretRef != nil {
+		endpoints = append(endpoints, L1RpcEndpoint{
+			Name:         DefaultL1EndpointName,
+			URL:          network.Spec.L1RpcUrl,
+			URLSecretRef: network.Spec.L1RpcUrlSecretRef,
+		})
+	}
+	endpoints = append(endpoints, network.Spec.L1RpcEndpoints...)
+	slices.SortStableFunc(endpoints, func(a, b L1RpcEndpoint) int {
+		return int(a.Priority) - int(b.Priority)
+	})
+	return endpoints
+}
+
+// ActiveL1RpcEndpoint returns the L1 RPC endpoint the components of a network use: the one
+// selected by the operator, or the preferred one until a selection has been made
+func ActiveL1RpcEndpoint(network *OptimismNetwork) L1RpcEndpoint {
+	endpoints := L1RpcEndpoints(network)
+	if i := slices.IndexFunc(endpoints, func(e L1RpcEndpoint) bool {
+		return e.Name == network.Status.ActiveL1Endpoint
+	}); i >= 0 {
+		return endpoints[i]
+	}
+	if len(endpoints) == 0 {
+		return L1RpcEndpoint{}
+	}
+	return endpoints[0]
+}
+
+// HasL1Beacon reports whether a network configures an L1 beacon endpoint
+func HasL1Beacon(network *OptimismNetwork) bool {
+	return network.Spec.L1BeaconUrl != "" || network.Spec.L1BeaconUrlSecretRef != nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -25,21 +26,131 @@ import (
 
 // OpBatcherSpec defines the desired state of OpBatcher.
 type OpBatcherSpec struct {
-	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// OptimismNetworkRef references the OptimismNetwork for this batcher
+	OptimismNetworkRef OptimismNetworkRef `json:"optimismNetworkRef"`
 
-	// Foo is an example field of OpBatcher. Edit opbatcher_types.go to remove/update
-	Foo string `json:"foo,omitempty"`
+	// SequencerRef references the sequencer OpNode whose L2 blocks are batched
+	SequencerRef SequencerReference `json:"sequencerRef"`
+
+	// PrivateKey references the secret holding the L1 transaction signing key
+	PrivateKey SecretKeyRef `json:"privateKey"`
+
+	// Batching configuration
+	Batching *BatchingConfig `json:"batching,omitempty"`
+
+	// Data availability configuration
+	DataAvailability *DataAvailabilityConfig `json:"dataAvailability,omitempty"`
+
+	// Throttling configuration
+	Throttling *ThrottlingConfig `json:"throttling,omitempty"`
+
+	// L1 transaction management
+	L1Transaction *L1TransactionConfig `json:"l1Transaction,omitempty"`
+
+	// RPC configuration
+	RPC *RPCConfig `json:"rpc,omitempty"`
+
+	// Metrics configuration
+	Metrics *MetricsConfig `json:"metrics,omitempty"`
+
+	// Resources defines resource requirements for the op-batcher container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Service configuration
+	Service *ServiceConfig `json:"service,omitempty"`
+}
+
+// BatchingConfig defines channel and batch submission settings
+type BatchingConfig struct {
+	// MaxChannelDuration is the maximum number of L1 blocks to keep a channel open (0 disables)
+	MaxChannelDuration int32 `json:"maxChannelDuration,omitempty"`
+
+	// SubSafetyMargin is the number of L1 blocks subtracted from the channel timeout
+	SubSafetyMargin int32 `json:"subSafetyMargin,omitempty"`
+
+	// TargetNumFrames is the target number of frames (or blobs) per L1 transaction
+	TargetNumFrames int32 `json:"targetNumFrames,omitempty"`
+
+	// PollInterval is how often the L2 sequencer is polled for new blocks
+	PollInterval string `json:"pollInterval,omitempty"`
+}
+
+// DataAvailabilityConfig defines how batch data is posted to L1
+type DataAvailabilityConfig struct {
+	// Type selects calldata or EIP-4844 blob transactions
+	// +kubebuilder:validation:Enum=calldata;blobs;auto
+	Type string `json:"type,omitempty"`
+}
+
+// ThrottlingConfig defines op-batcher data availability throttling
+type ThrottlingConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Threshold is the pending bytes backlog at which throttling starts
+	Threshold int64 `json:"threshold,omitempty"`
+
+	// TxSize is the maximum transaction size the sequencer accepts while throttling
+	TxSize int64 `json:"txSize,omitempty"`
+
+	// BlockSize is the maximum block size the sequencer builds while throttling
+	BlockSize int64 `json:"blockSize,omitempty"`
+}
+
+// L1TransactionConfig defines L1 transaction manager settings
+type L1TransactionConfig struct {
+	FeeLimitMultiplier        int32  `json:"feeLimitMultiplier,omitempty"`
+	ResubmissionTimeout       string `json:"resubmissionTimeout,omitempty"`
+	NumConfirmations          int32  `json:"numConfirmations,omitempty"`
+	SafeAbortNonceTooLowCount int32  `json:"safeAbortNonceTooLowCount,omitempty"`
+
+	// MaxPendingTx is the maximum number of in-flight transactions (0 means no limit)
+	MaxPendingTx int32 `json:"maxPendingTx,omitempty"`
 }
 
 // OpBatcherStatus defines the observed state of OpBatcher.
 type OpBatcherStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// Phase represents the overall state of the OpBatcher
+	Phase string `json:"phase,omitempty"` // Pending, Running, Error, Stopped
+
+	// Conditions represent detailed status conditions
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed spec
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// BatcherInfo contains operational information about the batcher
+	BatcherInfo *BatcherInfo `json:"batcherInfo,omitempty"`
+}
+
+// BatcherInfo contains operational information about the running batcher
+type BatcherInfo struct {
+	// BatcherAddress is the L1 address derived from the signing key
+	BatcherAddress string `json:"batcherAddress,omitempty"`
+
+	// LastBatchSubmitted is the most recent batch transaction seen on L1
+	LastBatchSubmitted *BatchSubmissionInfo `json:"lastBatchSubmitted,omitempty"`
+
+	// PendingChannels is the number of channels op-batcher holds in memory
+	PendingChannels int32 `json:"pendingChannels,omitempty"`
+
+	// LastScannedL1Block is the highest L1 block searched for batch transactions
+	LastScannedL1Block int64 `json:"lastScannedL1Block,omitempty"`
+}
+
+// BatchSubmissionInfo describes a batch transaction submitted to L1
+type BatchSubmissionInfo struct {
+	BlockNumber     int64       `json:"blockNumber,omitempty"`
+	TransactionHash string      `json:"transactionHash,omitempty"`
+	Timestamp       metav1.Time `json:"timestamp,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=`.spec.optimismNetworkRef.name`
+// +kubebuilder:printcolumn:name="Sequencer",type=string,JSONPath=`.spec.sequencerRef.name`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Pending",type=integer,JSONPath=`.status.batcherInfo.pendingChannels`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // OpBatcher is the Schema for the opbatchers API.
 type OpBatcher struct {
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -25,21 +26,108 @@ import (
 
 // OpChallengerSpec defines the desired state of OpChallenger.
 type OpChallengerSpec struct {
-	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// OptimismNetworkRef references the OptimismNetwork for this challenger
+	OptimismNetworkRef OptimismNetworkRef `json:"optimismNetworkRef"`
 
-	// Foo is an example field of OpChallenger. Edit opchallenger_types.go to remove/update
-	Foo string `json:"foo,omitempty"`
+	// RollupRPCRef references the OpNode providing the op-node and op-geth RPCs
+	RollupRPCRef OpNodeReference `json:"rollupRpcRef"`
+
+	// PrivateKey references the secret holding the L1 transaction signing key
+	PrivateKey SecretKeyRef `json:"privateKey"`
+
+	// TraceTypes lists the dispute game trace types the challenger participates in
+	// +kubebuilder:validation:MinItems=1
+	TraceTypes []TraceType `json:"traceTypes"`
+
+	// Cannon configures the cannon VM, used by the cannon and permissioned trace types
+	Cannon *VMTraceConfig `json:"cannon,omitempty"`
+
+	// Asterisc configures the asterisc VM, used by the asterisc trace type
+	Asterisc *VMTraceConfig `json:"asterisc,omitempty"`
+
+	// MaxConcurrency limits the number of games processed concurrently
+	MaxConcurrency int32 `json:"maxConcurrency,omitempty"`
+
+	// Storage for the game data directory
+	Storage *StorageConfig `json:"storage,omitempty"`
+
+	// L1 transaction management
+	L1Transaction *L1TransactionConfig `json:"l1Transaction,omitempty"`
+
+	// Metrics configuration
+	Metrics *MetricsConfig `json:"metrics,omitempty"`
+
+	// Resources defines resource requirements for the op-challenger container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// TraceType is a dispute game trace type supported by op-challenger
+// +kubebuilder:validation:Enum=cannon;asterisc;permissioned
+type TraceType string
+
+const (
+	TraceTypeCannon       TraceType = "cannon"
+	TraceTypeAsterisc     TraceType = "asterisc"
+	TraceTypePermissioned TraceType = "permissioned"
+)
+
+// VMTraceConfig defines the fault proof VM used to generate traces
+type VMTraceConfig struct {
+	// Prestate defines where the absolute prestate is loaded from
+	Prestate *PrestateSource `json:"prestate,omitempty"`
+
+	// BinaryPath is the path to the VM binary inside the image
+	BinaryPath string `json:"binaryPath,omitempty"`
+
+	// ServerPath is the path to the fault proof program inside the image
+	ServerPath string `json:"serverPath,omitempty"`
+}
+
+// PrestateSource defines how the absolute prestate is provided
+type PrestateSource struct {
+	// ConfigMapRef selects a ConfigMap key holding the prestate file
+	ConfigMapRef *corev1.ConfigMapKeySelector `json:"configMapRef,omitempty"`
+
+	// URL is the base URL prestates are downloaded from, keyed by prestate hash
+	URL string `json:"url,omitempty"`
 }
 
 // OpChallengerStatus defines the observed state of OpChallenger.
 type OpChallengerStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// Phase represents the overall state of the OpChallenger
+	Phase string `json:"phase,omitempty"` // Pending, Running, Error, Stopped
+
+	// Conditions represent detailed status conditions
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed spec
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ChallengerInfo contains operational information about the challenger
+	ChallengerInfo *ChallengerInfo `json:"challengerInfo,omitempty"`
+}
+
+// ChallengerInfo contains operational information about the running challenger
+type ChallengerInfo struct {
+	// ChallengerAddress is the L1 address derived from the signing key
+	ChallengerAddress string `json:"challengerAddress,omitempty"`
+
+	// GameFactoryAddress is the DisputeGameFactory being monitored
+	GameFactoryAddress string `json:"gameFactoryAddress,omitempty"`
+
+	// InProgressGames is the number of tracked games that have not resolved
+	InProgressGames int32 `json:"inProgressGames"`
+
+	// LastUpdated is when the game count was last refreshed
+	LastUpdated metav1.Time `json:"lastUpdated,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=`.spec.optimismNetworkRef.name`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Games",type=integer,JSONPath=`.status.challengerInfo.inProgressGames`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // OpChallenger is the Schema for the opchallengers API.
 type OpChallenger struct {
@@ -27,14 +27,21 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // OpNodeSpec defines the desired state of OpNode
+// +kubebuilder:validation:XValidation:rule="self.nodeType != 'sequencer' || !has(self.replicas) || self.replicas == 1",message="sequencer nodes run a single replica"
 type OpNodeSpec struct {
 	// OptimismNetworkRef references the OptimismNetwork for this node
 	OptimismNetworkRef OptimismNetworkRef `json:"optimismNetworkRef"`
 
-	// NodeType specifies whether this is a sequencer or replica node
+	// NodeType specifies whether this is a sequencer or replica node.
+	// Immutable unless forced with the optimism.io/force-update annotation.
 	// +kubebuilder:validation:Enum=sequencer;replica
 	NodeType string `json:"nodeType"`
 
+	// Replicas is the number of pods for replica nodes. Each pod gets its own data
+	// volume and P2P key. Sequencer nodes always run a single pod.
+	// +kubebuilder:validation:Minimum=0
+	Replicas *int32 `json:"replicas,omitempty"`
+
 	// SequencerRef references the sequencer OpNode (only for replica nodes)
 	// This field is optional when L2RpcUrl is set for external sequencer connections
 	SequencerRef *SequencerReference `json:"sequencerRef,omitempty"`
@@ -53,8 +60,68 @@ type OpNodeSpec struct {
 	// Resources defines resource requirements for the components
 	Resources *OpNodeResources `json:"resources,omitempty"`
 
+	// PodBandwidthLimit caps the ingress and egress bandwidth of each pod, e.g. "100M". It is
+	// enforced by the CNI bandwidth plugin and applies to all traffic of the pod, op-node P2P as
+	// well as op-geth and op-node RPC; op-node has no setting that limits P2P traffic alone.
+	PodBandwidthLimit string `json:"podBandwidthLimit,omitempty"`
+
 	// Service configuration
 	Service *ServiceConfig `json:"service,omitempty"`
+
+	// Images overrides the network-level images for this node
+	Images *ImageOverrides `json:"images,omitempty"`
+
+	// UpgradeStrategy controls how image changes are rolled out
+	UpgradeStrategy *UpgradeStrategy `json:"upgradeStrategy,omitempty"`
+
+	// HA runs a sequencer as an op-conductor Raft cluster (sequencer nodes only)
+	HA *SequencerHAConfig `json:"ha,omitempty"`
+
+	// Backup periodically snapshots the op-geth data volume
+	Backup *BackupConfig `json:"backup,omitempty"`
+
+	// ExtraVolumes are added to the pod for the extraVolumeMounts of the containers and sidecars
+	ExtraVolumes []corev1.Volume `json:"extraVolumes,omitempty"`
+
+	// Sidecars are additional containers run in every pod
+	Sidecars []corev1.Container `json:"sidecars,omitempty"`
+
+	// PodTemplate overrides scheduling settings of the pods
+	PodTemplate *PodTemplateOverrides `json:"podTemplate,omitempty"`
+}
+
+// SequencerHAConfig defines the op-conductor cluster of a highly available sequencer.
+// Each member pod runs an op-conductor sidecar; only the Raft leader sequences.
+type SequencerHAConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Members is the number of sequencer pods in the Raft cluster
+	// +kubebuilder:validation:Minimum=1
+	Members int32 `json:"members,omitempty"`
+
+	// RaftPort is the op-conductor consensus port
+	RaftPort int32 `json:"raftPort,omitempty"`
+
+	// RPCPort is the op-conductor RPC port
+	RPCPort int32 `json:"rpcPort,omitempty"`
+
+	// HealthCheck configures how op-conductor judges the health of its sequencer
+	HealthCheck *ConductorHealthCheck `json:"healthCheck,omitempty"`
+}
+
+// ConductorHealthCheck defines the op-conductor sequencer health check
+type ConductorHealthCheck struct {
+	// Interval between health checks, in seconds
+	Interval int32 `json:"interval,omitempty"`
+
+	// UnsafeInterval is the maximum age of the unsafe head, in seconds
+	UnsafeInterval int32 `json:"unsafeInterval,omitempty"`
+
+	// SafeInterval is the maximum age of the safe head, in seconds
+	SafeInterval int32 `json:"safeInterval,omitempty"`
+
+	// MinPeerCount is the minimum number of P2P peers of a healthy sequencer
+	MinPeerCount int32 `json:"minPeerCount,omitempty"`
 }
 
 // OptimismNetworkRef references an OptimismNetwork resource
@@ -74,7 +141,11 @@ type SequencerReference struct {
 // OpNodeConfig defines op-node specific configuration
 type OpNodeConfig struct {
 	// Sync configuration
-	SyncMode string `json:"syncMode,omitempty"` // execution-layer, consensus-layer
+	// +kubebuilder:validation:Enum=consensus-layer;execution-layer
+	SyncMode string `json:"syncMode,omitempty"`
+
+	// L1 configures the L1 RPC client
+	L1 *L1ClientConfig `json:"l1,omitempty"`
 
 	// P2P configuration
 	P2P *P2PConfig `json:"p2p,omitempty"`
@@ -87,6 +158,9 @@ type OpNodeConfig struct {
 
 	// Engine API configuration (communication with op-geth)
 	Engine *EngineConfig `json:"engine,omitempty"`
+
+	// Extra arguments, environment and volume mounts of the op-node container
+	ContainerOverrides `json:",inline"`
 }
 
 // P2PConfig defines P2P networking configuration
@@ -103,9 +177,6 @@ type P2PConfig struct {
 	// Peer scoring
 	PeerScoring *P2PScoringConfig `json:"peerScoring,omitempty"`
 
-	// Bandwidth limit
-	BandwidthLimit string `json:"bandwidthLimit,omitempty"`
-
 	// P2P private key management
 	PrivateKey *SecretKeyRef `json:"privateKey,omitempty"`
 }
@@ -118,9 +189,20 @@ type P2PDiscoveryConfig struct {
 
 // P2PScoringConfig defines P2P peer scoring settings
 type P2PScoringConfig struct {
+	// Enabled selects light peer scoring; disabled turns scoring off
 	Enabled bool `json:"enabled,omitempty"`
 }
 
+// L1ClientConfig defines how op-node queries the L1 RPC
+type L1ClientConfig struct {
+	// RPCKind optimizes receipt fetching for the L1 RPC provider
+	// +kubebuilder:validation:Enum=alchemy;quicknode;infura;parity;nethermind;debug_geth;erigon;basic;any;standard
+	RPCKind string `json:"rpcKind,omitempty"`
+
+	// TrustRPC skips verifying L1 data against block hashes; only for RPCs under your control
+	TrustRPC bool `json:"trustRPC,omitempty"`
+}
+
 // RPCConfig defines RPC server configuration
 type RPCConfig struct {
 	Enabled     bool        `json:"enabled,omitempty"`
@@ -138,15 +220,22 @@ type CORSConfig struct {
 
 // SequencerConfig defines sequencer-specific settings
 type SequencerConfig struct {
-	Enabled       bool   `json:"enabled,omitempty"`
-	BlockTime     string `json:"blockTime,omitempty"`
-	MaxTxPerBlock int32  `json:"maxTxPerBlock,omitempty"`
+	Enabled   bool   `json:"enabled,omitempty"`
+	BlockTime string `json:"blockTime,omitempty"`
+
+	// MaxTxPerBlock has no op-node or op-geth setting and is ignored
+	// +kubebuilder:validation:Minimum=0
+	MaxTxPerBlock int32 `json:"maxTxPerBlock,omitempty"`
 }
 
 // EngineConfig defines Engine API configuration
 type EngineConfig struct {
+	// JWTSecret shares the Engine API secret; by default the operator generates one
 	JWTSecret *SecretKeyRef `json:"jwtSecret,omitempty"`
-	Endpoint  string        `json:"endpoint,omitempty"`
+
+	// Endpoint of an external execution engine's authenticated RPC. op-node drives it
+	// instead of a local op-geth, which is then not deployed.
+	Endpoint string `json:"endpoint,omitempty"`
 }
 
 // SecretKeyRef references a secret for key material
@@ -161,17 +250,23 @@ type OpGethConfig struct {
 	Network string `json:"network,omitempty"`
 
 	// Data directory and storage
-	DataDir string         `json:"dataDir,omitempty"`
-	Storage *StorageConfig `json:"storage,omitempty"`
+	DataDir string             `json:"dataDir,omitempty"`
+	Storage *GethStorageConfig `json:"storage,omitempty"`
 
 	// Sync configuration
-	SyncMode    string `json:"syncMode,omitempty"`    // snap, full
-	GCMode      string `json:"gcMode,omitempty"`      // full, archive
-	StateScheme string `json:"stateScheme,omitempty"` // path, hash
+	// +kubebuilder:validation:Enum=snap;full
+	SyncMode string `json:"syncMode,omitempty"`
+	// +kubebuilder:validation:Enum=full;archive
+	GCMode string `json:"gcMode,omitempty"`
+	// StateScheme defaults to hash for archive nodes, which do not support the path scheme
+	// +kubebuilder:validation:Enum=path;hash
+	StateScheme string `json:"stateScheme,omitempty"`
 
 	// Database configuration
-	Cache    int32  `json:"cache,omitempty"`    // Cache size in MB
-	DBEngine string `json:"dbEngine,omitempty"` // pebble, leveldb
+	// +kubebuilder:validation:Minimum=0
+	Cache int32 `json:"cache,omitempty"` // Cache size in MB
+	// +kubebuilder:validation:Enum=pebble;leveldb
+	DBEngine string `json:"dbEngine,omitempty"`
 
 	// Networking configuration
 	Networking *GethNetworkingConfig `json:"networking,omitempty"`
@@ -181,6 +276,33 @@ type OpGethConfig struct {
 
 	// Rollup-specific configuration
 	Rollup *RollupConfig `json:"rollup,omitempty"`
+
+	// Extra arguments, environment and volume mounts of the op-geth container
+	ContainerOverrides `json:",inline"`
+}
+
+// ContainerOverrides extend an operator-managed container with settings the operator does
+// not model. Entries that collide with operator-managed flags, variables or mount paths are
+// ignored and reported in the OverridesApplied condition.
+type ContainerOverrides struct {
+	// ExtraArgs are appended to the container arguments, e.g. "--log.level=debug"
+	ExtraArgs []string `json:"extraArgs,omitempty"`
+
+	// ExtraEnv are appended to the container environment
+	ExtraEnv []corev1.EnvVar `json:"extraEnv,omitempty"`
+
+	// ExtraVolumeMounts mount pod volumes, typically from spec.extraVolumes, into the container
+	ExtraVolumeMounts []corev1.VolumeMount `json:"extraVolumeMounts,omitempty"`
+}
+
+// PodTemplateOverrides are strategically merged into the operator-generated pod spec
+type PodTemplateOverrides struct {
+	NodeSelector map[string]string   `json:"nodeSelector,omitempty"`
+	Tolerations  []corev1.Toleration `json:"tolerations,omitempty"`
+	Affinity     *corev1.Affinity    `json:"affinity,omitempty"`
+
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 // StorageConfig defines persistent storage settings
@@ -190,6 +312,53 @@ type StorageConfig struct {
 	AccessMode   string            `json:"accessMode,omitempty"`
 }
 
+// GethStorageConfig defines the op-geth data volume
+type GethStorageConfig struct {
+	StorageConfig `json:",inline"`
+
+	// Snapshot seeds new data volumes instead of syncing from genesis.
+	// Volumes that already hold chain data are left untouched.
+	Snapshot *SnapshotSource `json:"snapshot,omitempty"`
+
+	// AutoGrow expands data volumes as they fill up
+	AutoGrow *StorageAutoGrowConfig `json:"autoGrow,omitempty"`
+}
+
+// StorageAutoGrowConfig expands op-geth data volumes when their usage, as reported by the
+// kubelet, passes a threshold. The StorageClass must allow volume expansion.
+type StorageAutoGrowConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// ThresholdPercent is the volume usage that triggers an expansion (default 80)
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=99
+	ThresholdPercent int32 `json:"thresholdPercent,omitempty"`
+
+	// IncreasePercent is how much an expansion grows the volume (default 20)
+	// +kubebuilder:validation:Minimum=1
+	IncreasePercent int32 `json:"increasePercent,omitempty"`
+
+	// MaxSize caps automatic expansions
+	MaxSize resource.Quantity `json:"maxSize"`
+}
+
+// SnapshotSource is the initial content of an op-geth data volume. Exactly one source is set.
+// +kubebuilder:validation:XValidation:rule="[has(self.url), has(self.volumeSnapshotName), has(self.persistentVolumeClaimName)].filter(x, x).size() == 1",message="exactly one of url, volumeSnapshotName or persistentVolumeClaimName must be set"
+type SnapshotSource struct {
+	// URL of a tar archive of the datadir, streamed and extracted by an init container.
+	// A .gz/.tgz, .bz2/.tbz2 or .xz/.txz suffix selects gzip, bzip2 or xz decompression.
+	URL string `json:"url,omitempty"`
+
+	// SHA256 is the hex-encoded checksum the downloaded archive must match
+	SHA256 string `json:"sha256,omitempty"`
+
+	// VolumeSnapshotName provisions the volume from a CSI VolumeSnapshot in the OpNode namespace
+	VolumeSnapshotName string `json:"volumeSnapshotName,omitempty"`
+
+	// PersistentVolumeClaimName provisions the volume as a clone of a PVC in the OpNode namespace
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName,omitempty"`
+}
+
 // GethNetworkingConfig defines geth networking settings
 type GethNetworkingConfig struct {
 	HTTP    *HTTPConfig    `json:"http,omitempty"`
@@ -275,6 +444,36 @@ type ServicePortConfig struct {
 	Protocol   corev1.Protocol    `json:"protocol,omitempty"`
 }
 
+// UpgradeStrategy gates image changes on node health. Replica nodes are upgraded
+// before the sequencers of the same network.
+type UpgradeStrategy struct {
+	// Paused holds pending image changes until it is cleared
+	Paused bool `json:"paused,omitempty"`
+
+	// HealthTimeout is how long an upgraded node may take to report synced with a
+	// fresh unsafe head before the upgrade is considered failed (default 15m)
+	HealthTimeout string `json:"healthTimeout,omitempty"`
+
+	// AutoRollback restores the previous images when an upgrade fails its health gate
+	AutoRollback bool `json:"autoRollback,omitempty"`
+}
+
+// BackupConfig schedules CSI VolumeSnapshots of the op-geth data volume of the first pod.
+// Snapshots are crash-consistent; op-geth recovers its last persisted state on restore.
+type BackupConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Interval between snapshots (default 24h)
+	Interval string `json:"interval,omitempty"`
+
+	// Retention is the number of snapshots to keep (default 7)
+	// +kubebuilder:validation:Minimum=1
+	Retention int32 `json:"retention,omitempty"`
+
+	// VolumeSnapshotClassName selects the VolumeSnapshotClass; the cluster default is used when empty
+	VolumeSnapshotClassName string `json:"volumeSnapshotClassName,omitempty"`
+}
+
 // OpNodeStatus defines the observed state of OpNode
 type OpNodeStatus struct {
 	// Phase represents the overall state of the OpNode
@@ -288,6 +487,96 @@ type OpNodeStatus struct {
 
 	// NodeInfo contains operational information about the node
 	NodeInfo *NodeInfo `json:"nodeInfo,omitempty"`
+
+	// Replicas is the number of pods created by the StatefulSet
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// ReadyReplicas is the number of pods that are ready
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// Selector is the label selector of the pods, for the scale subresource
+	Selector string `json:"selector,omitempty"`
+
+	// Conductor reports the op-conductor Raft cluster of an HA sequencer
+	Conductor *ConductorStatus `json:"conductor,omitempty"`
+
+	// Upgrade tracks the rollout of image changes
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+
+	// Backups lists the retained VolumeSnapshots, newest first
+	Backups []BackupSnapshot `json:"backups,omitempty"`
+
+	// Volumes reports the size and usage of the op-geth data volumes
+	Volumes []VolumeStatus `json:"volumes,omitempty"`
+}
+
+// VolumeStatus reports an op-geth data volume
+type VolumeStatus struct {
+	ClaimName string `json:"claimName"`
+
+	// Requested is the size requested by the PVC; Capacity is the provisioned size,
+	// which trails Requested while an expansion is in progress
+	Requested resource.Quantity `json:"requested,omitempty"`
+	Capacity  resource.Quantity `json:"capacity,omitempty"`
+
+	// UsedPercent is the share of the volume in use; zero when unknown
+	UsedPercent int32 `json:"usedPercent,omitempty"`
+}
+
+// BackupSnapshot records a VolumeSnapshot of the op-geth data volume
+type BackupSnapshot struct {
+	Name      string      `json:"name"`
+	CreatedAt metav1.Time `json:"createdAt"`
+
+	// BlockNumber and SafeBlockNumber are the unsafe and safe L2 heads op-node reported right
+	// after the snapshot was cut. The node keeps running during the cut, so they are upper bounds
+	// of the heads a restore recovers. Zero until they could be polled.
+	BlockNumber     int64 `json:"blockNumber,omitempty"`
+	SafeBlockNumber int64 `json:"safeBlockNumber,omitempty"`
+
+	// ReadyToUse is set once the CSI driver has cut the snapshot
+	ReadyToUse bool `json:"readyToUse,omitempty"`
+}
+
+// NodeImages are the images of the op-geth and op-node containers
+type NodeImages struct {
+	OpGeth string `json:"opGeth"`
+	OpNode string `json:"opNode"`
+}
+
+// ConductorStatus describes the op-conductor Raft cluster
+type ConductorStatus struct {
+	// Leader is the server ID (pod name) of the Raft leader
+	Leader string `json:"leader,omitempty"`
+
+	// Members are the servers of the Raft configuration
+	Members []RaftMember `json:"members,omitempty"`
+
+	// BootstrappedBy is the member the controller let bootstrap the Raft cluster. It is set
+	// once, so no other member with empty Raft storage bootstraps a second cluster.
+	BootstrappedBy string `json:"bootstrappedBy,omitempty"`
+}
+
+// RaftMember is a server of the op-conductor Raft configuration
+type RaftMember struct {
+	ID       string `json:"id"`
+	Address  string `json:"address"`
+	Suffrage string `json:"suffrage,omitempty"` // Voter, Nonvoter
+}
+
+// UpgradeStatus records the progress of an image upgrade
+type UpgradeStatus struct {
+	// Phase of the upgrade
+	Phase string `json:"phase,omitempty"` // Waiting, Progressing, Succeeded, Failed, RolledBack
+
+	// CurrentImages are the images the node last ran successfully
+	CurrentImages *NodeImages `json:"currentImages,omitempty"`
+
+	// TargetImages are the images being rolled out
+	TargetImages *NodeImages `json:"targetImages,omitempty"`
+
+	// StartedAt is when the target images were applied to the StatefulSet
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
 }
 
 // NodeInfo contains operational information about the running node
@@ -317,13 +606,24 @@ type SyncStatusInfo struct {
 	CurrentBlock int64 `json:"currentBlock,omitempty"`
 	HighestBlock int64 `json:"highestBlock,omitempty"`
 	Syncing      bool  `json:"syncing,omitempty"`
+
+	// SafeBlock is the latest L2 block derived from data posted to L1
+	SafeBlock int64 `json:"safeBlock,omitempty"`
+	// FinalizedBlock is the latest L2 block derived from finalized L1 data
+	FinalizedBlock int64 `json:"finalizedBlock,omitempty"`
+	// CurrentL1Block is the L1 block the derivation pipeline has processed up to
+	CurrentL1Block int64 `json:"currentL1Block,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.replicas,selectorpath=.status.selector
 // +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.nodeType`
 // +kubebuilder:printcolumn:name="Network",type=string,JSONPath=`.spec.optimismNetworkRef.name`
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.readyReplicas`
+// +kubebuilder:printcolumn:name="Leader",type=string,JSONPath=`.status.conductor.leader`,priority=1
+// +kubebuilder:printcolumn:name="Head",type=integer,JSONPath=`.status.nodeInfo.chainHead.blockNumber`
 // +kubebuilder:printcolumn:name="Peers",type=integer,JSONPath=`.status.nodeInfo.peerCount`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
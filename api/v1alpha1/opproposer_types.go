@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -25,21 +26,93 @@ import (
 
 // OpProposerSpec defines the desired state of OpProposer.
 type OpProposerSpec struct {
-	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// OptimismNetworkRef references the OptimismNetwork for this proposer
+	OptimismNetworkRef OptimismNetworkRef `json:"optimismNetworkRef"`
 
-	// Foo is an example field of OpProposer. Edit opproposer_types.go to remove/update
-	Foo string `json:"foo,omitempty"`
+	// RollupRPCRef references the OpNode whose op-node RPC serves output roots
+	RollupRPCRef OpNodeReference `json:"rollupRpcRef"`
+
+	// PrivateKey references the secret holding the L1 transaction signing key
+	PrivateKey SecretKeyRef `json:"privateKey"`
+
+	// ProposalInterval is the interval between dispute game proposals (e.g. "1h")
+	ProposalInterval string `json:"proposalInterval,omitempty"`
+
+	// GameType is the dispute game type to create (0 = cannon, 1 = permissioned)
+	// +kubebuilder:validation:Minimum=0
+	GameType int32 `json:"gameType,omitempty"`
+
+	// PollInterval is how often the rollup node is polled for new output roots
+	PollInterval string `json:"pollInterval,omitempty"`
+
+	// AllowNonFinalized allows proposals of output roots that are not yet finalized on L1
+	AllowNonFinalized bool `json:"allowNonFinalized,omitempty"`
+
+	// L1 transaction management
+	L1Transaction *L1TransactionConfig `json:"l1Transaction,omitempty"`
+
+	// RPC configuration
+	RPC *RPCConfig `json:"rpc,omitempty"`
+
+	// Metrics configuration
+	Metrics *MetricsConfig `json:"metrics,omitempty"`
+
+	// Resources defines resource requirements for the op-proposer container
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// OpNodeReference defines a reference to an OpNode
+type OpNodeReference struct {
+	// Name of the OpNode
+	Name string `json:"name"`
+	// Namespace of the OpNode (optional, defaults to same namespace)
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // OpProposerStatus defines the observed state of OpProposer.
 type OpProposerStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+	// Phase represents the overall state of the OpProposer
+	Phase string `json:"phase,omitempty"` // Pending, Running, Error, Stopped
+
+	// Conditions represent detailed status conditions
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration reflects the generation of the most recently observed spec
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ProposerInfo contains operational information about the proposer
+	ProposerInfo *ProposerInfo `json:"proposerInfo,omitempty"`
+}
+
+// ProposerInfo contains operational information about the running proposer
+type ProposerInfo struct {
+	// ProposerAddress is the L1 address derived from the signing key
+	ProposerAddress string `json:"proposerAddress,omitempty"`
+
+	// OutputContract is the contract proposals are submitted to
+	OutputContract string `json:"outputContract,omitempty"`
+
+	// OutputContractType is either DisputeGameFactory or L2OutputOracle
+	OutputContractType string `json:"outputContractType,omitempty"`
+
+	// LastProposal is the most recent output root proposed on L1
+	LastProposal *OutputProposalInfo `json:"lastProposal,omitempty"`
+}
+
+// OutputProposalInfo describes an output root proposal on L1
+type OutputProposalInfo struct {
+	OutputRoot    string      `json:"outputRoot,omitempty"`
+	L2BlockNumber int64       `json:"l2BlockNumber,omitempty"`
+	GameAddress   string      `json:"gameAddress,omitempty"`
+	Timestamp     metav1.Time `json:"timestamp,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Network",type=string,JSONPath=`.spec.optimismNetworkRef.name`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="L2Block",type=integer,JSONPath=`.status.proposerInfo.lastProposal.l2BlockNumber`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // OpProposer is the Schema for the opproposers API.
 type OpProposer struct {
@@ -30,14 +30,36 @@ import (
 type OptimismNetworkSpec struct {
 	// Network Configuration
 	NetworkName string `json:"networkName,omitempty"`
-	ChainID     int64  `json:"chainID"`
-	L1ChainID   int64  `json:"l1ChainID"`
 
-	// L1 RPC Configuration (required by all components)
-	L1RpcUrl     string        `json:"l1RpcUrl"`
+	// ChainID is the L2 chain ID. Immutable unless forced with the
+	// optimism.io/force-update annotation.
+	ChainID int64 `json:"chainID"`
+
+	// L1ChainID is the chain ID of the settlement layer. Immutable unless forced
+	// with the optimism.io/force-update annotation.
+	L1ChainID int64 `json:"l1ChainID"`
+
+	// L1 RPC Configuration (required by all components). L1RpcUrl is the endpoint named
+	// "default" and may be omitted when L1RpcEndpoints are listed.
+	L1RpcUrl     string        `json:"l1RpcUrl,omitempty"`
 	L1BeaconUrl  string        `json:"l1BeaconUrl,omitempty"`
 	L1RpcTimeout time.Duration `json:"l1RpcTimeout,omitempty"`
 
+	// L1RpcUrlSecretRef reads the "default" endpoint from a Secret instead of l1RpcUrl, for
+	// URLs that embed provider API keys. Components receive it through an environment
+	// variable, so the Secret must exist in the namespace of every component as well.
+	L1RpcUrlSecretRef *corev1.SecretKeySelector `json:"l1RpcUrlSecretRef,omitempty"`
+
+	// L1BeaconUrlSecretRef reads the L1 beacon URL from a Secret instead of l1BeaconUrl
+	L1BeaconUrlSecretRef *corev1.SecretKeySelector `json:"l1BeaconUrlSecretRef,omitempty"`
+
+	// L1RpcEndpoints are further L1 RPC endpoints. The operator probes every endpoint and
+	// points the components at the healthiest one.
+	L1RpcEndpoints []L1RpcEndpoint `json:"l1RpcEndpoints,omitempty"`
+
+	// L1Failover tunes the probing of and switching between L1 RPC endpoints
+	L1Failover *L1FailoverConfig `json:"l1Failover,omitempty"`
+
 	// Network-specific Configuration Files
 	RollupConfig *ConfigSource `json:"rollupConfig,omitempty"`
 	L2Genesis    *ConfigSource `json:"l2Genesis,omitempty"`
@@ -45,8 +67,51 @@ type OptimismNetworkSpec struct {
 	// Contract Address Discovery
 	ContractAddresses *ContractAddressConfig `json:"contractAddresses,omitempty"`
 
+	// ChainParameters supply the rollup parameters that cannot be read from L1.
+	// Only used to generate rollup.json for chains outside the superchain registry.
+	// The genesis system config is read once, from L1 state at the SystemConfig start
+	// block, which the L1 RPC must be able to serve, and is kept afterwards.
+	ChainParameters *ChainParameters `json:"chainParameters,omitempty"`
+
 	// Shared Configuration
 	SharedConfig *SharedConfig `json:"sharedConfig,omitempty"`
+
+	// Images selects the component images for every resource on this network.
+	// OpNode resources may override them individually.
+	Images *ImageOverrides `json:"images,omitempty"`
+}
+
+// L1RpcEndpoint is an L1 RPC endpoint the components may use
+type L1RpcEndpoint struct {
+	// Name identifies the endpoint in status; "default" is taken by l1RpcUrl
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// URL is the HTTP or WebSocket URL of the endpoint
+	URL string `json:"url,omitempty"`
+
+	// URLSecretRef reads the URL from a Secret instead; exactly one of url and urlSecretRef is set
+	URLSecretRef *corev1.SecretKeySelector `json:"urlSecretRef,omitempty"`
+
+	// Priority orders healthy endpoints; lower values are preferred and endpoints of equal
+	// priority are ordered by latency. l1RpcUrl has priority 0.
+	// +kubebuilder:validation:Minimum=0
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// L1FailoverConfig tunes the L1 RPC endpoint selection
+type L1FailoverConfig struct {
+	// ProbeInterval is how often the endpoints are probed (default 30s)
+	ProbeInterval string `json:"probeInterval,omitempty"`
+
+	// MaxHeadLag is how many blocks an endpoint's head may trail the highest head among
+	// the endpoints before it counts as stale (default 3)
+	// +kubebuilder:validation:Minimum=0
+	MaxHeadLag *int64 `json:"maxHeadLag,omitempty"`
+
+	// HysteresisWindow is how long another endpoint must stay the best one before the
+	// components are switched to it, which rolls their pods (default 2m)
+	HysteresisWindow string `json:"hysteresisWindow,omitempty"`
 }
 
 // ConfigSource defines how configuration data is provided
@@ -65,10 +130,61 @@ type ContractAddressConfig struct {
 	OptimismPortalAddr     string `json:"optimismPortalAddr,omitempty"`
 
 	// Discovery configuration
-	DiscoveryMethod string        `json:"discoveryMethod,omitempty"` // auto, superchain-registry, well-known, manual
+	DiscoveryMethod string        `json:"discoveryMethod,omitempty"` // auto, system-config, superchain-registry, well-known, manual
 	CacheTimeout    time.Duration `json:"cacheTimeout,omitempty"`
 }
 
+// ChainParameters defines rollup parameters of an L2 chain
+type ChainParameters struct {
+	// BlockTime is the L2 block time in seconds (default 2)
+	// +kubebuilder:validation:Minimum=1
+	BlockTime int64 `json:"blockTime,omitempty"`
+
+	// MaxSequencerDrift is the maximum L2 timestamp drift from L1 origin in seconds (default 600)
+	// +kubebuilder:validation:Minimum=1
+	MaxSequencerDrift int64 `json:"maxSequencerDrift,omitempty"`
+
+	// SeqWindowSize is the sequencing window in L1 blocks (default 3600)
+	// +kubebuilder:validation:Minimum=2
+	SeqWindowSize int64 `json:"seqWindowSize,omitempty"`
+
+	// ChannelTimeout is the channel timeout in L1 blocks (default 300)
+	// +kubebuilder:validation:Minimum=1
+	ChannelTimeout int64 `json:"channelTimeout,omitempty"`
+
+	// L2GenesisHash is the hash of the L2 genesis block
+	// +kubebuilder:validation:Pattern=`^0x[0-9a-fA-F]{64}$`
+	L2GenesisHash string `json:"l2GenesisHash"`
+
+	// L2GenesisBlock is the number of the L2 genesis block (default 0)
+	// +kubebuilder:validation:Minimum=0
+	L2GenesisBlock int64 `json:"l2GenesisBlock,omitempty"`
+
+	// L2GenesisTime is the L2 genesis timestamp (defaults to the L1 start block timestamp)
+	// +kubebuilder:validation:Minimum=0
+	L2GenesisTime int64 `json:"l2GenesisTime,omitempty"`
+
+	// ProtocolVersionsAddr is the L1 ProtocolVersions contract, if deployed
+	ProtocolVersionsAddr string `json:"protocolVersionsAddr,omitempty"`
+
+	// Hardforks are the hardfork activation timestamps. Without any, every fork up to
+	// Holocene is active at genesis. Otherwise Regolith defaults to genesis, forks after
+	// the last scheduled one stay inactive, and every fork before a scheduled one must be set.
+	Hardforks *HardforkTimes `json:"hardforks,omitempty"`
+}
+
+// HardforkTimes defines L2 hardfork activation timestamps
+type HardforkTimes struct {
+	Regolith *int64 `json:"regolith,omitempty"`
+	Canyon   *int64 `json:"canyon,omitempty"`
+	Delta    *int64 `json:"delta,omitempty"`
+	Ecotone  *int64 `json:"ecotone,omitempty"`
+	Fjord    *int64 `json:"fjord,omitempty"`
+	Granite  *int64 `json:"granite,omitempty"`
+	Holocene *int64 `json:"holocene,omitempty"`
+	Isthmus  *int64 `json:"isthmus,omitempty"`
+}
+
 // SharedConfig defines configuration shared across all components
 type SharedConfig struct {
 	// Logging
@@ -84,6 +200,28 @@ type SharedConfig struct {
 	Security *SecurityConfig `json:"security,omitempty"`
 }
 
+// ImageOverrides selects component images. Overrides apply in order: the
+// version set, then the global version, then per-component images.
+type ImageOverrides struct {
+	// VersionSet names a set of compatible component versions (e.g. "stable-v1.13")
+	VersionSet string `json:"versionSet,omitempty"`
+
+	// GlobalVersion pins op-node, op-batcher and op-proposer to one release tag
+	GlobalVersion string `json:"globalVersion,omitempty"`
+
+	// Per-component images (full references including tag or digest)
+	OpNodeImage       string `json:"opNodeImage,omitempty"`
+	OpGethImage       string `json:"opGethImage,omitempty"`
+	OpBatcherImage    string `json:"opBatcherImage,omitempty"`
+	OpProposerImage   string `json:"opProposerImage,omitempty"`
+	OpChallengerImage string `json:"opChallengerImage,omitempty"`
+	OpConductorImage  string `json:"opConductorImage,omitempty"`
+
+	// PullPolicy for the component containers
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	PullPolicy corev1.PullPolicy `json:"pullPolicy,omitempty"`
+}
+
 // LoggingConfig defines logging configuration
 type LoggingConfig struct {
 	Level  string `json:"level,omitempty"`  // trace, debug, info, warn, error
@@ -125,6 +263,54 @@ type OptimismNetworkStatus struct {
 
 	// NetworkInfo contains discovered network information
 	NetworkInfo *NetworkInfo `json:"networkInfo,omitempty"`
+
+	// L1 reports the last probe of the active L1 RPC endpoint
+	L1 *L1Status `json:"l1,omitempty"`
+
+	// L1Endpoints reports the last probe of every L1 RPC endpoint
+	L1Endpoints []L1EndpointStatus `json:"l1Endpoints,omitempty"`
+
+	// ActiveL1Endpoint names the L1 RPC endpoint the components use
+	ActiveL1Endpoint string `json:"activeL1Endpoint,omitempty"`
+
+	// PendingL1Endpoint names the endpoint the components switch to once it has been the
+	// best one for the hysteresis window
+	PendingL1Endpoint string `json:"pendingL1Endpoint,omitempty"`
+
+	// PendingL1EndpointSince is when the pending endpoint became the best one
+	PendingL1EndpointSince *metav1.Time `json:"pendingL1EndpointSince,omitempty"`
+}
+
+// L1EndpointStatus reports the health of an L1 RPC endpoint
+type L1EndpointStatus struct {
+	Name string `json:"name"`
+
+	// Healthy is set when the endpoint is reachable, serves the L1 chain and its head is current
+	Healthy bool `json:"healthy"`
+
+	// LatencyMilliseconds is the round trip of the chain ID query
+	LatencyMilliseconds int64 `json:"latencyMilliseconds,omitempty"`
+
+	// HeadBlock is the latest block number reported by the endpoint
+	HeadBlock int64 `json:"headBlock,omitempty"`
+
+	// Message explains why the endpoint is unhealthy
+	Message string `json:"message,omitempty"`
+
+	LastChecked metav1.Time `json:"lastChecked,omitempty"`
+}
+
+// L1Status reports the reachability of the L1 RPC endpoint
+type L1Status struct {
+	Reachable bool `json:"reachable"`
+
+	// LatencyMilliseconds is the round trip of the chain ID query
+	LatencyMilliseconds int64 `json:"latencyMilliseconds,omitempty"`
+
+	// HeadBlock is the latest L1 block number
+	HeadBlock int64 `json:"headBlock,omitempty"`
+
+	LastChecked metav1.Time `json:"lastChecked,omitempty"`
 }
 
 // NetworkInfo contains discovered network information and contract addresses
@@ -134,6 +320,10 @@ type NetworkInfo struct {
 
 	// Discovered contract addresses (populated by controller)
 	DiscoveredContracts *NetworkContractAddresses `json:"discoveredContracts,omitempty"`
+
+	// OpGethNetwork is the op-geth --op-network that bundles the auto-discovered L2 genesis
+	// of a registered chain. OpNodes then run with it instead of initializing a genesis.
+	OpGethNetwork string `json:"opGethNetwork,omitempty"`
 }
 
 // NetworkContractAddresses contains all discovered contract addresses
@@ -145,6 +335,10 @@ type NetworkContractAddresses struct {
 	SystemConfigAddr           string `json:"systemConfigAddr,omitempty"`
 	L1CrossDomainMessengerAddr string `json:"l1CrossDomainMessengerAddr,omitempty"`
 	L1StandardBridgeAddr       string `json:"l1StandardBridgeAddr,omitempty"`
+	BatchInboxAddr             string `json:"batchInboxAddr,omitempty"`
+
+	// SystemConfigStartBlock is the L1 block the SystemConfig was initialized at
+	SystemConfigStartBlock int64 `json:"systemConfigStartBlock,omitempty"`
 
 	// L2 Contracts (predeploys - same across all OP Stack chains)
 	L2CrossDomainMessengerAddr string `json:"l2CrossDomainMessengerAddr,omitempty"`
@@ -46,6 +46,88 @@ func (in *AuthRPCConfig) DeepCopy() *AuthRPCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupConfig) DeepCopyInto(out *BackupConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupConfig.
+func (in *BackupConfig) DeepCopy() *BackupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupSnapshot) DeepCopyInto(out *BackupSnapshot) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSnapshot.
+func (in *BackupSnapshot) DeepCopy() *BackupSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchSubmissionInfo) DeepCopyInto(out *BatchSubmissionInfo) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchSubmissionInfo.
+func (in *BatchSubmissionInfo) DeepCopy() *BatchSubmissionInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchSubmissionInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatcherInfo) DeepCopyInto(out *BatcherInfo) {
+	*out = *in
+	if in.LastBatchSubmitted != nil {
+		in, out := &in.LastBatchSubmitted, &out.LastBatchSubmitted
+		*out = new(BatchSubmissionInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatcherInfo.
+func (in *BatcherInfo) DeepCopy() *BatcherInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(BatcherInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BatchingConfig) DeepCopyInto(out *BatchingConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BatchingConfig.
+func (in *BatchingConfig) DeepCopy() *BatchingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BatchingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CORSConfig) DeepCopyInto(out *CORSConfig) {
 	*out = *in
@@ -87,6 +169,77 @@ func (in *ChainHeadInfo) DeepCopy() *ChainHeadInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainParameters) DeepCopyInto(out *ChainParameters) {
+	*out = *in
+	if in.Hardforks != nil {
+		in, out := &in.Hardforks, &out.Hardforks
+		*out = new(HardforkTimes)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainParameters.
+func (in *ChainParameters) DeepCopy() *ChainParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChallengerInfo) DeepCopyInto(out *ChallengerInfo) {
+	*out = *in
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChallengerInfo.
+func (in *ChallengerInfo) DeepCopy() *ChallengerInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ChallengerInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConductorHealthCheck) DeepCopyInto(out *ConductorHealthCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConductorHealthCheck.
+func (in *ConductorHealthCheck) DeepCopy() *ConductorHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ConductorHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConductorStatus) DeepCopyInto(out *ConductorStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]RaftMember, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConductorStatus.
+func (in *ConductorStatus) DeepCopy() *ConductorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConductorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigSource) DeepCopyInto(out *ConfigSource) {
 	*out = *in
@@ -107,6 +260,40 @@ func (in *ConfigSource) DeepCopy() *ConfigSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerOverrides) DeepCopyInto(out *ContainerOverrides) {
+	*out = *in
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraEnv != nil {
+		in, out := &in.ExtraEnv, &out.ExtraEnv
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraVolumeMounts != nil {
+		in, out := &in.ExtraVolumeMounts, &out.ExtraVolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerOverrides.
+func (in *ContainerOverrides) DeepCopy() *ContainerOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContractAddressConfig) DeepCopyInto(out *ContractAddressConfig) {
 	*out = *in
@@ -122,6 +309,21 @@ func (in *ContractAddressConfig) DeepCopy() *ContractAddressConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataAvailabilityConfig) DeepCopyInto(out *DataAvailabilityConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataAvailabilityConfig.
+func (in *DataAvailabilityConfig) DeepCopy() *DataAvailabilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DataAvailabilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EngineConfig) DeepCopyInto(out *EngineConfig) {
 	*out = *in
@@ -197,6 +399,32 @@ func (in *GethP2PConfig) DeepCopy() *GethP2PConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GethStorageConfig) DeepCopyInto(out *GethStorageConfig) {
+	*out = *in
+	in.StorageConfig.DeepCopyInto(&out.StorageConfig)
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(SnapshotSource)
+		**out = **in
+	}
+	if in.AutoGrow != nil {
+		in, out := &in.AutoGrow, &out.AutoGrow
+		*out = new(StorageAutoGrowConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GethStorageConfig.
+func (in *GethStorageConfig) DeepCopy() *GethStorageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GethStorageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPConfig) DeepCopyInto(out *HTTPConfig) {
 	*out = *in
@@ -222,6 +450,178 @@ func (in *HTTPConfig) DeepCopy() *HTTPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HardforkTimes) DeepCopyInto(out *HardforkTimes) {
+	*out = *in
+	if in.Regolith != nil {
+		in, out := &in.Regolith, &out.Regolith
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Canyon != nil {
+		in, out := &in.Canyon, &out.Canyon
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Delta != nil {
+		in, out := &in.Delta, &out.Delta
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Ecotone != nil {
+		in, out := &in.Ecotone, &out.Ecotone
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Fjord != nil {
+		in, out := &in.Fjord, &out.Fjord
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Granite != nil {
+		in, out := &in.Granite, &out.Granite
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Holocene != nil {
+		in, out := &in.Holocene, &out.Holocene
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Isthmus != nil {
+		in, out := &in.Isthmus, &out.Isthmus
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HardforkTimes.
+func (in *HardforkTimes) DeepCopy() *HardforkTimes {
+	if in == nil {
+		return nil
+	}
+	out := new(HardforkTimes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageOverrides) DeepCopyInto(out *ImageOverrides) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageOverrides.
+func (in *ImageOverrides) DeepCopy() *ImageOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L1ClientConfig) DeepCopyInto(out *L1ClientConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L1ClientConfig.
+func (in *L1ClientConfig) DeepCopy() *L1ClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(L1ClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L1EndpointStatus) DeepCopyInto(out *L1EndpointStatus) {
+	*out = *in
+	in.LastChecked.DeepCopyInto(&out.LastChecked)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L1EndpointStatus.
+func (in *L1EndpointStatus) DeepCopy() *L1EndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(L1EndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L1FailoverConfig) DeepCopyInto(out *L1FailoverConfig) {
+	*out = *in
+	if in.MaxHeadLag != nil {
+		in, out := &in.MaxHeadLag, &out.MaxHeadLag
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L1FailoverConfig.
+func (in *L1FailoverConfig) DeepCopy() *L1FailoverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(L1FailoverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L1RpcEndpoint) DeepCopyInto(out *L1RpcEndpoint) {
+	*out = *in
+	if in.URLSecretRef != nil {
+		in, out := &in.URLSecretRef, &out.URLSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L1RpcEndpoint.
+func (in *L1RpcEndpoint) DeepCopy() *L1RpcEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(L1RpcEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L1Status) DeepCopyInto(out *L1Status) {
+	*out = *in
+	in.LastChecked.DeepCopyInto(&out.LastChecked)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L1Status.
+func (in *L1Status) DeepCopy() *L1Status {
+	if in == nil {
+		return nil
+	}
+	out := new(L1Status)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *L1TransactionConfig) DeepCopyInto(out *L1TransactionConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new L1TransactionConfig.
+func (in *L1TransactionConfig) DeepCopy() *L1TransactionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(L1TransactionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoggingConfig) DeepCopyInto(out *LoggingConfig) {
 	*out = *in
@@ -290,6 +690,21 @@ func (in *NetworkInfo) DeepCopy() *NetworkInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeImages) DeepCopyInto(out *NodeImages) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeImages.
+func (in *NodeImages) DeepCopy() *NodeImages {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeImages)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeInfo) DeepCopyInto(out *NodeInfo) {
 	*out = *in
@@ -320,8 +735,8 @@ func (in *OpBatcher) DeepCopyInto(out *OpBatcher) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpBatcher.
@@ -377,6 +792,49 @@ func (in *OpBatcherList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpBatcherSpec) DeepCopyInto(out *OpBatcherSpec) {
 	*out = *in
+	out.OptimismNetworkRef = in.OptimismNetworkRef
+	out.SequencerRef = in.SequencerRef
+	in.PrivateKey.DeepCopyInto(&out.PrivateKey)
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(BatchingConfig)
+		**out = **in
+	}
+	if in.DataAvailability != nil {
+		in, out := &in.DataAvailability, &out.DataAvailability
+		*out = new(DataAvailabilityConfig)
+		**out = **in
+	}
+	if in.Throttling != nil {
+		in, out := &in.Throttling, &out.Throttling
+		*out = new(ThrottlingConfig)
+		**out = **in
+	}
+	if in.L1Transaction != nil {
+		in, out := &in.L1Transaction, &out.L1Transaction
+		*out = new(L1TransactionConfig)
+		**out = **in
+	}
+	if in.RPC != nil {
+		in, out := &in.RPC, &out.RPC
+		*out = new(RPCConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(MetricsConfig)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ServiceConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpBatcherSpec.
@@ -392,6 +850,18 @@ func (in *OpBatcherSpec) DeepCopy() *OpBatcherSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpBatcherStatus) DeepCopyInto(out *OpBatcherStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BatcherInfo != nil {
+		in, out := &in.BatcherInfo, &out.BatcherInfo
+		*out = new(BatcherInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpBatcherStatus.
@@ -409,8 +879,8 @@ func (in *OpChallenger) DeepCopyInto(out *OpChallenger) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpChallenger.
@@ -466,6 +936,44 @@ func (in *OpChallengerList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpChallengerSpec) DeepCopyInto(out *OpChallengerSpec) {
 	*out = *in
+	out.OptimismNetworkRef = in.OptimismNetworkRef
+	out.RollupRPCRef = in.RollupRPCRef
+	in.PrivateKey.DeepCopyInto(&out.PrivateKey)
+	if in.TraceTypes != nil {
+		in, out := &in.TraceTypes, &out.TraceTypes
+		*out = make([]TraceType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Cannon != nil {
+		in, out := &in.Cannon, &out.Cannon
+		*out = new(VMTraceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Asterisc != nil {
+		in, out := &in.Asterisc, &out.Asterisc
+		*out = new(VMTraceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(StorageConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.L1Transaction != nil {
+		in, out := &in.L1Transaction, &out.L1Transaction
+		*out = new(L1TransactionConfig)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(MetricsConfig)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpChallengerSpec.
@@ -481,6 +989,18 @@ func (in *OpChallengerSpec) DeepCopy() *OpChallengerSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpChallengerStatus) DeepCopyInto(out *OpChallengerStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ChallengerInfo != nil {
+		in, out := &in.ChallengerInfo, &out.ChallengerInfo
+		*out = new(ChallengerInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpChallengerStatus.
@@ -498,7 +1018,7 @@ func (in *OpGethConfig) DeepCopyInto(out *OpGethConfig) {
 	*out = *in
 	if in.Storage != nil {
 		in, out := &in.Storage, &out.Storage
-		*out = new(StorageConfig)
+		*out = new(GethStorageConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Networking != nil {
@@ -516,6 +1036,7 @@ func (in *OpGethConfig) DeepCopyInto(out *OpGethConfig) {
 		*out = new(RollupConfig)
 		**out = **in
 	}
+	in.ContainerOverrides.DeepCopyInto(&out.ContainerOverrides)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpGethConfig.
@@ -558,6 +1079,11 @@ func (in *OpNode) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpNodeConfig) DeepCopyInto(out *OpNodeConfig) {
 	*out = *in
+	if in.L1 != nil {
+		in, out := &in.L1, &out.L1
+		*out = new(L1ClientConfig)
+		**out = **in
+	}
 	if in.P2P != nil {
 		in, out := &in.P2P, &out.P2P
 		*out = new(P2PConfig)
@@ -578,6 +1104,7 @@ func (in *OpNodeConfig) DeepCopyInto(out *OpNodeConfig) {
 		*out = new(EngineConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	in.ContainerOverrides.DeepCopyInto(&out.ContainerOverrides)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpNodeConfig.
@@ -622,6 +1149,21 @@ func (in *OpNodeList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpNodeReference) DeepCopyInto(out *OpNodeReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpNodeReference.
+func (in *OpNodeReference) DeepCopy() *OpNodeReference {
+	if in == nil {
+		return nil
+	}
+	out := new(OpNodeReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpNodeResources) DeepCopyInto(out *OpNodeResources) {
 	*out = *in
@@ -651,21 +1193,65 @@ func (in *OpNodeResources) DeepCopy() *OpNodeResources {
 func (in *OpNodeSpec) DeepCopyInto(out *OpNodeSpec) {
 	*out = *in
 	out.OptimismNetworkRef = in.OptimismNetworkRef
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
 	if in.SequencerRef != nil {
 		in, out := &in.SequencerRef, &out.SequencerRef
 		*out = new(SequencerReference)
 		**out = **in
 	}
-	in.OpNode.DeepCopyInto(&out.OpNode)
-	in.OpGeth.DeepCopyInto(&out.OpGeth)
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(OpNodeResources)
-		(*in).DeepCopyInto(*out)
+	in.OpNode.DeepCopyInto(&out.OpNode)
+	in.OpGeth.DeepCopyInto(&out.OpGeth)
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(OpNodeResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(ServiceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(ImageOverrides)
+		**out = **in
+	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(UpgradeStrategy)
+		**out = **in
+	}
+	if in.HA != nil {
+		in, out := &in.HA, &out.HA
+		*out = new(SequencerHAConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(BackupConfig)
+		**out = **in
+	}
+	if in.ExtraVolumes != nil {
+		in, out := &in.ExtraVolumes, &out.ExtraVolumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Service != nil {
-		in, out := &in.Service, &out.Service
-		*out = new(ServiceConfig)
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]v1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(PodTemplateOverrides)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -695,6 +1281,30 @@ func (in *OpNodeStatus) DeepCopyInto(out *OpNodeStatus) {
 		*out = new(NodeInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conductor != nil {
+		in, out := &in.Conductor, &out.Conductor
+		*out = new(ConductorStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(UpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]BackupSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]VolumeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpNodeStatus.
@@ -712,8 +1322,8 @@ func (in *OpProposer) DeepCopyInto(out *OpProposer) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpProposer.
@@ -769,6 +1379,29 @@ func (in *OpProposerList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpProposerSpec) DeepCopyInto(out *OpProposerSpec) {
 	*out = *in
+	out.OptimismNetworkRef = in.OptimismNetworkRef
+	out.RollupRPCRef = in.RollupRPCRef
+	in.PrivateKey.DeepCopyInto(&out.PrivateKey)
+	if in.L1Transaction != nil {
+		in, out := &in.L1Transaction, &out.L1Transaction
+		*out = new(L1TransactionConfig)
+		**out = **in
+	}
+	if in.RPC != nil {
+		in, out := &in.RPC, &out.RPC
+		*out = new(RPCConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(MetricsConfig)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpProposerSpec.
@@ -784,6 +1417,18 @@ func (in *OpProposerSpec) DeepCopy() *OpProposerSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpProposerStatus) DeepCopyInto(out *OpProposerStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ProposerInfo != nil {
+		in, out := &in.ProposerInfo, &out.ProposerInfo
+		*out = new(ProposerInfo)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpProposerStatus.
@@ -873,6 +1518,28 @@ func (in *OptimismNetworkRef) DeepCopy() *OptimismNetworkRef {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OptimismNetworkSpec) DeepCopyInto(out *OptimismNetworkSpec) {
 	*out = *in
+	if in.L1RpcUrlSecretRef != nil {
+		in, out := &in.L1RpcUrlSecretRef, &out.L1RpcUrlSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.L1BeaconUrlSecretRef != nil {
+		in, out := &in.L1BeaconUrlSecretRef, &out.L1BeaconUrlSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.L1RpcEndpoints != nil {
+		in, out := &in.L1RpcEndpoints, &out.L1RpcEndpoints
+		*out = make([]L1RpcEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.L1Failover != nil {
+		in, out := &in.L1Failover, &out.L1Failover
+		*out = new(L1FailoverConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RollupConfig != nil {
 		in, out := &in.RollupConfig, &out.RollupConfig
 		*out = new(ConfigSource)
@@ -888,11 +1555,21 @@ func (in *OptimismNetworkSpec) DeepCopyInto(out *OptimismNetworkSpec) {
 		*out = new(ContractAddressConfig)
 		**out = **in
 	}
+	if in.ChainParameters != nil {
+		in, out := &in.ChainParameters, &out.ChainParameters
+		*out = new(ChainParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SharedConfig != nil {
 		in, out := &in.SharedConfig, &out.SharedConfig
 		*out = new(SharedConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = new(ImageOverrides)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptimismNetworkSpec.
@@ -920,6 +1597,22 @@ func (in *OptimismNetworkStatus) DeepCopyInto(out *OptimismNetworkStatus) {
 		*out = new(NetworkInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.L1 != nil {
+		in, out := &in.L1, &out.L1
+		*out = new(L1Status)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.L1Endpoints != nil {
+		in, out := &in.L1Endpoints, &out.L1Endpoints
+		*out = make([]L1EndpointStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PendingL1EndpointSince != nil {
+		in, out := &in.PendingL1EndpointSince, &out.PendingL1EndpointSince
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OptimismNetworkStatus.
@@ -932,6 +1625,22 @@ func (in *OptimismNetworkStatus) DeepCopy() *OptimismNetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputProposalInfo) DeepCopyInto(out *OutputProposalInfo) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputProposalInfo.
+func (in *OutputProposalInfo) DeepCopy() *OutputProposalInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputProposalInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *P2PConfig) DeepCopyInto(out *P2PConfig) {
 	*out = *in
@@ -1002,6 +1711,87 @@ func (in *P2PScoringConfig) DeepCopy() *P2PScoringConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodTemplateOverrides) DeepCopyInto(out *PodTemplateOverrides) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodTemplateOverrides.
+func (in *PodTemplateOverrides) DeepCopy() *PodTemplateOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(PodTemplateOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrestateSource) DeepCopyInto(out *PrestateSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrestateSource.
+func (in *PrestateSource) DeepCopy() *PrestateSource {
+	if in == nil {
+		return nil
+	}
+	out := new(PrestateSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProposerInfo) DeepCopyInto(out *ProposerInfo) {
+	*out = *in
+	if in.LastProposal != nil {
+		in, out := &in.LastProposal, &out.LastProposal
+		*out = new(OutputProposalInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProposerInfo.
+func (in *ProposerInfo) DeepCopy() *ProposerInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(ProposerInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RPCConfig) DeepCopyInto(out *RPCConfig) {
 	*out = *in
@@ -1022,6 +1812,21 @@ func (in *RPCConfig) DeepCopy() *RPCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RaftMember) DeepCopyInto(out *RaftMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RaftMember.
+func (in *RaftMember) DeepCopy() *RaftMember {
+	if in == nil {
+		return nil
+	}
+	out := new(RaftMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceConfig) DeepCopyInto(out *ResourceConfig) {
 	*out = *in
@@ -1136,6 +1941,26 @@ func (in *SequencerConfig) DeepCopy() *SequencerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SequencerHAConfig) DeepCopyInto(out *SequencerHAConfig) {
+	*out = *in
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(ConductorHealthCheck)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SequencerHAConfig.
+func (in *SequencerHAConfig) DeepCopy() *SequencerHAConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SequencerHAConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SequencerReference) DeepCopyInto(out *SequencerReference) {
 	*out = *in
@@ -1229,6 +2054,37 @@ func (in *SharedConfig) DeepCopy() *SharedConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSource) DeepCopyInto(out *SnapshotSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSource.
+func (in *SnapshotSource) DeepCopy() *SnapshotSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageAutoGrowConfig) DeepCopyInto(out *StorageAutoGrowConfig) {
+	*out = *in
+	out.MaxSize = in.MaxSize.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageAutoGrowConfig.
+func (in *StorageAutoGrowConfig) DeepCopy() *StorageAutoGrowConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageAutoGrowConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageConfig) DeepCopyInto(out *StorageConfig) {
 	*out = *in
@@ -1260,6 +2116,21 @@ func (in *SyncStatusInfo) DeepCopy() *SyncStatusInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThrottlingConfig) DeepCopyInto(out *ThrottlingConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThrottlingConfig.
+func (in *ThrottlingConfig) DeepCopy() *ThrottlingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ThrottlingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TxPoolConfig) DeepCopyInto(out *TxPoolConfig) {
 	*out = *in
@@ -1280,6 +2151,87 @@ func (in *TxPoolConfig) DeepCopy() *TxPoolConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
+	*out = *in
+	if in.CurrentImages != nil {
+		in, out := &in.CurrentImages, &out.CurrentImages
+		*out = new(NodeImages)
+		**out = **in
+	}
+	if in.TargetImages != nil {
+		in, out := &in.TargetImages, &out.TargetImages
+		*out = new(NodeImages)
+		**out = **in
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
+func (in *UpgradeStatus) DeepCopy() *UpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStrategy) DeepCopyInto(out *UpgradeStrategy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStrategy.
+func (in *UpgradeStrategy) DeepCopy() *UpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMTraceConfig) DeepCopyInto(out *VMTraceConfig) {
+	*out = *in
+	if in.Prestate != nil {
+		in, out := &in.Prestate, &out.Prestate
+		*out = new(PrestateSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMTraceConfig.
+func (in *VMTraceConfig) DeepCopy() *VMTraceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VMTraceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
+	*out = *in
+	out.Requested = in.Requested.DeepCopy()
+	out.Capacity = in.Capacity.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeStatus.
+func (in *VolumeStatus) DeepCopy() *VolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WSConfig) DeepCopyInto(out *WSConfig) {
 	*out = *in
@@ -28,17 +28,22 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
 	"github.com/ethereum-optimism/op-stack-operator/internal/controller"
+	webhookoptimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/internal/webhook/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/config"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/superchain"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -63,6 +68,8 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var superchainRegistryPath string
+	var versionSetsPath string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -81,6 +88,11 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&superchainRegistryPath, "superchain-registry-path", "",
+		"Optional directory (e.g. a mounted ConfigMap) whose superchain registry files override the embedded snapshot.")
+	flag.StringVar(&versionSetsPath, "version-sets-path", "",
+		"Optional YAML or JSON file (e.g. a mounted ConfigMap key) with extra version sets and op-node/op-geth "+
+			"pairings. The file is reloaded when it changes.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -202,16 +214,40 @@ func main() {
 		os.Exit(1)
 	}
 
+	superchainRegistry, err := superchain.NewRegistry(superchainRegistryPath)
+	if err != nil {
+		setupLog.Error(err, "unable to load superchain registry")
+		os.Exit(1)
+	}
+
+	if versionSetsPath != "" {
+		versionCatalogWatcher, err := config.NewVersionCatalogWatcher(versionSetsPath)
+		if err != nil {
+			setupLog.Error(err, "unable to load version sets", "path", versionSetsPath)
+			os.Exit(1)
+		}
+		if err := mgr.Add(versionCatalogWatcher); err != nil {
+			setupLog.Error(err, "unable to add version catalog watcher to manager")
+			os.Exit(1)
+		}
+	}
 	if err = (&controller.OptimismNetworkReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:             mgr.GetClient(),
+		Scheme:             mgr.GetScheme(),
+		SuperchainRegistry: superchainRegistry,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "OptimismNetwork")
 		os.Exit(1)
 	}
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to create Kubernetes clientset")
+		os.Exit(1)
+	}
 	if err = (&controller.OpNodeReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:      mgr.GetClient(),
+		Scheme:      mgr.GetScheme(),
+		VolumeStats: controller.NewKubeletVolumeStats(clientset.CoreV1().RESTClient()),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "OpNode")
 		os.Exit(1)
@@ -237,6 +273,33 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "OpChallenger")
 		os.Exit(1)
 	}
+	if err = metrics.Registry.Register(controller.NewChainHealthCollector(mgr.GetClient())); err != nil {
+		setupLog.Error(err, "unable to register chain health metrics")
+		os.Exit(1)
+	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = webhookoptimismv1alpha1.SetupOptimismNetworkWebhookWithManager(mgr, superchainRegistry); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "OptimismNetwork")
+			os.Exit(1)
+		}
+		if err = webhookoptimismv1alpha1.SetupOpNodeWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "OpNode")
+			os.Exit(1)
+		}
+		if err = webhookoptimismv1alpha1.SetupOpBatcherWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "OpBatcher")
+			os.Exit(1)
+		}
+		if err = webhookoptimismv1alpha1.SetupOpProposerWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "OpProposer")
+			os.Exit(1)
+		}
+		if err = webhookoptimismv1alpha1.SetupOpChallengerWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "OpChallenger")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if metricsCertWatcher != nil {
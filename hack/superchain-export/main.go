@@ -0,0 +1,233 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// superchain-export regenerates the superchain registry snapshot embedded in
+// pkg/superchain. The chain list and addresses are downloaded from the
+// superchain-registry at -ref. Rollup configs are dumped by the default op-node
+// image and genesis references are checked against the default op-geth image, so
+// the snapshot matches the components the operator deploys. Chains the images do
+// not bundle are left out.
+//
+// Usage (from pkg/superchain, see go:generate in registry.go):
+//
+//	go run ../../hack/superchain-export -ref <commit> -out data [-genesis 901,902]
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/op-stack-operator/pkg/config"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/superchain"
+)
+
+const registryURL = "https://raw.githubusercontent.com/ethereum-optimism/superchain-registry"
+
+func main() {
+	ref := flag.String("ref", "", "superchain-registry commit or tag to export (required)")
+	out := flag.String("out", "data", "directory the snapshot is written to")
+	opNodeImage := flag.String("op-node-image", config.DefaultImages.OpNode, "op-node image that dumps the rollup configs")
+	opGethImage := flag.String("op-geth-image", config.DefaultImages.OpGeth, "op-geth image whose bundled genesis is referenced")
+	genesis := flag.String("genesis", "", "comma-separated chain IDs whose full genesis is embedded as well")
+	flag.Parse()
+	if *ref == "" {
+		log.Fatal("-ref is required so the snapshot is reproducible")
+	}
+
+	embedGenesis := map[int64]bool{}
+	for _, id := range strings.Split(*genesis, ",") {
+		if id = strings.TrimSpace(id); id == "" {
+			continue
+		}
+		chainID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			log.Fatalf("invalid -genesis chain ID %q: %v", id, err)
+		}
+		embedGenesis[chainID] = true
+	}
+
+	if err := export(*ref, *out, *opNodeImage, *opGethImage, embedGenesis); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func export(ref, out, opNodeImage, opGethImage string, embedGenesis map[int64]bool) error {
+	rawList, err := fetch(ref, superchain.ChainListFile)
+	if err != nil {
+		return err
+	}
+	var list []json.RawMessage
+	if err := json.Unmarshal(rawList, &list); err != nil {
+		return fmt.Errorf("invalid %s: %w", superchain.ChainListFile, err)
+	}
+	rawAddresses, err := fetch(ref, "superchain/extra/addresses/"+superchain.AddressesFile)
+	if err != nil {
+		return err
+	}
+	var addresses map[string]json.RawMessage
+	if err := json.Unmarshal(rawAddresses, &addresses); err != nil {
+		return fmt.Errorf("invalid %s: %w", superchain.AddressesFile, err)
+	}
+
+	// The snapshot is assembled next to out and only replaces it once complete
+	final := out
+	out = final + ".new"
+	if err := os.RemoveAll(out); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(out, 0o755); err != nil {
+		return err
+	}
+	defer os.RemoveAll(out)
+
+	var chains []json.RawMessage
+	keptAddresses := map[string]json.RawMessage{}
+	genesisRefs := map[string]superchain.GenesisRef{}
+	for _, raw := range list {
+		var entry struct {
+			Identifier string `json:"identifier"`
+			ChainID    int64  `json:"chainId"`
+		}
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return fmt.Errorf("invalid %s entry: %w", superchain.ChainListFile, err)
+		}
+		key := strconv.FormatInt(entry.ChainID, 10)
+		if _, ok := addresses[key]; !ok {
+			log.Printf("skipping chain %d (%s): no addresses", entry.ChainID, entry.Identifier)
+			continue
+		}
+		network := superchain.OpNetworkName(entry.Identifier)
+
+		// op-node prints the rollup config it would run with for a bundled network
+		rollupConfig, err := run(opNodeImage, "op-node", "networks", "dump-rollup-config", "--network="+network)
+		if err != nil {
+			log.Printf("skipping chain %d (%s): op-node does not bundle %s: %v", entry.ChainID, entry.Identifier, network, err)
+			continue
+		}
+		var rollup struct {
+			Genesis struct {
+				L2 struct {
+					Hash string `json:"hash"`
+				} `json:"l2"`
+			} `json:"genesis"`
+			L2ChainID int64 `json:"l2_chain_id"`
+		}
+		if err := json.Unmarshal(rollupConfig, &rollup); err != nil || rollup.L2ChainID != entry.ChainID {
+			return fmt.Errorf("op-node dumped an unexpected rollup config for %s: %s", network, rollupConfig)
+		}
+
+		// op-geth prints the genesis it bundles; only its presence is required unless embedded
+		genesis, err := run(opGethImage, "geth", "dumpgenesis", "--op-network="+network)
+		if err != nil {
+			log.Printf("skipping chain %d (%s): op-geth does not bundle %s: %v", entry.ChainID, entry.Identifier, network, err)
+			continue
+		}
+		if embedGenesis[entry.ChainID] {
+			if err := writeJSON(filepath.Join(out, fmt.Sprintf("genesis-%d.json", entry.ChainID)), genesis); err != nil {
+				return err
+			}
+		}
+
+		if err := writeJSON(filepath.Join(out, fmt.Sprintf("rollup-%d.json", entry.ChainID)), rollupConfig); err != nil {
+			return err
+		}
+		chains = append(chains, raw)
+		keptAddresses[key] = addresses[key]
+		genesisRefs[key] = superchain.GenesisRef{OpGethNetwork: network, L2GenesisHash: rollup.Genesis.L2.Hash}
+	}
+	if len(chains) == 0 {
+		return fmt.Errorf("no chain of %s at %s is bundled by %s and %s", superchain.ChainListFile, ref, opNodeImage, opGethImage)
+	}
+
+	files := map[string]interface{}{
+		superchain.ChainListFile:   chains,
+		superchain.AddressesFile:   keptAddresses,
+		superchain.GenesisRefsFile: genesisRefs,
+	}
+	for name, value := range files {
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if err := writeJSON(filepath.Join(out, name), raw); err != nil {
+			return err
+		}
+	}
+
+	ids := make([]string, 0, len(genesisRefs))
+	for key := range genesisRefs {
+		ids = append(ids, key)
+	}
+	sort.Strings(ids)
+	source := fmt.Sprintf("superchain-registry %s\n%s\n%s\nchains %s\n", ref, opNodeImage, opGethImage, strings.Join(ids, ","))
+	if err := os.WriteFile(filepath.Join(out, "SOURCE"), []byte(source), 0o644); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(final); err != nil {
+		return err
+	}
+	return os.Rename(out, final)
+}
+
+// fetch downloads a file of the superchain-registry at ref
+func fetch(ref, name string) ([]byte, error) {
+	client := &http.Client{Timeout: time.Minute}
+	resp, err := client.Get(fmt.Sprintf("%s/%s/%s", registryURL, ref, name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s at %s: %s", name, ref, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// run executes a command in a container of image and returns its JSON output
+func run(image string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("docker", append([]string{"run", "--rm", "--entrypoint", args[0], image}, args[1:]...)...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	if !json.Valid(stdout.Bytes()) {
+		return nil, fmt.Errorf("%s printed no JSON document", args[0])
+	}
+	return stdout.Bytes(), nil
+}
+
+// writeJSON writes an indented JSON document
+func writeJSON(name string, raw []byte) error {
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, raw, "", "  "); err != nil {
+		return fmt.Errorf("failed to format %s: %w", name, err)
+	}
+	indented.WriteByte('\n')
+	return os.WriteFile(name, indented.Bytes(), 0o644)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+// clearForceUpdate removes the one-shot force-update annotation once the forced
+// change has been reconciled, so later edits are protected again
+func clearForceUpdate(ctx context.Context, c client.Client, obj client.Object) error {
+	if _, ok := obj.GetAnnotations()[optimismv1alpha1.ForceUpdateAnnotation]; !ok {
+		return nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := maps.Clone(obj.GetAnnotations())
+	delete(annotations, optimismv1alpha1.ForceUpdateAnnotation)
+	obj.SetAnnotations(annotations)
+	return c.Patch(ctx, obj, patch)
+}
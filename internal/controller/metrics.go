@@ -0,0 +1,213 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+// metricsListTimeout bounds the resource listing of a scrape
+const metricsListTimeout = 10 * time.Second
+
+// opNodePhases are the phases exported by opstack_opnode_phase
+var opNodePhases = []string{
+	OpNodePhasePending, OpNodePhaseInitializing, OpNodePhaseRunning, OpNodePhaseError, OpNodePhaseStopped,
+}
+
+var (
+	opNodeLabels  = []string{"namespace", "name", "network", "node_type"}
+	networkLabels = []string{"namespace", "name"}
+
+	opNodeHeadBlockDesc = prometheus.NewDesc("opstack_opnode_head_block",
+		"Unsafe L2 head block of the node", opNodeLabels, nil)
+	opNodeL1LagDesc = prometheus.NewDesc("opstack_opnode_l1_lag_blocks",
+		"L1 blocks between the L1 head and the L1 block the derivation pipeline processed", opNodeLabels, nil)
+	opNodeSequencerLagDesc = prometheus.NewDesc("opstack_opnode_sequencer_lag_blocks",
+		"L2 blocks the node trails the sequencer of its network", opNodeLabels, nil)
+	opNodePeerCountDesc = prometheus.NewDesc("opstack_opnode_peer_count",
+		"P2P peers of the node", opNodeLabels, nil)
+	opNodePhaseDesc = prometheus.NewDesc("opstack_opnode_phase",
+		"Phase of the node, 1 for the current phase", append(opNodeLabels, "phase"), nil)
+
+	networkL1ReachableDesc = prometheus.NewDesc("opstack_optimismnetwork_l1_reachable",
+		"Whether the last L1 RPC probe succeeded", networkLabels, nil)
+	networkL1LatencyDesc = prometheus.NewDesc("opstack_optimismnetwork_l1_latency_seconds",
+		"Round trip of the last L1 RPC probe", networkLabels, nil)
+	networkL1HeadBlockDesc = prometheus.NewDesc("opstack_optimismnetwork_l1_head_block",
+		"L1 head block seen by the last L1 RPC probe", networkLabels, nil)
+	networkL1EndpointHealthyDesc = prometheus.NewDesc("opstack_optimismnetwork_l1_endpoint_healthy",
+		"Whether an L1 RPC endpoint passed its last probe", append(networkLabels, "endpoint"), nil)
+	networkL1EndpointActiveDesc = prometheus.NewDesc("opstack_optimismnetwork_l1_endpoint_active",
+		"Whether the components use an L1 RPC endpoint", append(networkLabels, "endpoint"), nil)
+	networkDiscoveryMethodDesc = prometheus.NewDesc("opstack_optimismnetwork_discovery_method",
+		"Method the contract addresses were discovered with, always 1", append(networkLabels, "method"), nil)
+)
+
+// ChainHealthCollector exports chain health metrics of OpNodes and OptimismNetworks.
+// Values are read from resource status at scrape time, so they are as fresh as the
+// last reconcile of each resource.
+type ChainHealthCollector struct {
+	Reader client.Reader
+}
+
+// NewChainHealthCollector returns a collector reading resources through reader,
+// typically the manager's cache-backed client
+func NewChainHealthCollector(reader client.Reader) *ChainHealthCollector {
+	return &ChainHealthCollector{Reader: reader}
+}
+
+// Describe implements prometheus.Collector
+func (c *ChainHealthCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		opNodeHeadBlockDesc, opNodeL1LagDesc, opNodeSequencerLagDesc, opNodePeerCountDesc, opNodePhaseDesc,
+		networkL1ReachableDesc, networkL1LatencyDesc, networkL1HeadBlockDesc,
+		networkL1EndpointHealthyDesc, networkL1EndpointActiveDesc, networkDiscoveryMethodDesc,
+	} {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector
+func (c *ChainHealthCollector) Collect(ch chan<- prometheus.Metric) {
+	logger := logf.Log.WithName("chain-health-metrics")
+	ctx, cancel := context.WithTimeout(context.Background(), metricsListTimeout)
+	defer cancel()
+
+	var networks optimismv1alpha1.OptimismNetworkList
+	if err := c.Reader.List(ctx, &networks); err != nil {
+		logger.Error(err, "failed to list OptimismNetworks")
+		return
+	}
+	var opNodes optimismv1alpha1.OpNodeList
+	if err := c.Reader.List(ctx, &opNodes); err != nil {
+		logger.Error(err, "failed to list OpNodes")
+		return
+	}
+
+	l1Heads := map[client.ObjectKey]int64{}
+	for i := range networks.Items {
+		network := &networks.Items[i]
+		if l1 := network.Status.L1; l1 != nil && l1.Reachable {
+			l1Heads[client.ObjectKeyFromObject(network)] = l1.HeadBlock
+		}
+		collectNetwork(ch, network)
+	}
+
+	sequencerHeads := map[client.ObjectKey]int64{}
+	for i := range opNodes.Items {
+		opNode := &opNodes.Items[i]
+		if head, ok := opNodeHead(opNode); ok && opNode.Spec.NodeType == "sequencer" {
+			networkKey := opNodeNetworkKey(opNode)
+			if head > sequencerHeads[networkKey] {
+				sequencerHeads[networkKey] = head
+			}
+		}
+	}
+
+	for i := range opNodes.Items {
+		opNode := &opNodes.Items[i]
+		networkKey := opNodeNetworkKey(opNode)
+		labels := []string{opNode.Namespace, opNode.Name, networkKey.Name, opNode.Spec.NodeType}
+
+		for _, phase := range opNodePhases {
+			value := 0.0
+			if opNode.Status.Phase == phase {
+				value = 1
+			}
+			ch <- prometheus.MustNewConstMetric(opNodePhaseDesc, prometheus.GaugeValue, value, append(labels, phase)...)
+		}
+
+		info := opNode.Status.NodeInfo
+		if info == nil {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(opNodePeerCountDesc, prometheus.GaugeValue, float64(info.PeerCount), labels...)
+
+		head, hasHead := opNodeHead(opNode)
+		if hasHead {
+			ch <- prometheus.MustNewConstMetric(opNodeHeadBlockDesc, prometheus.GaugeValue, float64(head), labels...)
+		}
+		if l1Head, ok := l1Heads[networkKey]; ok && info.SyncStatus != nil && info.SyncStatus.CurrentL1Block > 0 {
+			ch <- prometheus.MustNewConstMetric(opNodeL1LagDesc, prometheus.GaugeValue,
+				float64(max(l1Head-info.SyncStatus.CurrentL1Block, 0)), labels...)
+		}
+		if sequencerHead, ok := sequencerHeads[networkKey]; ok && hasHead && opNode.Spec.NodeType != "sequencer" {
+			ch <- prometheus.MustNewConstMetric(opNodeSequencerLagDesc, prometheus.GaugeValue,
+				float64(max(sequencerHead-head, 0)), labels...)
+		}
+	}
+}
+
+// collectNetwork exports the L1 probes and discovery method of a network
+func collectNetwork(ch chan<- prometheus.Metric, network *optimismv1alpha1.OptimismNetwork) {
+	labels := []string{network.Namespace, network.Name}
+
+	if l1 := network.Status.L1; l1 != nil {
+		reachable := 0.0
+		if l1.Reachable {
+			reachable = 1
+		}
+		ch <- prometheus.MustNewConstMetric(networkL1ReachableDesc, prometheus.GaugeValue, reachable, labels...)
+		if l1.Reachable {
+			ch <- prometheus.MustNewConstMetric(networkL1LatencyDesc, prometheus.GaugeValue,
+				(time.Duration(l1.LatencyMilliseconds) * time.Millisecond).Seconds(), labels...)
+			ch <- prometheus.MustNewConstMetric(networkL1HeadBlockDesc, prometheus.GaugeValue, float64(l1.HeadBlock), labels...)
+		}
+	}
+
+	for _, endpoint := range network.Status.L1Endpoints {
+		healthy, active := 0.0, 0.0
+		if endpoint.Healthy {
+			healthy = 1
+		}
+		if endpoint.Name == network.Status.ActiveL1Endpoint {
+			active = 1
+		}
+		ch <- prometheus.MustNewConstMetric(networkL1EndpointHealthyDesc, prometheus.GaugeValue, healthy, append(labels, endpoint.Name)...)
+		ch <- prometheus.MustNewConstMetric(networkL1EndpointActiveDesc, prometheus.GaugeValue, active, append(labels, endpoint.Name)...)
+	}
+
+	if info := network.Status.NetworkInfo; info != nil && info.DiscoveredContracts != nil && info.DiscoveredContracts.DiscoveryMethod != "" {
+		ch <- prometheus.MustNewConstMetric(networkDiscoveryMethodDesc, prometheus.GaugeValue, 1,
+			append(labels, info.DiscoveredContracts.DiscoveryMethod)...)
+	}
+}
+
+// opNodeHead returns the unsafe head block polled from a node, if known
+func opNodeHead(opNode *optimismv1alpha1.OpNode) (int64, bool) {
+	info := opNode.Status.NodeInfo
+	if info == nil || info.ChainHead == nil || info.ChainHead.BlockNumber == 0 {
+		return 0, false
+	}
+	return info.ChainHead.BlockNumber, true
+}
+
+// opNodeNetworkKey returns the key of the OptimismNetwork an OpNode references
+func opNodeNetworkKey(opNode *optimismv1alpha1.OpNode) client.ObjectKey {
+	namespace := opNode.Spec.OptimismNetworkRef.Namespace
+	if namespace == "" {
+		namespace = opNode.Namespace
+	}
+	return client.ObjectKey{Name: opNode.Spec.OptimismNetworkRef.Name, Namespace: namespace}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+var _ = Describe("Chain Health Metrics", func() {
+	network := &optimismv1alpha1.OptimismNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+		Status: optimismv1alpha1.OptimismNetworkStatus{
+			L1: &optimismv1alpha1.L1Status{Reachable: true, LatencyMilliseconds: 250, HeadBlock: 5000},
+			L1Endpoints: []optimismv1alpha1.L1EndpointStatus{
+				{Name: "primary", Healthy: true},
+				{Name: "backup", Healthy: false},
+			},
+			ActiveL1Endpoint: "primary",
+			NetworkInfo: &optimismv1alpha1.NetworkInfo{
+				DiscoveredContracts: &optimismv1alpha1.NetworkContractAddresses{DiscoveryMethod: "system-config"},
+			},
+		},
+	}
+	newOpNode := func(name, nodeType string, head, l1Block int64) *optimismv1alpha1.OpNode {
+		return &optimismv1alpha1.OpNode{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: optimismv1alpha1.OpNodeSpec{
+				OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+				NodeType:           nodeType,
+			},
+			Status: optimismv1alpha1.OpNodeStatus{
+				Phase: OpNodePhaseRunning,
+				NodeInfo: &optimismv1alpha1.NodeInfo{
+					ChainHead:  &optimismv1alpha1.ChainHeadInfo{BlockNumber: head},
+					SyncStatus: &optimismv1alpha1.SyncStatusInfo{CurrentBlock: head, CurrentL1Block: l1Block},
+					PeerCount:  12,
+				},
+			},
+		}
+	}
+
+	It("Should export node heads and lag against L1 and the sequencer", func() {
+		fakeClient := newFakeClient(network,
+			newOpNode("devnet-sequencer", "sequencer", 1000, 4998),
+			newOpNode("devnet-replica", "replica", 940, 4990))
+
+		gauges := gatherGauges(NewChainHealthCollector(fakeClient))
+		replica := `{name="devnet-replica",namespace="default",network="devnet",node_type="replica"}`
+		Expect(gauges).To(HaveKeyWithValue("opstack_opnode_head_block"+replica, 940.0))
+		Expect(gauges).To(HaveKeyWithValue("opstack_opnode_l1_lag_blocks"+replica, 10.0))
+		Expect(gauges).To(HaveKeyWithValue("opstack_opnode_sequencer_lag_blocks"+replica, 60.0))
+		Expect(gauges).To(HaveKeyWithValue("opstack_opnode_peer_count"+replica, 12.0))
+		Expect(gauges).To(HaveKeyWithValue(`opstack_opnode_phase{name="devnet-replica",namespace="default",network="devnet",node_type="replica",phase="Running"}`, 1.0))
+		Expect(gauges).To(HaveKeyWithValue(`opstack_opnode_phase{name="devnet-replica",namespace="default",network="devnet",node_type="replica",phase="Error"}`, 0.0))
+		Expect(gauges).NotTo(HaveKey(`opstack_opnode_sequencer_lag_blocks{name="devnet-sequencer",namespace="default",network="devnet",node_type="sequencer"}`))
+	})
+
+	It("Should export L1 reachability, latency, endpoint health and the discovery method of networks", func() {
+		unreachable := network.DeepCopy()
+		unreachable.Name = "testnet"
+		unreachable.Status.L1 = &optimismv1alpha1.L1Status{Reachable: false}
+		unreachable.Status.NetworkInfo = nil
+
+		gauges := gatherGauges(NewChainHealthCollector(newFakeClient(network, unreachable)))
+		Expect(gauges).To(HaveKeyWithValue(`opstack_optimismnetwork_l1_reachable{name="devnet",namespace="default"}`, 1.0))
+		Expect(gauges).To(HaveKeyWithValue(`opstack_optimismnetwork_l1_latency_seconds{name="devnet",namespace="default"}`, 0.25))
+		Expect(gauges).To(HaveKeyWithValue(`opstack_optimismnetwork_discovery_method{method="system-config",name="devnet",namespace="default"}`, 1.0))
+		Expect(gauges).To(HaveKeyWithValue(`opstack_optimismnetwork_l1_reachable{name="testnet",namespace="default"}`, 0.0))
+		Expect(gauges).NotTo(HaveKey(`opstack_optimismnetwork_l1_latency_seconds{name="testnet",namespace="default"}`))
+		Expect(gauges).To(HaveKeyWithValue(`opstack_optimismnetwork_l1_endpoint_healthy{endpoint="backup",name="devnet",namespace="default"}`, 0.0))
+		Expect(gauges).To(HaveKeyWithValue(`opstack_optimismnetwork_l1_endpoint_active{endpoint="primary",name="devnet",namespace="default"}`, 1.0))
+		Expect(gauges).To(HaveKeyWithValue(`opstack_optimismnetwork_l1_endpoint_active{endpoint="backup",name="devnet",namespace="default"}`, 0.0))
+	})
+})
+
+// gatherGauges collects a collector through a registry and returns its gauge values
+// keyed by metric name and sorted labels
+func gatherGauges(collector prometheus.Collector) map[string]float64 {
+	registry := prometheus.NewPedanticRegistry()
+	Expect(registry.Register(collector)).To(Succeed())
+	families, err := registry.Gather()
+	Expect(err).NotTo(HaveOccurred())
+
+	gauges := map[string]float64{}
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := make([]string, 0, len(metric.GetLabel()))
+			for _, label := range metric.GetLabel() {
+				labels = append(labels, fmt.Sprintf("%s=%q", label.GetName(), label.GetValue()))
+			}
+			gauges[family.GetName()+"{"+strings.Join(labels, ",")+"}"] = metric.GetGauge().GetValue()
+		}
+	}
+	return gauges
+}
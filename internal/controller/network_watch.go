@@ -0,0 +1,81 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+// l1EndpointSwitched passes the OptimismNetwork updates that switch the active L1 RPC
+// endpoint, which the components of the network render into their pods
+var l1EndpointSwitched = predicate.Funcs{
+	CreateFunc:  func(event.CreateEvent) bool { return false },
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldNetwork, ok := e.ObjectOld.(*optimismv1alpha1.OptimismNetwork)
+		if !ok {
+			return false
+		}
+		newNetwork, ok := e.ObjectNew.(*optimismv1alpha1.OptimismNetwork)
+		if !ok {
+			return false
+		}
+		return oldNetwork.Status.ActiveL1Endpoint != newNetwork.Status.ActiveL1Endpoint
+	},
+}
+
+// networkDependents returns a map function that enqueues the objects of list's kind whose
+// OptimismNetworkRef, as returned by ref, names the mapped network
+func networkDependents(c client.Client, list client.ObjectList, ref func(client.Object) optimismv1alpha1.OptimismNetworkRef) handler.MapFunc {
+	return func(ctx context.Context, network client.Object) []reconcile.Request {
+		objects := list.DeepCopyObject().(client.ObjectList)
+		if err := c.List(ctx, objects); err != nil {
+			return nil
+		}
+		items, err := meta.ExtractList(objects)
+		if err != nil {
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			networkRef := ref(obj)
+			namespace := networkRef.Namespace
+			if namespace == "" {
+				namespace = obj.GetNamespace()
+			}
+			if networkRef.Name == network.GetName() && namespace == network.GetNamespace() {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})
+			}
+		}
+		return requests
+	}
+}
@@ -17,16 +17,55 @@ limitations under the License.
 package controller
 
 import (
+	"bufio"
 	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/utils"
 )
 
+// OpBatcherFinalizer is the finalizer for OpBatcher resources
+const OpBatcherFinalizer = "opbatcher.optimism.io/finalizer"
+
+// Phase constants for OpBatcher status
+const (
+	OpBatcherPhasePending = "Pending"
+	OpBatcherPhaseRunning = "Running"
+	OpBatcherPhaseError   = "Error"
+	OpBatcherPhaseStopped = "Stopped"
+)
+
+// maxBatcherScanBlocks bounds the number of L1 blocks searched for batch transactions per reconcile
+const maxBatcherScanBlocks = 64
+
+// batcherChannelQueueMetric is the op-batcher gauge reporting the channels held in memory
+const batcherChannelQueueMetric = "op_batcher_default_channel_queue_length"
+
 // OpBatcherReconciler reconciles a OpBatcher object
 type OpBatcherReconciler struct {
 	client.Client
@@ -36,28 +75,507 @@ type OpBatcherReconciler struct {
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=opbatchers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=opbatchers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=opbatchers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=optimism.optimism.io,resources=opnodes;optimismnetworks,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the OpBatcher object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.20.0/pkg/reconcile
 func (r *OpBatcherReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	logger := log.FromContext(ctx)
+
+	// Fetch the OpBatcher instance
+	var opBatcher optimismv1alpha1.OpBatcher
+	if err := r.Get(ctx, req.NamespacedName, &opBatcher); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "unable to fetch OpBatcher")
+		return ctrl.Result{}, err
+	}
+
+	// Handle deletion
+	if opBatcher.DeletionTimestamp != nil {
+		return r.handleDeletion(ctx, &opBatcher)
+	}
+
+	// Add finalizer if not present
+	if !controllerutil.ContainsFinalizer(&opBatcher, OpBatcherFinalizer) {
+		controllerutil.AddFinalizer(&opBatcher, OpBatcherFinalizer)
+		if err := r.Update(ctx, &opBatcher); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Validate configuration
+	if err := r.validateConfiguration(&opBatcher); err != nil {
+		utils.SetCondition(&opBatcher.Status.Conditions, "ConfigurationValid", metav1.ConditionFalse, "InvalidConfiguration", err.Error())
+		opBatcher.Status.Phase = OpBatcherPhaseError
+		opBatcher.Status.ObservedGeneration = opBatcher.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opBatcher); statusErr != nil {
+			logger.Error(statusErr, "failed to update status after validation error")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
+	}
+
+	utils.SetCondition(&opBatcher.Status.Conditions, "ConfigurationValid", metav1.ConditionTrue, "ValidConfiguration", "OpBatcher configuration is valid")
+
+	// Fetch referenced OptimismNetwork
+	network, err := r.fetchOptimismNetwork(ctx, &opBatcher)
+	if err != nil {
+		utils.SetCondition(&opBatcher.Status.Conditions, "NetworkReference", metav1.ConditionFalse, "NetworkNotFound", fmt.Sprintf("Failed to fetch OptimismNetwork: %v", err))
+		opBatcher.Status.Phase = OpBatcherPhaseError
+		opBatcher.Status.ObservedGeneration = opBatcher.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opBatcher); statusErr != nil {
+			logger.Error(statusErr, "failed to update status after network fetch error")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute * 2}, nil
+	}
+
+	utils.SetCondition(&opBatcher.Status.Conditions, "NetworkReference", metav1.ConditionTrue, "NetworkFound", "OptimismNetwork reference resolved successfully")
+
+	if network.Status.Phase != PhaseReady {
+		utils.SetCondition(&opBatcher.Status.Conditions, "NetworkReady", metav1.ConditionFalse, "NetworkNotReady", "OptimismNetwork is not ready")
+		opBatcher.Status.Phase = OpBatcherPhasePending
+		opBatcher.Status.ObservedGeneration = opBatcher.Generation
+		if err := r.updateStatusWithRetry(ctx, &opBatcher); err != nil {
+			logger.Error(err, "failed to update status for network pending")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}
+	utils.SetCondition(&opBatcher.Status.Conditions, "NetworkReady", metav1.ConditionTrue, "NetworkReady", "OptimismNetwork is ready")
+
+	// Resolve the sequencer OpNode whose blocks are batched
+	sequencer, err := r.fetchSequencer(ctx, &opBatcher)
+	if err != nil {
+		utils.SetCondition(&opBatcher.Status.Conditions, "SequencerReference", metav1.ConditionFalse, "SequencerNotFound", fmt.Sprintf("Failed to resolve sequencer: %v", err))
+		opBatcher.Status.Phase = OpBatcherPhasePending
+		opBatcher.Status.ObservedGeneration = opBatcher.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opBatcher); statusErr != nil {
+			logger.Error(statusErr, "failed to update status after sequencer fetch error")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}
+	utils.SetCondition(&opBatcher.Status.Conditions, "SequencerReference", metav1.ConditionTrue, "SequencerFound", "Sequencer OpNode reference resolved successfully")
+
+	// 1) Reconcile Deployment
+	if err := r.reconcileDeployment(ctx, &opBatcher, network, sequencer); err != nil {
+		utils.SetCondition(&opBatcher.Status.Conditions, "DeploymentReady", metav1.ConditionFalse, "DeploymentReconciliationFailed", fmt.Sprintf("Failed to reconcile Deployment: %v", err))
+		opBatcher.Status.Phase = OpBatcherPhaseError
+		goto updateStatus
+	}
+	utils.SetCondition(&opBatcher.Status.Conditions, "DeploymentReady", metav1.ConditionTrue, "DeploymentReconciled", "Deployment is ready")
+
+	// 2) Reconcile Service
+	if err := r.reconcileService(ctx, &opBatcher, network); err != nil {
+		utils.SetCondition(&opBatcher.Status.Conditions, "ServiceReady", metav1.ConditionFalse, "ServiceReconciliationFailed", fmt.Sprintf("Failed to reconcile Service: %v", err))
+		opBatcher.Status.Phase = OpBatcherPhaseError
+		goto updateStatus
+	}
+	utils.SetCondition(&opBatcher.Status.Conditions, "ServiceReady", metav1.ConditionTrue, "ServiceReconciled", "Service is ready")
+
+	// 3) All done
+	r.updateBatcherStatus(ctx, &opBatcher, network)
+	opBatcher.Status.Phase = OpBatcherPhaseRunning
+
+updateStatus:
+	// Consolidated status update
+	opBatcher.Status.ObservedGeneration = opBatcher.Generation
+	if err := r.updateStatusWithRetry(ctx, &opBatcher); err != nil {
+		logger.Error(err, "failed to update status")
+	}
+	// Decide requeue interval
+	var requeueAfter time.Duration
+	switch opBatcher.Status.Phase {
+	case OpBatcherPhaseError:
+		requeueAfter = time.Minute * 2
+	case OpBatcherPhaseRunning:
+		// Poll more frequently than OpNode so batch submissions are tracked closely
+		requeueAfter = time.Minute
+	default:
+		requeueAfter = time.Minute
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// validateConfiguration validates the OpBatcher configuration
+func (r *OpBatcherReconciler) validateConfiguration(opBatcher *optimismv1alpha1.OpBatcher) error {
+	if opBatcher.Spec.OptimismNetworkRef.Name == "" {
+		return fmt.Errorf("optimismNetworkRef.name is required")
+	}
+
+	if opBatcher.Spec.SequencerRef.Name == "" {
+		return fmt.Errorf("sequencerRef.name is required")
+	}
+
+	// The batcher key must hold funds on L1, so it cannot be generated by the operator
+	if opBatcher.Spec.PrivateKey.SecretRef == nil {
+		return fmt.Errorf("privateKey.secretRef is required")
+	}
+	if opBatcher.Spec.PrivateKey.Generate {
+		return fmt.Errorf("privateKey.generate is not supported for op-batcher; provide a funded key via secretRef")
+	}
+
+	if da := opBatcher.Spec.DataAvailability; da != nil && da.Type != "" {
+		if da.Type != "calldata" && da.Type != "blobs" && da.Type != "auto" {
+			return fmt.Errorf("dataAvailability.type must be 'calldata', 'blobs' or 'auto'")
+		}
+	}
+
+	if batching := opBatcher.Spec.Batching; batching != nil {
+		if batching.MaxChannelDuration < 0 {
+			return fmt.Errorf("batching.maxChannelDuration must not be negative")
+		}
+		if batching.SubSafetyMargin < 0 {
+			return fmt.Errorf("batching.subSafetyMargin must not be negative")
+		}
+		if batching.PollInterval != "" {
+			if _, err := time.ParseDuration(batching.PollInterval); err != nil {
+				return fmt.Errorf("batching.pollInterval is not a valid duration: %w", err)
+			}
+		}
+	}
+
+	if throttling := opBatcher.Spec.Throttling; throttling != nil {
+		if throttling.Threshold < 0 || throttling.TxSize < 0 || throttling.BlockSize < 0 {
+			return fmt.Errorf("throttling limits must not be negative")
+		}
+	}
+
+	return nil
+}
+
+// fetchOptimismNetwork fetches the referenced OptimismNetwork
+func (r *OpBatcherReconciler) fetchOptimismNetwork(ctx context.Context, opBatcher *optimismv1alpha1.OpBatcher) (*optimismv1alpha1.OptimismNetwork, error) {
+	namespace := opBatcher.Spec.OptimismNetworkRef.Namespace
+	if namespace == "" {
+		namespace = opBatcher.Namespace
+	}
+
+	var network optimismv1alpha1.OptimismNetwork
+	key := k8stypes.NamespacedName{
+		Name:      opBatcher.Spec.OptimismNetworkRef.Name,
+		Namespace: namespace,
+	}
+
+	if err := r.Get(ctx, key, &network); err != nil {
+		return nil, err
+	}
+
+	return &network, nil
+}
+
+// fetchSequencer fetches the referenced sequencer OpNode and verifies its node type
+func (r *OpBatcherReconciler) fetchSequencer(ctx context.Context, opBatcher *optimismv1alpha1.OpBatcher) (*optimismv1alpha1.OpNode, error) {
+	namespace := opBatcher.Spec.SequencerRef.Namespace
+	if namespace == "" {
+		namespace = opBatcher.Namespace
+	}
+
+	var sequencer optimismv1alpha1.OpNode
+	key := k8stypes.NamespacedName{
+		Name:      opBatcher.Spec.SequencerRef.Name,
+		Namespace: namespace,
+	}
+
+	if err := r.Get(ctx, key, &sequencer); err != nil {
+		return nil, err
+	}
+
+	if sequencer.Spec.NodeType != "sequencer" {
+		return nil, fmt.Errorf("OpNode %s/%s is not a sequencer", namespace, sequencer.Name)
+	}
+
+	return &sequencer, nil
+}
+
+// reconcileDeployment manages the Deployment for OpBatcher
+func (r *OpBatcherReconciler) reconcileDeployment(
+	ctx context.Context,
+	opBatcher *optimismv1alpha1.OpBatcher,
+	network *optimismv1alpha1.OptimismNetwork,
+	sequencer *optimismv1alpha1.OpNode,
+) error {
+	desiredDeployment := resources.CreateOpBatcherDeployment(opBatcher, network, sequencer)
+
+	if err := ctrl.SetControllerReference(opBatcher, desiredDeployment, r.Scheme); err != nil {
+		return err
+	}
+
+	var currentDeployment appsv1.Deployment
+	key := k8stypes.NamespacedName{Name: opBatcher.Name, Namespace: opBatcher.Namespace}
+
+	if err := r.Get(ctx, key, &currentDeployment); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		// Create new Deployment
+		return r.Create(ctx, desiredDeployment)
+	}
+
+	// Update existing Deployment if needed
+	currentDeployment.Spec = desiredDeployment.Spec
+	currentDeployment.Labels = desiredDeployment.Labels
+	currentDeployment.Annotations = desiredDeployment.Annotations
+
+	return r.Update(ctx, &currentDeployment)
+}
+
+// reconcileService manages the Service for OpBatcher
+func (r *OpBatcherReconciler) reconcileService(ctx context.Context, opBatcher *optimismv1alpha1.OpBatcher, network *optimismv1alpha1.OptimismNetwork) error {
+	desiredService := resources.CreateOpBatcherService(opBatcher, network)
+
+	if err := ctrl.SetControllerReference(opBatcher, desiredService, r.Scheme); err != nil {
+		return err
+	}
+
+	var currentService corev1.Service
+	key := k8stypes.NamespacedName{Name: opBatcher.Name, Namespace: opBatcher.Namespace}
+
+	if err := r.Get(ctx, key, &currentService); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		// Create new Service
+		return r.Create(ctx, desiredService)
+	}
+
+	// Update existing Service if needed
+	currentService.Spec.Ports = desiredService.Spec.Ports
+	currentService.Spec.Type = desiredService.Spec.Type
+	currentService.Labels = desiredService.Labels
+	currentService.Annotations = desiredService.Annotations
+
+	return r.Update(ctx, &currentService)
+}
+
+// updateBatcherStatus refreshes the operational batcher information.
+// Every step is best effort: a failure is logged and the previous value is kept.
+func (r *OpBatcherReconciler) updateBatcherStatus(ctx context.Context, opBatcher *optimismv1alpha1.OpBatcher, network *optimismv1alpha1.OptimismNetwork) {
+	logger := log.FromContext(ctx)
+
+	if opBatcher.Status.BatcherInfo == nil {
+		opBatcher.Status.BatcherInfo = &optimismv1alpha1.BatcherInfo{}
+	}
+	info := opBatcher.Status.BatcherInfo
+
+	address, err := resolveSignerAddress(ctx, r.Client, opBatcher.Namespace, opBatcher.Spec.PrivateKey.SecretRef)
+	if err != nil {
+		logger.Error(err, "failed to derive batcher address")
+	} else {
+		info.BatcherAddress = address.Hex()
+		if err := r.scanBatchSubmissions(ctx, network, address, info); err != nil {
+			logger.Error(err, "failed to scan L1 for batch submissions")
+		}
+	}
+
+	if opBatcher.Spec.Metrics == nil || opBatcher.Spec.Metrics.Enabled {
+		metricsURL := fmt.Sprintf("http://%s.%s.svc.cluster.local:%d/metrics",
+			opBatcher.Name, opBatcher.Namespace, resources.GetOpBatcherMetricsPort(opBatcher))
+		pending, err := scrapeGauge(ctx, metricsURL, batcherChannelQueueMetric)
+		if err != nil {
+			logger.V(1).Info("unable to scrape op-batcher metrics", "error", err.Error())
+		} else {
+			info.PendingChannels = int32(pending)
+		}
+	}
+}
+
+// resolveSignerAddress derives the L1 address of a private key stored in a secret
+func resolveSignerAddress(ctx context.Context, c client.Reader, namespace string, secretRef *corev1.SecretKeySelector) (common.Address, error) {
+	if secretRef == nil {
+		return common.Address{}, fmt.Errorf("no private key secret configured")
+	}
+
+	var secret corev1.Secret
+	key := k8stypes.NamespacedName{Name: secretRef.Name, Namespace: namespace}
+	if err := c.Get(ctx, key, &secret); err != nil {
+		return common.Address{}, err
+	}
+
+	raw, ok := secret.Data[secretRef.Key]
+	if !ok {
+		return common.Address{}, fmt.Errorf("key %q not found in secret %s", secretRef.Key, secretRef.Name)
+	}
+
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(raw)), "0x"))
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid private key in secret %s: %w", secretRef.Name, err)
+	}
+
+	return crypto.PubkeyToAddress(privateKey.PublicKey), nil
+}
+
+// scanBatchSubmissions searches recent L1 blocks for transactions the batcher sent to the
+// batch inbox of the network; other transactions of the batcher account are not batches
+func (r *OpBatcherReconciler) scanBatchSubmissions(
+	ctx context.Context,
+	network *optimismv1alpha1.OptimismNetwork,
+	batcher common.Address,
+	info *optimismv1alpha1.BatcherInfo,
+) (err error) {
+	var inbox common.Address
+	if info := network.Status.NetworkInfo; info != nil && info.DiscoveredContracts != nil &&
+		common.IsHexAddress(info.DiscoveredContracts.BatchInboxAddr) {
+		inbox = common.HexToAddress(info.DiscoveredContracts.BatchInboxAddr)
+	} else {
+		return fmt.Errorf("batch inbox address of network %s is not known", network.Name)
+	}
+
+	timeout := 10 * time.Second
+	if network.Spec.L1RpcTimeout != 0 {
+		timeout = network.Spec.L1RpcTimeout
+	}
+
+	scanCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-	// TODO(user): your logic here
+	l1RpcURL, err := activeL1RpcURL(scanCtx, r.Client, network)
+	if err != nil {
+		return err
+	}
+	defer func() { err = redactL1URL(err, optimismv1alpha1.ActiveL1RpcEndpoint(network), l1RpcURL) }()
+
+	l1Client, err := ethclient.DialContext(scanCtx, l1RpcURL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to L1 RPC: %w", err)
+	}
+	defer l1Client.Close()
+
+	head, err := l1Client.BlockNumber(scanCtx)
+	if err != nil {
+		return fmt.Errorf("failed to get L1 head: %w", err)
+	}
+
+	// Only look at blocks not scanned before, bounded to the most recent window
+	start := uint64(info.LastScannedL1Block) + 1
+	if head >= maxBatcherScanBlocks && start < head-maxBatcherScanBlocks+1 {
+		start = head - maxBatcherScanBlocks + 1
+	}
+
+	signer := types.LatestSignerForChainID(big.NewInt(network.Spec.L1ChainID))
+	for number := head; number >= start && number > 0; number-- {
+		block, err := l1Client.BlockByNumber(scanCtx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return fmt.Errorf("failed to fetch L1 block %d: %w", number, err)
+		}
+
+		for _, tx := range block.Transactions() {
+			if tx.To() == nil || *tx.To() != inbox {
+				continue
+			}
+			sender, err := types.Sender(signer, tx)
+			if err != nil || sender != batcher {
+				continue
+			}
+			info.LastBatchSubmitted = &optimismv1alpha1.BatchSubmissionInfo{
+				BlockNumber:     int64(number),
+				TransactionHash: tx.Hash().Hex(),
+				Timestamp:       metav1.NewTime(time.Unix(int64(block.Time()), 0)),
+			}
+		}
+
+		// Scanning newest first, so the first block with a batch holds the latest submission
+		if info.LastBatchSubmitted != nil && info.LastBatchSubmitted.BlockNumber == int64(number) {
+			break
+		}
+	}
+
+	info.LastScannedL1Block = int64(head)
+	return nil
+}
+
+// scrapeGauge fetches a Prometheus text exposition and returns the value of the named metric
+func scrapeGauge(ctx context.Context, url, metric string) (float64, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, metric) {
+			continue
+		}
+		fields := strings.Fields(line)
+		// Skip metrics that merely share the prefix
+		if len(fields) < 2 || (fields[0] != metric && !strings.HasPrefix(fields[0], metric+"{")) {
+			continue
+		}
+		return strconv.ParseFloat(fields[1], 64)
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("metric %s not found", metric)
+}
+
+// handleDeletion handles the deletion of OpBatcher resources
+func (r *OpBatcherReconciler) handleDeletion(ctx context.Context, opBatcher *optimismv1alpha1.OpBatcher) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Owned Deployment and Service are removed through owner references
+	logger.Info("Cleaning up OpBatcher resources", "name", opBatcher.Name)
+
+	// Remove finalizer
+	controllerutil.RemoveFinalizer(opBatcher, OpBatcherFinalizer)
+	if err := r.Update(ctx, opBatcher); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	return ctrl.Result{}, nil
 }
 
+// updateStatusWithRetry updates the OpBatcher status with retry logic to handle conflicts
+func (r *OpBatcherReconciler) updateStatusWithRetry(ctx context.Context, opBatcher *optimismv1alpha1.OpBatcher) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// Get the latest version of the resource
+		latest := &optimismv1alpha1.OpBatcher{}
+		if err := r.Get(ctx, k8stypes.NamespacedName{Name: opBatcher.Name, Namespace: opBatcher.Namespace}, latest); err != nil {
+			return err
+		}
+
+		// Copy status fields from opBatcher to latest to avoid race conditions
+		latest.Status.Phase = opBatcher.Status.Phase
+		latest.Status.ObservedGeneration = opBatcher.Status.ObservedGeneration
+		latest.Status.Conditions = opBatcher.Status.Conditions
+		if opBatcher.Status.BatcherInfo != nil {
+			latest.Status.BatcherInfo = opBatcher.Status.BatcherInfo.DeepCopy()
+		}
+
+		return r.Status().Update(ctx, latest)
+	})
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *OpBatcherReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&optimismv1alpha1.OpBatcher{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&corev1.Service{}).
+		Watches(&optimismv1alpha1.OptimismNetwork{}, handler.EnqueueRequestsFromMapFunc(networkDependents(mgr.GetClient(), &optimismv1alpha1.OpBatcherList{},
+			func(obj client.Object) optimismv1alpha1.OptimismNetworkRef {
+				return obj.(*optimismv1alpha1.OpBatcher).Spec.OptimismNetworkRef
+			})), builder.WithPredicates(l1EndpointSwitched)).
 		Named("opbatcher").
 		Complete(r)
 }
@@ -18,9 +18,16 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -28,6 +35,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
 )
 
 var _ = Describe("OpBatcher Controller", func() {
@@ -38,7 +46,7 @@ var _ = Describe("OpBatcher Controller", func() {
 
 		typeNamespacedName := types.NamespacedName{
 			Name:      resourceName,
-			Namespace: "default", // TODO(user):Modify as needed
+			Namespace: "default",
 		}
 		opbatcher := &optimismv1alpha1.OpBatcher{}
 
@@ -51,14 +59,26 @@ var _ = Describe("OpBatcher Controller", func() {
 						Name:      resourceName,
 						Namespace: "default",
 					},
-					// TODO(user): Specify other spec details if needed.
+					Spec: optimismv1alpha1.OpBatcherSpec{
+						OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{
+							Name: "test-network",
+						},
+						SequencerRef: optimismv1alpha1.SequencerReference{
+							Name: "test-sequencer",
+						},
+						PrivateKey: optimismv1alpha1.SecretKeyRef{
+							SecretRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "batcher-key"},
+								Key:                  "private-key",
+							},
+						},
+					},
 				}
 				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
 			}
 		})
 
 		AfterEach(func() {
-			// TODO(user): Cleanup logic after each test, like removing the resource instance.
 			resource := &optimismv1alpha1.OpBatcher{}
 			err := k8sClient.Get(ctx, typeNamespacedName, resource)
 			Expect(err).NotTo(HaveOccurred())
@@ -77,8 +97,193 @@ var _ = Describe("OpBatcher Controller", func() {
 				NamespacedName: typeNamespacedName,
 			})
 			Expect(err).NotTo(HaveOccurred())
-			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
-			// Example: If you expect a certain status condition after reconciliation, verify it here.
+		})
+	})
+
+	Context("Configuration Validation", func() {
+		reconciler := &OpBatcherReconciler{}
+
+		newBatcher := func() *optimismv1alpha1.OpBatcher {
+			return &optimismv1alpha1.OpBatcher{
+				Spec: optimismv1alpha1.OpBatcherSpec{
+					OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "test-network"},
+					SequencerRef:       optimismv1alpha1.SequencerReference{Name: "test-sequencer"},
+					PrivateKey: optimismv1alpha1.SecretKeyRef{
+						SecretRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "batcher-key"},
+							Key:                  "private-key",
+						},
+					},
+				},
+			}
+		}
+
+		It("Should accept a minimal valid configuration", func() {
+			Expect(reconciler.validateConfiguration(newBatcher())).To(Succeed())
+		})
+
+		It("Should require a private key secret", func() {
+			batcher := newBatcher()
+			batcher.Spec.PrivateKey.SecretRef = nil
+			Expect(reconciler.validateConfiguration(batcher)).To(MatchError(ContainSubstring("privateKey.secretRef")))
+		})
+
+		It("Should require a sequencer reference", func() {
+			batcher := newBatcher()
+			batcher.Spec.SequencerRef.Name = ""
+			Expect(reconciler.validateConfiguration(batcher)).To(MatchError(ContainSubstring("sequencerRef.name")))
+		})
+
+		It("Should reject unknown data availability types", func() {
+			batcher := newBatcher()
+			batcher.Spec.DataAvailability = &optimismv1alpha1.DataAvailabilityConfig{Type: "celestia"}
+			Expect(reconciler.validateConfiguration(batcher)).NotTo(Succeed())
+		})
+
+		It("Should reject an invalid poll interval", func() {
+			batcher := newBatcher()
+			batcher.Spec.Batching = &optimismv1alpha1.BatchingConfig{PollInterval: "soon"}
+			Expect(reconciler.validateConfiguration(batcher)).NotTo(Succeed())
+		})
+	})
+
+	Context("Deployment Rendering", func() {
+		network := &optimismv1alpha1.OptimismNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-network", Namespace: "default"},
+			Spec: optimismv1alpha1.OptimismNetworkSpec{
+				NetworkName: "op-sepolia",
+				ChainID:     11155420,
+				L1ChainID:   11155111,
+				L1RpcUrl:    "https://sepolia.example.com",
+			},
+		}
+		sequencer := &optimismv1alpha1.OpNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-sequencer", Namespace: "default"},
+			Spec: optimismv1alpha1.OpNodeSpec{
+				NodeType: "sequencer",
+			},
+		}
+
+		It("Should resolve L1 and L2 endpoints and keep the key out of args", func() {
+			batcher := &optimismv1alpha1.OpBatcher{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-batcher", Namespace: "default"},
+				Spec: optimismv1alpha1.OpBatcherSpec{
+					PrivateKey: optimismv1alpha1.SecretKeyRef{
+						SecretRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "batcher-key"},
+							Key:                  "private-key",
+						},
+					},
+					Batching: &optimismv1alpha1.BatchingConfig{
+						MaxChannelDuration: 1500,
+						SubSafetyMargin:    10,
+					},
+					DataAvailability: &optimismv1alpha1.DataAvailabilityConfig{Type: "blobs"},
+				},
+			}
+
+			deployment := resources.CreateOpBatcherDeployment(batcher, network, sequencer)
+			Expect(*deployment.Spec.Replicas).To(Equal(int32(1)))
+
+			container := deployment.Spec.Template.Spec.Containers[0]
+			Expect(container.Args).To(ContainElements(
+				"--l1-eth-rpc=https://sepolia.example.com",
+				"--l2-eth-rpc=http://test-sequencer.default.svc.cluster.local:8545",
+				"--rollup-rpc=http://test-sequencer.default.svc.cluster.local:9545",
+				"--max-channel-duration=1500",
+				"--sub-safety-margin=10",
+				"--data-availability-type=blobs",
+			))
+			Expect(container.Env[0].Name).To(Equal("OP_BATCHER_PRIVATE_KEY"))
+			Expect(container.Env[0].ValueFrom.SecretKeyRef.Name).To(Equal("batcher-key"))
+		})
+	})
+
+	Context("Batch Submissions", func() {
+		ctx := context.Background()
+		inbox := common.HexToAddress("0xff00000000000000000000000000000011155420")
+
+		// newL1Stub serves L1 blocks 100 and 101 holding the given transactions
+		newL1Stub := func(txs map[uint64][]*ethtypes.Transaction) string {
+			server := newJSONRPCStub(map[string]interface{}{
+				"eth_blockNumber": "0x65",
+				"eth_getBlockByNumber": jsonRPCHandler(func(params json.RawMessage) (interface{}, error) {
+					var args []interface{}
+					if err := json.Unmarshal(params, &args); err != nil {
+						return nil, err
+					}
+					number, err := hexutil.DecodeUint64(args[0].(string))
+					if err != nil {
+						return nil, err
+					}
+					header := &ethtypes.Header{
+						Number:     new(big.Int).SetUint64(number),
+						Time:       1700000000 + number*12,
+						Difficulty: big.NewInt(0),
+						UncleHash:  ethtypes.EmptyUncleHash,
+						TxHash:     ethtypes.EmptyTxsHash,
+					}
+					// The client only checks the transactions root against an empty block
+					if len(txs[number]) > 0 {
+						header.TxHash = common.Hash{1}
+					}
+					block := map[string]interface{}{}
+					raw, err := json.Marshal(header)
+					if err != nil {
+						return nil, err
+					}
+					if err := json.Unmarshal(raw, &block); err != nil {
+						return nil, err
+					}
+					block["transactions"] = append([]*ethtypes.Transaction{}, txs[number]...)
+					block["uncles"] = []string{}
+					return block, nil
+				}),
+			})
+			DeferCleanup(server.Close)
+			return server.URL
+		}
+
+		It("Should only count batcher transactions sent to the batch inbox", func() {
+			key, err := crypto.GenerateKey()
+			Expect(err).NotTo(HaveOccurred())
+			signer := ethtypes.LatestSignerForChainID(big.NewInt(11155111))
+			send := func(nonce uint64, to common.Address) *ethtypes.Transaction {
+				tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.DynamicFeeTx{
+					ChainID: big.NewInt(11155111), Nonce: nonce, To: &to, Gas: 21000,
+					GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1),
+				})
+				Expect(err).NotTo(HaveOccurred())
+				return tx
+			}
+			batch := send(0, inbox)
+			transfer := send(1, common.HexToAddress("0x1111111111111111111111111111111111111111"))
+
+			network := &optimismv1alpha1.OptimismNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "op-sepolia", Namespace: "default"},
+				Spec: optimismv1alpha1.OptimismNetworkSpec{
+					ChainID:   11155420,
+					L1ChainID: 11155111,
+					L1RpcUrl:  newL1Stub(map[uint64][]*ethtypes.Transaction{100: {batch}, 101: {transfer}}),
+				},
+				Status: optimismv1alpha1.OptimismNetworkStatus{NetworkInfo: &optimismv1alpha1.NetworkInfo{
+					DiscoveredContracts: &optimismv1alpha1.NetworkContractAddresses{BatchInboxAddr: inbox.Hex()},
+				}},
+			}
+			reconciler := &OpBatcherReconciler{Client: newFakeClient()}
+			info := &optimismv1alpha1.BatcherInfo{}
+			Expect(reconciler.scanBatchSubmissions(ctx, network, crypto.PubkeyToAddress(key.PublicKey), info)).To(Succeed())
+
+			// The newer transfer from the batcher account is not a batch
+			Expect(info.LastBatchSubmitted).NotTo(BeNil())
+			Expect(info.LastBatchSubmitted.BlockNumber).To(Equal(int64(100)))
+			Expect(info.LastBatchSubmitted.TransactionHash).To(Equal(batch.Hash().Hex()))
+			Expect(info.LastScannedL1Block).To(Equal(int64(101)))
+
+			// Without a known inbox, no transaction can be attributed to batching
+			network.Status.NetworkInfo = nil
+			err = reconciler.scanBatchSubmissions(ctx, network, crypto.PubkeyToAddress(key.PublicKey), &optimismv1alpha1.BatcherInfo{})
+			Expect(err).To(MatchError(ContainSubstring("batch inbox address of network op-sepolia is not known")))
 		})
 	})
 })
@@ -18,15 +18,42 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/utils"
 )
 
+// OpChallengerFinalizer is the finalizer for OpChallenger resources
+const OpChallengerFinalizer = "opchallenger.optimism.io/finalizer"
+
+// Phase constants for OpChallenger status
+const (
+	OpChallengerPhasePending = "Pending"
+	OpChallengerPhaseRunning = "Running"
+	OpChallengerPhaseError   = "Error"
+	OpChallengerPhaseStopped = "Stopped"
+)
+
+// challengerInProgressGamesMetric is the op-challenger series counting unresolved tracked games
+const challengerInProgressGamesMetric = `op_challenger_default_tracked_games{status="in_progress"}`
+
 // OpChallengerReconciler reconciles a OpChallenger object
 type OpChallengerReconciler struct {
 	client.Client
@@ -36,28 +63,423 @@ type OpChallengerReconciler struct {
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=opchallengers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=opchallengers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=opchallengers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=optimism.optimism.io,resources=opnodes;optimismnetworks,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services;persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the OpChallenger object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.20.0/pkg/reconcile
 func (r *OpChallengerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	logger := log.FromContext(ctx)
+
+	// Fetch the OpChallenger instance
+	var opChallenger optimismv1alpha1.OpChallenger
+	if err := r.Get(ctx, req.NamespacedName, &opChallenger); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "unable to fetch OpChallenger")
+		return ctrl.Result{}, err
+	}
+
+	// Handle deletion
+	if opChallenger.DeletionTimestamp != nil {
+		return r.handleDeletion(ctx, &opChallenger)
+	}
+
+	// Add finalizer if not present
+	if !controllerutil.ContainsFinalizer(&opChallenger, OpChallengerFinalizer) {
+		controllerutil.AddFinalizer(&opChallenger, OpChallengerFinalizer)
+		if err := r.Update(ctx, &opChallenger); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Validate configuration
+	if err := r.validateConfiguration(&opChallenger); err != nil {
+		utils.SetCondition(&opChallenger.Status.Conditions, "ConfigurationValid", metav1.ConditionFalse, "InvalidConfiguration", err.Error())
+		opChallenger.Status.Phase = OpChallengerPhaseError
+		opChallenger.Status.ObservedGeneration = opChallenger.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opChallenger); statusErr != nil {
+			logger.Error(statusErr, "failed to update status after validation error")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
+	}
+
+	utils.SetCondition(&opChallenger.Status.Conditions, "ConfigurationValid", metav1.ConditionTrue, "ValidConfiguration", "OpChallenger configuration is valid")
+
+	// Fetch referenced OptimismNetwork
+	network, err := r.fetchOptimismNetwork(ctx, &opChallenger)
+	if err != nil {
+		utils.SetCondition(&opChallenger.Status.Conditions, "NetworkReference", metav1.ConditionFalse, "NetworkNotFound", fmt.Sprintf("Failed to fetch OptimismNetwork: %v", err))
+		opChallenger.Status.Phase = OpChallengerPhaseError
+		opChallenger.Status.ObservedGeneration = opChallenger.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opChallenger); statusErr != nil {
+			logger.Error(statusErr, "failed to update status after network fetch error")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute * 2}, nil
+	}
+
+	utils.SetCondition(&opChallenger.Status.Conditions, "NetworkReference", metav1.ConditionTrue, "NetworkFound", "OptimismNetwork reference resolved successfully")
+
+	if network.Status.Phase != PhaseReady {
+		utils.SetCondition(&opChallenger.Status.Conditions, "NetworkReady", metav1.ConditionFalse, "NetworkNotReady", "OptimismNetwork is not ready")
+		opChallenger.Status.Phase = OpChallengerPhasePending
+		opChallenger.Status.ObservedGeneration = opChallenger.Generation
+		if err := r.updateStatusWithRetry(ctx, &opChallenger); err != nil {
+			logger.Error(err, "failed to update status for network pending")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}
+	utils.SetCondition(&opChallenger.Status.Conditions, "NetworkReady", metav1.ConditionTrue, "NetworkReady", "OptimismNetwork is ready")
+
+	// op-challenger needs the L1 beacon API to read blob data
+	if !optimismv1alpha1.HasL1Beacon(network) {
+		utils.SetCondition(&opChallenger.Status.Conditions, "ConfigurationValid", metav1.ConditionFalse, "L1BeaconMissing",
+			"OptimismNetwork does not define l1BeaconUrl or l1BeaconUrlSecretRef, which op-challenger requires")
+		opChallenger.Status.Phase = OpChallengerPhaseError
+		opChallenger.Status.ObservedGeneration = opChallenger.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opChallenger); statusErr != nil {
+			logger.Error(statusErr, "failed to update status for missing L1 beacon")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
+	}
+
+	// Dispute games are only created through the DisputeGameFactory
+	gameFactory := resources.GetOpChallengerGameFactory(network)
+	if gameFactory == "" {
+		utils.SetCondition(&opChallenger.Status.Conditions, "GameFactoryResolved", metav1.ConditionFalse, "GameFactoryMissing",
+			"OptimismNetwork has no discovered DisputeGameFactory address")
+		opChallenger.Status.Phase = OpChallengerPhasePending
+		opChallenger.Status.ObservedGeneration = opChallenger.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opChallenger); statusErr != nil {
+			logger.Error(statusErr, "failed to update status for missing game factory")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute * 2}, nil
+	}
+	utils.SetCondition(&opChallenger.Status.Conditions, "GameFactoryResolved", metav1.ConditionTrue, "GameFactoryFound",
+		fmt.Sprintf("Monitoring DisputeGameFactory at %s", gameFactory))
+
+	// Resolve the OpNode serving the rollup and L2 RPCs
+	rollupNode, err := r.fetchRollupNode(ctx, &opChallenger)
+	if err != nil {
+		utils.SetCondition(&opChallenger.Status.Conditions, "RollupRPCReference", metav1.ConditionFalse, "OpNodeNotFound", fmt.Sprintf("Failed to resolve rollup RPC OpNode: %v", err))
+		opChallenger.Status.Phase = OpChallengerPhasePending
+		opChallenger.Status.ObservedGeneration = opChallenger.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opChallenger); statusErr != nil {
+			logger.Error(statusErr, "failed to update status after rollup node fetch error")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}
+	utils.SetCondition(&opChallenger.Status.Conditions, "RollupRPCReference", metav1.ConditionTrue, "OpNodeFound", "Rollup RPC OpNode reference resolved successfully")
+
+	// 1) Reconcile Service (governing Service for the StatefulSet)
+	if err := r.reconcileService(ctx, &opChallenger, network); err != nil {
+		utils.SetCondition(&opChallenger.Status.Conditions, "ServiceReady", metav1.ConditionFalse, "ServiceReconciliationFailed", fmt.Sprintf("Failed to reconcile Service: %v", err))
+		opChallenger.Status.Phase = OpChallengerPhaseError
+		goto updateStatus
+	}
+	utils.SetCondition(&opChallenger.Status.Conditions, "ServiceReady", metav1.ConditionTrue, "ServiceReconciled", "Service is ready")
+
+	// 2) Reconcile StatefulSet
+	if err := r.reconcileStatefulSet(ctx, &opChallenger, network, rollupNode); err != nil {
+		utils.SetCondition(&opChallenger.Status.Conditions, "StatefulSetReady", metav1.ConditionFalse, "StatefulSetReconciliationFailed", fmt.Sprintf("Failed to reconcile StatefulSet: %v", err))
+		opChallenger.Status.Phase = OpChallengerPhaseError
+		goto updateStatus
+	}
+	utils.SetCondition(&opChallenger.Status.Conditions, "StatefulSetReady", metav1.ConditionTrue, "StatefulSetReconciled", "StatefulSet is ready")
+
+	// 3) All done
+	r.updateChallengerStatus(ctx, &opChallenger, gameFactory)
+	opChallenger.Status.Phase = OpChallengerPhaseRunning
 
-	// TODO(user): your logic here
+updateStatus:
+	// Consolidated status update
+	opChallenger.Status.ObservedGeneration = opChallenger.Generation
+	if err := r.updateStatusWithRetry(ctx, &opChallenger); err != nil {
+		logger.Error(err, "failed to update status")
+	}
+	// Decide requeue interval
+	var requeueAfter time.Duration
+	switch opChallenger.Status.Phase {
+	case OpChallengerPhaseError:
+		requeueAfter = time.Minute * 2
+	case OpChallengerPhaseRunning:
+		requeueAfter = time.Minute * 5
+	default:
+		requeueAfter = time.Minute
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// validateConfiguration validates the OpChallenger configuration
+func (r *OpChallengerReconciler) validateConfiguration(opChallenger *optimismv1alpha1.OpChallenger) error {
+	if opChallenger.Spec.OptimismNetworkRef.Name == "" {
+		return fmt.Errorf("optimismNetworkRef.name is required")
+	}
+
+	if opChallenger.Spec.RollupRPCRef.Name == "" {
+		return fmt.Errorf("rollupRpcRef.name is required")
+	}
+
+	// The challenger key must hold funds for bonds on L1, so it cannot be generated by the operator
+	if opChallenger.Spec.PrivateKey.SecretRef == nil {
+		return fmt.Errorf("privateKey.secretRef is required")
+	}
+	if opChallenger.Spec.PrivateKey.Generate {
+		return fmt.Errorf("privateKey.generate is not supported for op-challenger; provide a funded key via secretRef")
+	}
+
+	if len(opChallenger.Spec.TraceTypes) == 0 {
+		return fmt.Errorf("at least one trace type is required")
+	}
+	seen := make(map[optimismv1alpha1.TraceType]bool)
+	for _, traceType := range opChallenger.Spec.TraceTypes {
+		switch traceType {
+		case optimismv1alpha1.TraceTypeCannon, optimismv1alpha1.TraceTypeAsterisc, optimismv1alpha1.TraceTypePermissioned:
+		default:
+			return fmt.Errorf("unsupported trace type %q", traceType)
+		}
+		if seen[traceType] {
+			return fmt.Errorf("trace type %q is listed more than once", traceType)
+		}
+		seen[traceType] = true
+	}
+
+	if resources.OpChallengerUsesCannon(opChallenger) {
+		if err := validateVMTraceConfig("cannon", opChallenger.Spec.Cannon, false); err != nil {
+			return err
+		}
+	}
+	if resources.OpChallengerUsesAsterisc(opChallenger) {
+		// The asterisc VM and its program are not bundled in the op-challenger image
+		if err := validateVMTraceConfig("asterisc", opChallenger.Spec.Asterisc, true); err != nil {
+			return err
+		}
+	}
+
+	if opChallenger.Spec.MaxConcurrency < 0 {
+		return fmt.Errorf("maxConcurrency must not be negative")
+	}
+
+	return nil
+}
+
+// validateVMTraceConfig validates the prestate source and binaries of a fault proof VM
+func validateVMTraceConfig(vm string, vmConfig *optimismv1alpha1.VMTraceConfig, requirePaths bool) error {
+	if vmConfig == nil || vmConfig.Prestate == nil {
+		return fmt.Errorf("%s.prestate is required by the configured trace types", vm)
+	}
+
+	prestate := vmConfig.Prestate
+	if prestate.ConfigMapRef != nil && prestate.URL != "" {
+		return fmt.Errorf("%s.prestate must set only one of configMapRef or url", vm)
+	}
+	if prestate.ConfigMapRef == nil && prestate.URL == "" {
+		return fmt.Errorf("%s.prestate must set configMapRef or url", vm)
+	}
+	if prestate.ConfigMapRef != nil && (prestate.ConfigMapRef.Name == "" || prestate.ConfigMapRef.Key == "") {
+		return fmt.Errorf("%s.prestate.configMapRef requires name and key", vm)
+	}
+
+	if requirePaths && (vmConfig.BinaryPath == "" || vmConfig.ServerPath == "") {
+		return fmt.Errorf("%s.binaryPath and %s.serverPath are required", vm, vm)
+	}
+
+	return nil
+}
+
+// fetchOptimismNetwork fetches the referenced OptimismNetwork
+func (r *OpChallengerReconciler) fetchOptimismNetwork(ctx context.Context, opChallenger *optimismv1alpha1.OpChallenger) (*optimismv1alpha1.OptimismNetwork, error) {
+	namespace := opChallenger.Spec.OptimismNetworkRef.Namespace
+	if namespace == "" {
+		namespace = opChallenger.Namespace
+	}
+
+	var network optimismv1alpha1.OptimismNetwork
+	key := k8stypes.NamespacedName{
+		Name:      opChallenger.Spec.OptimismNetworkRef.Name,
+		Namespace: namespace,
+	}
+
+	if err := r.Get(ctx, key, &network); err != nil {
+		return nil, err
+	}
+
+	return &network, nil
+}
+
+// fetchRollupNode fetches the OpNode referenced for the rollup and L2 RPCs
+func (r *OpChallengerReconciler) fetchRollupNode(ctx context.Context, opChallenger *optimismv1alpha1.OpChallenger) (*optimismv1alpha1.OpNode, error) {
+	namespace := opChallenger.Spec.RollupRPCRef.Namespace
+	if namespace == "" {
+		namespace = opChallenger.Namespace
+	}
+
+	var opNode optimismv1alpha1.OpNode
+	key := k8stypes.NamespacedName{
+		Name:      opChallenger.Spec.RollupRPCRef.Name,
+		Namespace: namespace,
+	}
+
+	if err := r.Get(ctx, key, &opNode); err != nil {
+		return nil, err
+	}
+
+	// op-challenger reads output roots from op-node and block data from op-geth
+	if opNode.Spec.OpNode.RPC == nil || !opNode.Spec.OpNode.RPC.Enabled {
+		return nil, fmt.Errorf("OpNode %s/%s does not have the op-node RPC enabled", namespace, opNode.Name)
+	}
+	if opNode.Spec.OpGeth.Networking == nil || opNode.Spec.OpGeth.Networking.HTTP == nil || !opNode.Spec.OpGeth.Networking.HTTP.Enabled {
+		return nil, fmt.Errorf("OpNode %s/%s does not have the op-geth HTTP RPC enabled", namespace, opNode.Name)
+	}
+
+	return &opNode, nil
+}
+
+// reconcileStatefulSet manages the StatefulSet for OpChallenger
+func (r *OpChallengerReconciler) reconcileStatefulSet(
+	ctx context.Context,
+	opChallenger *optimismv1alpha1.OpChallenger,
+	network *optimismv1alpha1.OptimismNetwork,
+	rollupNode *optimismv1alpha1.OpNode,
+) error {
+	desiredStatefulSet := resources.CreateOpChallengerStatefulSet(opChallenger, network, rollupNode)
+
+	if err := ctrl.SetControllerReference(opChallenger, desiredStatefulSet, r.Scheme); err != nil {
+		return err
+	}
+
+	var currentStatefulSet appsv1.StatefulSet
+	key := k8stypes.NamespacedName{Name: opChallenger.Name, Namespace: opChallenger.Namespace}
+
+	if err := r.Get(ctx, key, &currentStatefulSet); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		// Create new StatefulSet
+		return r.Create(ctx, desiredStatefulSet)
+	}
+
+	// Update existing StatefulSet if needed
+	currentStatefulSet.Spec = desiredStatefulSet.Spec
+	currentStatefulSet.Labels = desiredStatefulSet.Labels
+	currentStatefulSet.Annotations = desiredStatefulSet.Annotations
+
+	return r.Update(ctx, &currentStatefulSet)
+}
+
+// reconcileService manages the Service for OpChallenger
+func (r *OpChallengerReconciler) reconcileService(ctx context.Context, opChallenger *optimismv1alpha1.OpChallenger, network *optimismv1alpha1.OptimismNetwork) error {
+	desiredService := resources.CreateOpChallengerService(opChallenger, network)
+
+	if err := ctrl.SetControllerReference(opChallenger, desiredService, r.Scheme); err != nil {
+		return err
+	}
+
+	var currentService corev1.Service
+	key := k8stypes.NamespacedName{Name: opChallenger.Name, Namespace: opChallenger.Namespace}
+
+	if err := r.Get(ctx, key, &currentService); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		// Create new Service
+		return r.Create(ctx, desiredService)
+	}
+
+	// Update existing Service if needed
+	currentService.Spec.Ports = desiredService.Spec.Ports
+	currentService.Spec.Type = desiredService.Spec.Type
+	currentService.Labels = desiredService.Labels
+	currentService.Annotations = desiredService.Annotations
+
+	return r.Update(ctx, &currentService)
+}
+
+// updateChallengerStatus refreshes the operational challenger information.
+// Every step is best effort: a failure is logged and the previous value is kept.
+func (r *OpChallengerReconciler) updateChallengerStatus(ctx context.Context, opChallenger *optimismv1alpha1.OpChallenger, gameFactory string) {
+	logger := log.FromContext(ctx)
+
+	if opChallenger.Status.ChallengerInfo == nil {
+		opChallenger.Status.ChallengerInfo = &optimismv1alpha1.ChallengerInfo{}
+	}
+	info := opChallenger.Status.ChallengerInfo
+	info.GameFactoryAddress = gameFactory
+
+	address, err := resolveSignerAddress(ctx, r.Client, opChallenger.Namespace, opChallenger.Spec.PrivateKey.SecretRef)
+	if err != nil {
+		logger.Error(err, "failed to derive challenger address")
+	} else {
+		info.ChallengerAddress = address.Hex()
+	}
+
+	if opChallenger.Spec.Metrics == nil || opChallenger.Spec.Metrics.Enabled {
+		metricsURL := fmt.Sprintf("http://%s.%s.svc.cluster.local:%d/metrics",
+			opChallenger.Name, opChallenger.Namespace, resources.GetOpChallengerMetricsPort(opChallenger))
+		inProgress, err := scrapeGauge(ctx, metricsURL, challengerInProgressGamesMetric)
+		if err != nil {
+			logger.V(1).Info("unable to scrape op-challenger metrics", "error", err.Error())
+		} else {
+			info.InProgressGames = int32(inProgress)
+			info.LastUpdated = metav1.Now()
+		}
+	}
+}
+
+// handleDeletion handles the deletion of OpChallenger resources
+func (r *OpChallengerReconciler) handleDeletion(ctx context.Context, opChallenger *optimismv1alpha1.OpChallenger) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Owned StatefulSet and Service are removed through owner references.
+	// The game data PVC is retained, matching StatefulSet semantics.
+	logger.Info("Cleaning up OpChallenger resources", "name", opChallenger.Name)
+
+	// Remove finalizer
+	controllerutil.RemoveFinalizer(opChallenger, OpChallengerFinalizer)
+	if err := r.Update(ctx, opChallenger); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	return ctrl.Result{}, nil
 }
 
+// updateStatusWithRetry updates the OpChallenger status with retry logic to handle conflicts
+func (r *OpChallengerReconciler) updateStatusWithRetry(ctx context.Context, opChallenger *optimismv1alpha1.OpChallenger) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// Get the latest version of the resource
+		latest := &optimismv1alpha1.OpChallenger{}
+		if err := r.Get(ctx, k8stypes.NamespacedName{Name: opChallenger.Name, Namespace: opChallenger.Namespace}, latest); err != nil {
+			return err
+		}
+
+		// Copy status fields from opChallenger to latest to avoid race conditions
+		latest.Status.Phase = opChallenger.Status.Phase
+		latest.Status.ObservedGeneration = opChallenger.Status.ObservedGeneration
+		latest.Status.Conditions = opChallenger.Status.Conditions
+		if opChallenger.Status.ChallengerInfo != nil {
+			latest.Status.ChallengerInfo = opChallenger.Status.ChallengerInfo.DeepCopy()
+		}
+
+		return r.Status().Update(ctx, latest)
+	})
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *OpChallengerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&optimismv1alpha1.OpChallenger{}).
+		Owns(&appsv1.StatefulSet{}).
+		Owns(&corev1.Service{}).
+		Watches(&optimismv1alpha1.OptimismNetwork{}, handler.EnqueueRequestsFromMapFunc(networkDependents(mgr.GetClient(), &optimismv1alpha1.OpChallengerList{},
+			func(obj client.Object) optimismv1alpha1.OptimismNetworkRef {
+				return obj.(*optimismv1alpha1.OpChallenger).Spec.OptimismNetworkRef
+			})), builder.WithPredicates(l1EndpointSwitched)).
 		Named("opchallenger").
 		Complete(r)
 }
@@ -21,6 +21,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -28,9 +29,29 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
 )
 
 var _ = Describe("OpChallenger Controller", func() {
+	newChallengerSpec := func() optimismv1alpha1.OpChallengerSpec {
+		return optimismv1alpha1.OpChallengerSpec{
+			OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "test-network"},
+			RollupRPCRef:       optimismv1alpha1.OpNodeReference{Name: "test-node"},
+			PrivateKey: optimismv1alpha1.SecretKeyRef{
+				SecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "challenger-key"},
+					Key:                  "private-key",
+				},
+			},
+			TraceTypes: []optimismv1alpha1.TraceType{optimismv1alpha1.TraceTypeCannon, optimismv1alpha1.TraceTypePermissioned},
+			Cannon: &optimismv1alpha1.VMTraceConfig{
+				Prestate: &optimismv1alpha1.PrestateSource{
+					URL: "https://prestates.example.com",
+				},
+			},
+		}
+	}
+
 	Context("When reconciling a resource", func() {
 		const resourceName = "test-resource"
 
@@ -38,7 +59,7 @@ var _ = Describe("OpChallenger Controller", func() {
 
 		typeNamespacedName := types.NamespacedName{
 			Name:      resourceName,
-			Namespace: "default", // TODO(user):Modify as needed
+			Namespace: "default",
 		}
 		opchallenger := &optimismv1alpha1.OpChallenger{}
 
@@ -51,14 +72,13 @@ var _ = Describe("OpChallenger Controller", func() {
 						Name:      resourceName,
 						Namespace: "default",
 					},
-					// TODO(user): Specify other spec details if needed.
+					Spec: newChallengerSpec(),
 				}
 				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
 			}
 		})
 
 		AfterEach(func() {
-			// TODO(user): Cleanup logic after each test, like removing the resource instance.
 			resource := &optimismv1alpha1.OpChallenger{}
 			err := k8sClient.Get(ctx, typeNamespacedName, resource)
 			Expect(err).NotTo(HaveOccurred())
@@ -77,8 +97,105 @@ var _ = Describe("OpChallenger Controller", func() {
 				NamespacedName: typeNamespacedName,
 			})
 			Expect(err).NotTo(HaveOccurred())
-			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
-			// Example: If you expect a certain status condition after reconciliation, verify it here.
+		})
+	})
+
+	Context("Configuration Validation", func() {
+		reconciler := &OpChallengerReconciler{}
+
+		It("Should accept a minimal valid configuration", func() {
+			challenger := &optimismv1alpha1.OpChallenger{Spec: newChallengerSpec()}
+			Expect(reconciler.validateConfiguration(challenger)).To(Succeed())
+		})
+
+		It("Should require a prestate for cannon trace types", func() {
+			challenger := &optimismv1alpha1.OpChallenger{Spec: newChallengerSpec()}
+			challenger.Spec.Cannon = nil
+			Expect(reconciler.validateConfiguration(challenger)).To(MatchError(ContainSubstring("cannon.prestate")))
+		})
+
+		It("Should reject a prestate with both sources", func() {
+			challenger := &optimismv1alpha1.OpChallenger{Spec: newChallengerSpec()}
+			challenger.Spec.Cannon.Prestate.ConfigMapRef = &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "prestates"},
+				Key:                  "prestate.bin.gz",
+			}
+			Expect(reconciler.validateConfiguration(challenger)).NotTo(Succeed())
+		})
+
+		It("Should require asterisc binaries when asterisc is enabled", func() {
+			challenger := &optimismv1alpha1.OpChallenger{Spec: newChallengerSpec()}
+			challenger.Spec.TraceTypes = []optimismv1alpha1.TraceType{optimismv1alpha1.TraceTypeAsterisc}
+			challenger.Spec.Asterisc = &optimismv1alpha1.VMTraceConfig{
+				Prestate: &optimismv1alpha1.PrestateSource{URL: "https://prestates.example.com"},
+			}
+			Expect(reconciler.validateConfiguration(challenger)).To(MatchError(ContainSubstring("asterisc.binaryPath")))
+		})
+	})
+
+	Context("StatefulSet Rendering", func() {
+		network := &optimismv1alpha1.OptimismNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-network", Namespace: "default"},
+			Spec: optimismv1alpha1.OptimismNetworkSpec{
+				NetworkName: "op-sepolia",
+				ChainID:     11155420,
+				L1ChainID:   11155111,
+				L1RpcUrl:    "https://sepolia.example.com",
+				L1BeaconUrl: "https://beacon.example.com",
+			},
+			Status: optimismv1alpha1.OptimismNetworkStatus{
+				NetworkInfo: &optimismv1alpha1.NetworkInfo{
+					DiscoveredContracts: &optimismv1alpha1.NetworkContractAddresses{
+						DisputeGameFactoryAddr: "0x05F9613aDB30026FFd634f38e5C4dFd30a197Fa1",
+					},
+				},
+			},
+		}
+		rollupNode := &optimismv1alpha1.OpNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-node", Namespace: "default"},
+		}
+
+		It("Should render endpoints, trace types and a game data PVC", func() {
+			challenger := &optimismv1alpha1.OpChallenger{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-challenger", Namespace: "default"},
+				Spec:       newChallengerSpec(),
+			}
+
+			statefulSet := resources.CreateOpChallengerStatefulSet(challenger, network, rollupNode)
+			Expect(statefulSet.Spec.ServiceName).To(Equal("test-challenger"))
+			Expect(statefulSet.Spec.VolumeClaimTemplates).To(HaveLen(1))
+			Expect(statefulSet.Spec.VolumeClaimTemplates[0].Name).To(Equal("challenger-data"))
+
+			container := statefulSet.Spec.Template.Spec.Containers[0]
+			Expect(container.Args).To(ContainElements(
+				"--l1-eth-rpc=https://sepolia.example.com",
+				"--l1-beacon=https://beacon.example.com",
+				"--l2-eth-rpc=http://test-node.default.svc.cluster.local:8545",
+				"--rollup-rpc=http://test-node.default.svc.cluster.local:9545",
+				"--game-factory-address=0x05F9613aDB30026FFd634f38e5C4dFd30a197Fa1",
+				"--trace-type=cannon,permissioned",
+				"--datadir=/data",
+				"--cannon-prestates-url=https://prestates.example.com",
+			))
+			Expect(container.Env[0].Name).To(Equal("OP_CHALLENGER_PRIVATE_KEY"))
+		})
+
+		It("Should mount a ConfigMap prestate", func() {
+			challenger := &optimismv1alpha1.OpChallenger{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-challenger", Namespace: "default"},
+				Spec:       newChallengerSpec(),
+			}
+			challenger.Spec.Cannon.Prestate = &optimismv1alpha1.PrestateSource{
+				ConfigMapRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "prestates"},
+					Key:                  "prestate.bin.gz",
+				},
+			}
+
+			statefulSet := resources.CreateOpChallengerStatefulSet(challenger, network, rollupNode)
+			container := statefulSet.Spec.Template.Spec.Containers[0]
+			Expect(container.Args).To(ContainElement("--cannon-prestate=/prestates/cannon/prestate.bin.gz"))
+			Expect(container.VolumeMounts).To(ContainElement(HaveField("Name", "cannon-prestate")))
 		})
 	})
 })
@@ -0,0 +1,182 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+// opGethGoldenDir holds the expected op-geth arguments, one per line. Set UPDATE_GOLDEN=1
+// to rewrite them from the current renderer.
+const opGethGoldenDir = "testdata/opgeth-flags"
+
+var _ = Describe("op-geth Flags", func() {
+	network := &optimismv1alpha1.OptimismNetwork{
+		ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+		Spec: optimismv1alpha1.OptimismNetworkSpec{
+			ChainID:   901,
+			L1ChainID: 900,
+			L1RpcUrl:  "http://l1:8545",
+		},
+	}
+	newOpNode := func(geth optimismv1alpha1.OpGethConfig) *optimismv1alpha1.OpNode {
+		return &optimismv1alpha1.OpNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet-replica", Namespace: "default"},
+			Spec: optimismv1alpha1.OpNodeSpec{
+				OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+				NodeType:           "replica",
+				L2RpcUrl:           "http://sequencer:8545",
+				OpGeth:             geth,
+			},
+		}
+	}
+	opGethArgs := func(podSpec *corev1.PodSpec) []string {
+		return containerArgs(podSpec, "op-geth")
+	}
+
+	DescribeTable("Should render every configured setting",
+		func(golden string, geth optimismv1alpha1.OpGethConfig) {
+			Expect(resources.ValidateOpGethConfig(field.NewPath("spec", "opGeth"), &geth)).To(BeEmpty())
+			statefulSet := resources.CreateOpNodeStatefulSet(newOpNode(geth), network)
+			expectGoldenArgs(opGethGoldenDir, golden, opGethArgs(&statefulSet.Spec.Template.Spec))
+		},
+		Entry("defaults", "defaults", optimismv1alpha1.OpGethConfig{}),
+		Entry("sync and database", "database", optimismv1alpha1.OpGethConfig{
+			SyncMode:    "full",
+			GCMode:      "full",
+			StateScheme: "path",
+			Cache:       4096,
+			DBEngine:    "pebble",
+		}),
+		Entry("archive nodes default to the hash scheme", "archive", optimismv1alpha1.OpGethConfig{
+			SyncMode: "full",
+			GCMode:   "archive",
+		}),
+		Entry("named network", "network", optimismv1alpha1.OpGethConfig{Network: "op-sepolia"}),
+		Entry("transaction pool", "txpool", optimismv1alpha1.OpGethConfig{
+			TxPool: &optimismv1alpha1.TxPoolConfig{
+				Locals:         []string{"0x4200000000000000000000000000000000000011", "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF"},
+				NoLocals:       true,
+				Journal:        "transactions.rlp",
+				JournalRemotes: true,
+				Lifetime:       "3h0m0s",
+				PriceBump:      10,
+				AccountSlots:   16,
+				GlobalSlots:    5120,
+				AccountQueue:   64,
+				GlobalQueue:    1024,
+			},
+		}),
+		Entry("rollup", "rollup", optimismv1alpha1.OpGethConfig{
+			Rollup: &optimismv1alpha1.RollupConfig{DisableTxPoolGossip: true, ComputePendingBlock: true},
+		}),
+		Entry("peer-to-peer", "p2p", optimismv1alpha1.OpGethConfig{
+			Networking: &optimismv1alpha1.GethNetworkingConfig{
+				P2P: &optimismv1alpha1.GethP2PConfig{
+					Port:        30304,
+					MaxPeers:    50,
+					NoDiscovery: true,
+					NetRestrict: "10.0.0.0/8,192.168.0.0/16",
+					Static:      []string{"enode://abcd@10.0.0.1:30303"},
+				},
+			},
+		}),
+		Entry("RPC endpoints", "rpc", optimismv1alpha1.OpGethConfig{
+			DataDir: "/data/op-geth",
+			Networking: &optimismv1alpha1.GethNetworkingConfig{
+				HTTP: &optimismv1alpha1.HTTPConfig{
+					Enabled: true, Port: 8545, APIs: []string{"eth", "net", "web3"},
+					CORS: &optimismv1alpha1.CORSConfig{Origins: []string{"*"}},
+				},
+				WS:      &optimismv1alpha1.WSConfig{Enabled: true, Port: 8546, APIs: []string{"eth"}, Origins: []string{"*"}},
+				AuthRPC: &optimismv1alpha1.AuthRPCConfig{Port: 8551},
+			},
+		}),
+	)
+
+	It("Should project static peers into the op-geth TOML config", func() {
+		opNode := newOpNode(optimismv1alpha1.OpGethConfig{
+			Networking: &optimismv1alpha1.GethNetworkingConfig{
+				P2P: &optimismv1alpha1.GethP2PConfig{Static: []string{"enode://abcd@10.0.0.1:30303", "enode://ef01@10.0.0.2:30303"}},
+			},
+		})
+		podSpec := resources.CreateOpNodeStatefulSet(opNode, network).Spec.Template
+
+		Expect(podSpec.Annotations).To(HaveKeyWithValue(resources.GethConfigAnnotation,
+			"[Node.P2P]\nStaticNodes = [\"enode://abcd@10.0.0.1:30303\", \"enode://ef01@10.0.0.2:30303\"]\n"))
+		Expect(podSpec.Spec.Volumes).To(ContainElement(HaveField("Name", "geth-config")))
+		Expect(podSpec.Spec.Containers[0].VolumeMounts).To(ContainElement(HaveField("MountPath", "/etc/geth")))
+	})
+
+	It("Should reject unsupported enum values and archive nodes on the path scheme", func() {
+		errs := resources.ValidateOpGethConfig(field.NewPath("spec", "opGeth"), &optimismv1alpha1.OpGethConfig{
+			SyncMode:    "light",
+			GCMode:      "archive",
+			StateScheme: "path",
+			DBEngine:    "rocksdb",
+			TxPool:      &optimismv1alpha1.TxPoolConfig{Locals: []string{"alice"}, Lifetime: "forever"},
+			Networking: &optimismv1alpha1.GethNetworkingConfig{
+				P2P: &optimismv1alpha1.GethP2PConfig{NetRestrict: "10.0.0.0", Static: []string{"10.0.0.1:30303"}},
+			},
+		})
+		Expect(errs.ToAggregate().Error()).To(SatisfyAll(
+			ContainSubstring(`spec.opGeth.syncMode: Unsupported value: "light"`),
+			ContainSubstring(`spec.opGeth.dbEngine: Unsupported value: "rocksdb"`),
+			ContainSubstring("spec.opGeth.stateScheme: Invalid value: \"path\": archive nodes require the hash state scheme"),
+			ContainSubstring("spec.opGeth.txpool.locals[0]"),
+			ContainSubstring("spec.opGeth.txpool.lifetime"),
+			ContainSubstring("spec.opGeth.networking.p2p.netRestrict"),
+			ContainSubstring("spec.opGeth.networking.p2p.static[0]"),
+		))
+	})
+})
+
+// expectGoldenArgs compares container arguments with a golden file, one argument per line.
+// With UPDATE_GOLDEN set the golden file is rewritten first.
+func expectGoldenArgs(dir, name string, args []string) {
+	rendered := strings.Join(args, "\n") + "\n"
+	path := filepath.Join(dir, name+".golden")
+	if os.Getenv("UPDATE_GOLDEN") != "" {
+		Expect(os.MkdirAll(dir, 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(rendered), 0o644)).To(Succeed())
+	}
+	expected, err := os.ReadFile(path)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(rendered).To(Equal(string(expected)))
+}
+
+// containerArgs returns the arguments of the named container of a pod spec
+func containerArgs(podSpec *corev1.PodSpec, name string) []string {
+	for _, container := range podSpec.Containers {
+		if container.Name == name {
+			return container.Args
+		}
+	}
+	Fail("no " + name + " container")
+	return nil
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/utils"
+)
+
+const (
+	// backupSnapshotTimeFormat is the timestamp suffix of backup VolumeSnapshot names
+	backupSnapshotTimeFormat = "20060102-150405"
+	// backupRequeueInterval is how often a new snapshot is checked until it is ready, so
+	// its heads are read soon after the cut
+	backupRequeueInterval = 15 * time.Second
+)
+
+// reconcileBackups takes a VolumeSnapshot of the op-geth data volume when the newest
+// backup is older than the backup interval, prunes failed snapshots and ready snapshots
+// beyond the retention count and records the retained snapshots in status
+func (r *OpNodeReconciler) reconcileBackups(ctx context.Context, opNode *optimismv1alpha1.OpNode) error {
+	logger := log.FromContext(ctx)
+
+	backup := opNode.Spec.Backup
+	if backup == nil || !backup.Enabled {
+		opNode.Status.Backups = nil
+		meta.RemoveStatusCondition(&opNode.Status.Conditions, "BackedUp")
+		return nil
+	}
+
+	snapshots, err := r.listBackupSnapshots(ctx, opNode)
+	if err != nil {
+		return fmt.Errorf("failed to list backup snapshots: %w", err)
+	}
+
+	now := time.Now()
+	if len(snapshots) == 0 || now.Sub(snapshots[0].GetCreationTimestamp().Time) >= backupInterval(opNode) {
+		snapshot, err := r.createBackupSnapshot(ctx, opNode, now)
+		if err != nil {
+			utils.SetCondition(&opNode.Status.Conditions, "BackedUp", metav1.ConditionFalse, "SnapshotFailed", err.Error())
+			return err
+		}
+		if snapshot != nil {
+			logger.Info("created backup snapshot", "snapshot", snapshot.GetName())
+			snapshots = append([]unstructured.Unstructured{*snapshot}, snapshots...)
+		}
+	}
+
+	// The heads can only be bounded once the cut has happened, so they are read on a later
+	// reconcile, which is requeued shortly while the newest snapshot is pending
+	if opNode.Spec.OpNode.RPC != nil && opNode.Spec.OpNode.RPC.Enabled {
+		if err := r.recordBackupHeads(ctx, resources.GetOpNodeRPCEndpoint(opNode), snapshots); err != nil {
+			logger.V(1).Info("unable to record backup heads", "error", err.Error())
+		}
+	}
+
+	// Only ready snapshots count towards the retention, so snapshots a broken CSI driver never
+	// completes cannot push out the good ones. Failed snapshots are pruned, except the newest,
+	// which reports the failure and paces the next attempt.
+	retention := int(backupRetention(opNode))
+	ready := 0
+	kept := make([]unstructured.Unstructured, 0, len(snapshots))
+	for i := range snapshots {
+		snapshot := &snapshots[i]
+		var reason string
+		switch {
+		case backupFailed(snapshot):
+			if i > 0 {
+				reason = "failed"
+			}
+		case backupRecord(snapshot).ReadyToUse:
+			if ready++; ready > retention {
+				reason = "beyond retention"
+			}
+		}
+		if reason == "" {
+			kept = append(kept, *snapshot)
+			continue
+		}
+		if err := r.Delete(ctx, snapshot); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to prune backup snapshot %s: %w", snapshot.GetName(), err)
+		}
+		logger.Info("pruned backup snapshot", "snapshot", snapshot.GetName(), "reason", reason)
+	}
+	snapshots = kept
+
+	opNode.Status.Backups = make([]optimismv1alpha1.BackupSnapshot, 0, len(snapshots))
+	for i := range snapshots {
+		opNode.Status.Backups = append(opNode.Status.Backups, backupRecord(&snapshots[i]))
+	}
+	setBackedUpCondition(opNode, snapshots)
+	return nil
+}
+
+// listBackupSnapshots returns the backup VolumeSnapshots of the OpNode, newest first
+func (r *OpNodeReconciler) listBackupSnapshots(ctx context.Context, opNode *optimismv1alpha1.OpNode) ([]unstructured.Unstructured, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(resources.VolumeSnapshotGVK.GroupVersion().WithKind(resources.VolumeSnapshotGVK.Kind + "List"))
+	if err := r.List(ctx, list, client.InNamespace(opNode.Namespace),
+		client.MatchingLabels{resources.BackupOfLabel: opNode.Name}); err != nil {
+		return nil, err
+	}
+
+	snapshots := list.Items
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[j].GetCreationTimestamp().Time.Before(snapshots[i].GetCreationTimestamp().Time)
+	})
+	return snapshots, nil
+}
+
+// createBackupSnapshot snapshots the data volume of the first pod. It returns nil when the
+// volume does not exist yet.
+func (r *OpNodeReconciler) createBackupSnapshot(ctx context.Context, opNode *optimismv1alpha1.OpNode, now time.Time) (*unstructured.Unstructured, error) {
+	var claim corev1.PersistentVolumeClaim
+	claimKey := client.ObjectKey{Name: resources.OpGethDataClaimName(opNode, 0), Namespace: opNode.Namespace}
+	if err := r.Get(ctx, claimKey, &claim); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	name := fmt.Sprintf("%s-%s", opNode.Name, now.UTC().Format(backupSnapshotTimeFormat))
+	snapshot := resources.CreateOpGethVolumeSnapshot(opNode, name)
+	if err := r.Create(ctx, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to create backup snapshot %s: %w", name, err)
+	}
+	if snapshot.GetCreationTimestamp().Time.IsZero() {
+		snapshot.SetCreationTimestamp(metav1.NewTime(now))
+	}
+	return snapshot, nil
+}
+
+// recordBackupHeads annotates snapshots that have been cut but carry no heads yet with the
+// unsafe and safe L2 heads op-node reports now. The node keeps running during a crash-consistent
+// cut, so a restore recovers at or below these heads; they are upper bounds, not the exact height.
+func (r *OpNodeReconciler) recordBackupHeads(ctx context.Context, opNodeURL string, snapshots []unstructured.Unstructured) error {
+	var pending []*unstructured.Unstructured
+	for i := range snapshots {
+		if _, recorded := snapshots[i].GetAnnotations()[resources.MaxBlockNumberAnnotation]; recorded {
+			continue
+		}
+		if cutAt, _, _ := unstructured.NestedString(snapshots[i].Object, "status", "creationTime"); cutAt == "" {
+			continue
+		}
+		pending = append(pending, &snapshots[i])
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	status, err := pollRollupSyncStatus(ctx, opNodeURL)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range pending {
+		patch := client.MergeFrom(snapshot.DeepCopy())
+		annotations := snapshot.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[resources.MaxBlockNumberAnnotation] = strconv.FormatUint(status.UnsafeL2.Number, 10)
+		annotations[resources.MaxSafeBlockNumberAnnotation] = strconv.FormatUint(status.SafeL2.Number, 10)
+		snapshot.SetAnnotations(annotations)
+		if err := r.Patch(ctx, snapshot, patch); err != nil {
+			return fmt.Errorf("failed to record heads on backup snapshot %s: %w", snapshot.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// backupRecord converts a backup VolumeSnapshot into its status record
+func backupRecord(snapshot *unstructured.Unstructured) optimismv1alpha1.BackupSnapshot {
+	annotations := snapshot.GetAnnotations()
+	blockNumber, _ := strconv.ParseInt(annotations[resources.MaxBlockNumberAnnotation], 10, 64)
+	safeBlockNumber, _ := strconv.ParseInt(annotations[resources.MaxSafeBlockNumberAnnotation], 10, 64)
+	readyToUse, _, _ := unstructured.NestedBool(snapshot.Object, "status", "readyToUse")
+
+	return optimismv1alpha1.BackupSnapshot{
+		Name:            snapshot.GetName(),
+		CreatedAt:       snapshot.GetCreationTimestamp(),
+		BlockNumber:     blockNumber,
+		SafeBlockNumber: safeBlockNumber,
+		ReadyToUse:      readyToUse,
+	}
+}
+
+// backupFailed reports whether the snapshot controller failed to cut or upload a snapshot
+func backupFailed(snapshot *unstructured.Unstructured) bool {
+	if ready, _, _ := unstructured.NestedBool(snapshot.Object, "status", "readyToUse"); ready {
+		return false
+	}
+	_, failed, _ := unstructured.NestedFieldNoCopy(snapshot.Object, "status", "error")
+	return failed
+}
+
+// setBackedUpCondition reports the state of the newest backup snapshot
+func setBackedUpCondition(opNode *optimismv1alpha1.OpNode, snapshots []unstructured.Unstructured) {
+	if len(snapshots) == 0 {
+		utils.SetCondition(&opNode.Status.Conditions, "BackedUp", metav1.ConditionFalse, "WaitingForVolume",
+			"The op-geth data volume does not exist yet")
+		return
+	}
+
+	newest := &snapshots[0]
+	if backupFailed(newest) {
+		message, _, _ := unstructured.NestedString(newest.Object, "status", "error", "message")
+		utils.SetCondition(&opNode.Status.Conditions, "BackedUp", metav1.ConditionFalse, "SnapshotFailed",
+			fmt.Sprintf("Snapshot %s failed: %s", newest.GetName(), message))
+		return
+	}
+	record := backupRecord(newest)
+	if !record.ReadyToUse {
+		utils.SetCondition(&opNode.Status.Conditions, "BackedUp", metav1.ConditionFalse, "SnapshotPending",
+			fmt.Sprintf("Snapshot %s is not ready to use yet", record.Name))
+		return
+	}
+	message := fmt.Sprintf("Snapshot %s is ready to use", record.Name)
+	if record.BlockNumber > 0 {
+		message += fmt.Sprintf(" and restores at most to block %d", record.BlockNumber)
+	}
+	utils.SetCondition(&opNode.Status.Conditions, "BackedUp", metav1.ConditionTrue, "SnapshotReady", message)
+}
+
+// backupPending reports whether the newest backup snapshot is still being cut or uploaded
+func backupPending(opNode *optimismv1alpha1.OpNode) bool {
+	condition := meta.FindStatusCondition(opNode.Status.Conditions, "BackedUp")
+	return condition != nil && condition.Reason == "SnapshotPending"
+}
+
+// backupInterval returns the configured interval between backup snapshots
+func backupInterval(opNode *optimismv1alpha1.OpNode) time.Duration {
+	interval, err := time.ParseDuration(opNode.Spec.Backup.Interval)
+	if err != nil || interval <= 0 {
+		interval, _ = time.ParseDuration(resources.DefaultBackupInterval)
+	}
+	return interval
+}
+
+// backupRetention returns the number of backup snapshots to keep
+func backupRetention(opNode *optimismv1alpha1.OpNode) int32 {
+	if opNode.Spec.Backup.Retention > 0 {
+		return opNode.Spec.Backup.Retention
+	}
+	return resources.DefaultBackupRetention
+}
+
+// nextBackupIn returns how long until the next backup snapshot is due, or zero when backups are disabled
+func nextBackupIn(opNode *optimismv1alpha1.OpNode) time.Duration {
+	if opNode.Spec.Backup == nil || !opNode.Spec.Backup.Enabled || len(opNode.Status.Backups) == 0 {
+		return 0
+	}
+	next := time.Until(opNode.Status.Backups[0].CreatedAt.Add(backupInterval(opNode)))
+	if next < time.Second {
+		next = time.Second
+	}
+	return next
+}
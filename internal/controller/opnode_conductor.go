@@ -0,0 +1,290 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/utils"
+)
+
+// conductorRequeueInterval is how often an HA sequencer is reconciled until all members have joined
+const conductorRequeueInterval = 30 * time.Second
+
+// conductorMember is an expected member of the op-conductor Raft cluster
+type conductorMember struct {
+	ID       string // server ID, the pod name
+	Address  string // advertised Raft address
+	Endpoint string // op-conductor RPC endpoint
+}
+
+// raftServer is an entry of the op-conductor conductor_clusterMembership response
+type raftServer struct {
+	ID       string `json:"id"`
+	Addr     string `json:"addr"`
+	Suffrage int    `json:"suffrage"` // 0 voter, 1 nonvoter
+}
+
+// raftMembership is the op-conductor conductor_clusterMembership response
+type raftMembership struct {
+	Servers []raftServer `json:"servers"`
+	Version uint64       `json:"version"`
+}
+
+// conductorMembers lists the expected Raft members of an HA sequencer, one per pod
+func conductorMembers(opNode *optimismv1alpha1.OpNode) []conductorMember {
+	members := make([]conductorMember, 0, resources.ConductorMembers(opNode))
+	for i := int32(0); i < resources.ConductorMembers(opNode); i++ {
+		pod := fmt.Sprintf("%s-%d", opNode.Name, i)
+		members = append(members, conductorMember{
+			ID:       pod,
+			Address:  resources.ConductorRaftAddress(opNode, pod),
+			Endpoint: resources.ConductorRPCEndpoint(opNode, pod),
+		})
+	}
+	return members
+}
+
+// updateConductorStatus records the Raft leader and membership of an HA sequencer,
+// adds members that are running but have not joined the cluster yet and tells members
+// with empty Raft storage whether to bootstrap the cluster
+func (r *OpNodeReconciler) updateConductorStatus(ctx context.Context, opNode *optimismv1alpha1.OpNode) {
+	logger := log.FromContext(ctx)
+
+	if !resources.HAEnabled(opNode) {
+		opNode.Status.Conductor = nil
+		meta.RemoveStatusCondition(&opNode.Status.Conditions, "ConductorReady")
+		return
+	}
+
+	members := conductorMembers(opNode)
+	status, err := reconcileConductorCluster(ctx, members)
+	if err != nil {
+		logger.V(1).Info("unable to reconcile op-conductor cluster", "error", err.Error())
+	}
+
+	bootstrappedBy := ""
+	if opNode.Status.Conductor != nil {
+		bootstrappedBy = opNode.Status.Conductor.BootstrappedBy
+	}
+	bootstrappedBy, bootstrapErr := r.reconcileConductorBootstrap(ctx, opNode, members, status, bootstrappedBy)
+	if bootstrapErr != nil {
+		logger.Error(bootstrapErr, "failed to reconcile op-conductor bootstrap")
+	}
+
+	if err != nil {
+		if opNode.Status.Conductor == nil {
+			opNode.Status.Conductor = &optimismv1alpha1.ConductorStatus{}
+		}
+		opNode.Status.Conductor.BootstrappedBy = bootstrappedBy
+		utils.SetCondition(&opNode.Status.Conditions, "ConductorReady", metav1.ConditionUnknown, "ClusterUnavailable",
+			fmt.Sprintf("Failed to query op-conductor: %v", err))
+		return
+	}
+	status.BootstrappedBy = bootstrappedBy
+	opNode.Status.Conductor = status
+
+	if status.Leader == "" {
+		utils.SetCondition(&opNode.Status.Conditions, "ConductorReady", metav1.ConditionFalse, "NoLeader",
+			"No op-conductor member reports Raft leadership")
+		return
+	}
+	voters := 0
+	for _, member := range status.Members {
+		if member.Suffrage == "Voter" {
+			voters++
+		}
+	}
+	if voters < len(members) {
+		utils.SetCondition(&opNode.Status.Conditions, "ConductorReady", metav1.ConditionFalse, "MembersJoining",
+			fmt.Sprintf("Leader %s, %d of %d members are voters", status.Leader, voters, len(members)))
+		return
+	}
+	utils.SetCondition(&opNode.Status.Conditions, "ConductorReady", metav1.ConditionTrue, "LeaderElected",
+		fmt.Sprintf("Leader %s, %d of %d members are voters", status.Leader, voters, len(members)))
+}
+
+// reconcileConductorBootstrap annotates the member pods with their Raft bootstrap decision and
+// returns the member allowed to bootstrap. The cluster is bootstrapped once per OpNode, by the
+// first member, and only when no member reports a Raft configuration and no member pod is ready,
+// as a ready pod runs op-conductor. Once a cluster exists, every member joins it.
+func (r *OpNodeReconciler) reconcileConductorBootstrap(
+	ctx context.Context,
+	opNode *optimismv1alpha1.OpNode,
+	members []conductorMember,
+	observed *optimismv1alpha1.ConductorStatus,
+	bootstrappedBy string,
+) (string, error) {
+	clustered := observed != nil && (observed.Leader != "" || len(observed.Members) > 0)
+
+	pods := make([]*corev1.Pod, 0, len(members))
+	for _, member := range members {
+		var pod corev1.Pod
+		err := r.Get(ctx, types.NamespacedName{Name: member.ID, Namespace: opNode.Namespace}, &pod)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return bootstrappedBy, fmt.Errorf("failed to get member pod %s: %w", member.ID, err)
+		}
+		if isPodReady(&pod) && observed == nil {
+			// op-conductor runs but cannot be queried; it may belong to a cluster
+			clustered = true
+		}
+		pods = append(pods, &pod)
+	}
+
+	if bootstrappedBy == "" && !clustered && len(pods) > 0 && pods[0].Name == members[0].ID &&
+		!slices.ContainsFunc(pods, isPodReady) {
+		bootstrappedBy = members[0].ID
+	}
+
+	for _, pod := range pods {
+		decision := resources.ConductorRaftJoin
+		if pod.Name == bootstrappedBy && !clustered {
+			decision = resources.ConductorRaftBootstrap
+		}
+		if pod.Annotations[resources.ConductorRaftAnnotation] == decision {
+			continue
+		}
+		patch := client.MergeFrom(pod.DeepCopy())
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[resources.ConductorRaftAnnotation] = decision
+		if err := r.Patch(ctx, pod, patch); err != nil {
+			return bootstrappedBy, fmt.Errorf("failed to annotate member pod %s: %w", pod.Name, err)
+		}
+	}
+	return bootstrappedBy, nil
+}
+
+// isPodReady reports whether all containers of a pod pass their readiness probes
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// reconcileConductorCluster finds the Raft leader among the members and, through the leader,
+// adds reachable members missing from the Raft configuration as voters and removes servers
+// that are no longer members. Unreachable members are not added so they cannot cost quorum.
+// Without a leader, the Raft configuration a follower reports is returned, if any.
+func reconcileConductorCluster(ctx context.Context, members []conductorMember) (*optimismv1alpha1.ConductorStatus, error) {
+	pollCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var leader *rpc.Client
+	leaderID := ""
+	var membership raftMembership
+	reachable := make(map[string]bool, len(members))
+	for _, member := range members {
+		client, err := rpc.DialContext(pollCtx, member.Endpoint)
+		if err != nil {
+			continue
+		}
+		var isLeader bool
+		if err := client.CallContext(pollCtx, &isLeader, "conductor_leader"); err != nil {
+			client.Close()
+			continue
+		}
+		reachable[member.ID] = true
+		if isLeader && leader == nil {
+			leader, leaderID = client, member.ID
+			continue
+		}
+		// A follower that has not been added to a cluster reports no servers
+		if leader == nil && len(membership.Servers) == 0 {
+			_ = client.CallContext(pollCtx, &membership, "conductor_clusterMembership")
+		}
+		client.Close()
+	}
+	if len(reachable) == 0 {
+		return nil, fmt.Errorf("no op-conductor member is reachable")
+	}
+	status := &optimismv1alpha1.ConductorStatus{}
+	if leader == nil {
+		status.Members = raftMembers(membership)
+		return status, nil
+	}
+	defer leader.Close()
+	status.Leader = leaderID
+
+	if err := leader.CallContext(pollCtx, &membership, "conductor_clusterMembership"); err != nil {
+		return nil, fmt.Errorf("conductor_clusterMembership failed: %w", err)
+	}
+
+	for _, member := range members {
+		if !reachable[member.ID] || slices.ContainsFunc(membership.Servers, func(s raftServer) bool { return s.ID == member.ID }) {
+			continue
+		}
+		if err := leader.CallContext(pollCtx, nil, "conductor_addServerAsVoter", member.ID, member.Address, membership.Version); err != nil {
+			return nil, fmt.Errorf("failed to add %s to the Raft cluster: %w", member.ID, err)
+		}
+		if err := leader.CallContext(pollCtx, &membership, "conductor_clusterMembership"); err != nil {
+			return nil, fmt.Errorf("conductor_clusterMembership failed: %w", err)
+		}
+	}
+	for _, server := range membership.Servers {
+		if slices.ContainsFunc(members, func(m conductorMember) bool { return m.ID == server.ID }) {
+			continue
+		}
+		if err := leader.CallContext(pollCtx, nil, "conductor_removeServer", server.ID, membership.Version); err != nil {
+			return nil, fmt.Errorf("failed to remove %s from the Raft cluster: %w", server.ID, err)
+		}
+		if err := leader.CallContext(pollCtx, &membership, "conductor_clusterMembership"); err != nil {
+			return nil, fmt.Errorf("conductor_clusterMembership failed: %w", err)
+		}
+	}
+
+	status.Members = raftMembers(membership)
+	return status, nil
+}
+
+// raftMembers converts a Raft configuration into its status records
+func raftMembers(membership raftMembership) []optimismv1alpha1.RaftMember {
+	var members []optimismv1alpha1.RaftMember
+	for _, server := range membership.Servers {
+		suffrage := "Voter"
+		if server.Suffrage != 0 {
+			suffrage = "Nonvoter"
+		}
+		members = append(members, optimismv1alpha1.RaftMember{
+			ID:       server.ID,
+			Address:  server.Addr,
+			Suffrage: suffrage,
+		})
+	}
+	return members
+}
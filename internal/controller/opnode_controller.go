@@ -19,22 +19,32 @@ package controller
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
 	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
@@ -53,10 +63,16 @@ const (
 	OpNodePhaseStopped      = "Stopped"
 )
 
+// errRecreatingStatefulSet signals that the StatefulSet is being replaced for a forced nodeType change
+var errRecreatingStatefulSet = errors.New("StatefulSet is being recreated")
+
 // OpNodeReconciler reconciles an OpNode object
 type OpNodeReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// VolumeStats reports data volume usage for storage auto-grow; auto-grow is inactive when nil
+	VolumeStats VolumeStatsProvider
 }
 
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=opnodes,verbs=get;list;watch;create;update;patch;delete
@@ -64,6 +80,11 @@ type OpNodeReconciler struct {
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=opnodes/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=secrets;configmaps;services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups="",resources=nodes/proxy,verbs=get
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;create;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -138,6 +159,28 @@ func (r *OpNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	utils.SetCondition(&opNode.Status.Conditions, "NetworkReady", metav1.ConditionTrue, "NetworkReady", "OptimismNetwork is ready")
 	opNode.Status.Phase = OpNodePhaseInitializing
 
+	// op-geth loads the chain config of a named network from its built-in registry
+	if name := opNode.Spec.OpGeth.Network; name != "" && network.Spec.NetworkName != "" && name != network.Spec.NetworkName {
+		utils.SetCondition(&opNode.Status.Conditions, "ConfigurationValid", metav1.ConditionFalse, "InvalidConfiguration",
+			fmt.Sprintf("opGeth.network %q does not match the OptimismNetwork networkName %q", name, network.Spec.NetworkName))
+		opNode.Status.Phase = OpNodePhaseError
+		goto updateStatus
+	}
+	if name, bundled := opNode.Spec.OpGeth.Network, resources.BundledGenesisNetwork(network); name != "" && bundled != "" && name != bundled {
+		utils.SetCondition(&opNode.Status.Conditions, "ConfigurationValid", metav1.ConditionFalse, "InvalidConfiguration",
+			fmt.Sprintf("opGeth.network %q does not match the op-geth network %q bundling the auto-discovered genesis", name, bundled))
+		opNode.Status.Phase = OpNodePhaseError
+		goto updateStatus
+	}
+
+	// Node-level image overrides are layered on the network images
+	if _, err := resources.ResolveImages(network, &opNode); err != nil {
+		utils.SetCondition(&opNode.Status.Conditions, "ImagesValid", metav1.ConditionFalse, "InvalidImages", err.Error())
+		opNode.Status.Phase = OpNodePhaseError
+		goto updateStatus
+	}
+	utils.SetCondition(&opNode.Status.Conditions, "ImagesValid", metav1.ConditionTrue, "ImagesResolved", "Component images are resolved and compatible")
+
 	// 1) Reconcile secrets
 	if err := r.reconcileSecrets(ctx, &opNode); err != nil {
 		utils.SetCondition(&opNode.Status.Conditions, "SecretsReady", metav1.ConditionFalse, "SecretReconciliationFailed", fmt.Sprintf("Failed to reconcile secrets: %v", err))
@@ -146,8 +189,18 @@ func (r *OpNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 	utils.SetCondition(&opNode.Status.Conditions, "SecretsReady", metav1.ConditionTrue, "SecretsReconciled", "All required secrets are ready")
 
-	// 2) Reconcile StatefulSet
-	if err := r.reconcileStatefulSet(ctx, &opNode, network); err != nil {
+	// 2) Reconcile StatefulSet; overrides colliding with operator-managed settings are left out
+	if conflicts := resources.OpNodeOverrideConflicts(&opNode, network); len(conflicts) > 0 {
+		utils.SetCondition(&opNode.Status.Conditions, "OverridesApplied", metav1.ConditionFalse, "OverridesConflict",
+			"Ignored overrides of operator-managed settings: "+strings.Join(conflicts, "; "))
+	} else {
+		utils.SetCondition(&opNode.Status.Conditions, "OverridesApplied", metav1.ConditionTrue, "OverridesApplied", "All overrides are applied")
+	}
+	if err := r.reconcileStatefulSet(ctx, &opNode, network); errors.Is(err, errRecreatingStatefulSet) {
+		utils.SetCondition(&opNode.Status.Conditions, "StatefulSetReady", metav1.ConditionFalse, "StatefulSetRecreating", "Recreating StatefulSet for the forced nodeType change")
+		opNode.Status.Phase = OpNodePhaseInitializing
+		goto updateStatus
+	} else if err != nil {
 		utils.SetCondition(&opNode.Status.Conditions, "StatefulSetReady", metav1.ConditionFalse, "StatefulSetReconciliationFailed", fmt.Sprintf("Failed to reconcile StatefulSet: %v", err))
 		opNode.Status.Phase = OpNodePhaseError
 		goto updateStatus
@@ -160,10 +213,28 @@ func (r *OpNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		opNode.Status.Phase = OpNodePhaseError
 		goto updateStatus
 	}
-	utils.SetCondition(&opNode.Status.Conditions, "ServiceReady", metav1.ConditionTrue, "ServiceReconciled", "Service is ready")
+	utils.SetCondition(&opNode.Status.Conditions, "ServiceReady", metav1.ConditionTrue, "ServiceReconciled", "Services are ready")
 
-	// 4) All done
+	// 4) Forced changes have been applied; drop the one-shot annotation
+	if err := clearForceUpdate(ctx, r.Client, &opNode); err != nil {
+		logger.Error(err, "failed to remove force-update annotation")
+	}
+
+	// 5) All done
+	if err := r.updateReplicaStatus(ctx, &opNode); err != nil {
+		logger.Error(err, "failed to read StatefulSet replica status")
+	}
 	r.updateNodeStatus(ctx, &opNode)
+	r.updateConductorStatus(ctx, &opNode)
+	if err := r.checkUpgradeHealth(ctx, &opNode); err != nil {
+		logger.Error(err, "failed to check upgrade health")
+	}
+	if err := r.reconcileStorage(ctx, &opNode); err != nil {
+		logger.Error(err, "failed to reconcile storage")
+	}
+	if err := r.reconcileBackups(ctx, &opNode); err != nil {
+		logger.Error(err, "failed to reconcile backups")
+	}
 	opNode.Status.Phase = OpNodePhaseRunning
 
 updateStatus:
@@ -184,6 +255,22 @@ updateStatus:
 	default:
 		requeueAfter = time.Minute
 	}
+	if upgradeInProgress(&opNode) && requeueAfter > upgradeRequeueInterval {
+		requeueAfter = upgradeRequeueInterval
+	}
+	if resources.HAEnabled(&opNode) && !meta.IsStatusConditionTrue(opNode.Status.Conditions, "ConductorReady") &&
+		requeueAfter > conductorRequeueInterval {
+		requeueAfter = conductorRequeueInterval
+	}
+	if storageResizing(&opNode) && requeueAfter > storageRequeueInterval {
+		requeueAfter = storageRequeueInterval
+	}
+	if backupPending(&opNode) && requeueAfter > backupRequeueInterval {
+		requeueAfter = backupRequeueInterval
+	}
+	if next := nextBackupIn(&opNode); next > 0 && requeueAfter > next {
+		requeueAfter = next
+	}
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
@@ -231,8 +318,102 @@ func (r *OpNodeReconciler) validateConfiguration(opNode *optimismv1alpha1.OpNode
 		if opNode.Spec.OpGeth.Storage.Size.IsZero() {
 			return fmt.Errorf("storage size must be specified")
 		}
+		if snapshot := opNode.Spec.OpGeth.Storage.Snapshot; snapshot != nil {
+			if err := validateSnapshotSource(snapshot); err != nil {
+				return fmt.Errorf("invalid storage snapshot: %w", err)
+			}
+		}
+		if autoGrow := opNode.Spec.OpGeth.Storage.AutoGrow; autoGrow != nil && autoGrow.Enabled {
+			if autoGrow.MaxSize.IsZero() {
+				return fmt.Errorf("storage autoGrow requires maxSize")
+			}
+			if autoGrow.MaxSize.Cmp(opNode.Spec.OpGeth.Storage.Size) < 0 {
+				return fmt.Errorf("storage autoGrow maxSize must not be smaller than the storage size")
+			}
+		}
+	}
+
+	if errs := resources.ValidateOpGethConfig(field.NewPath("spec", "opGeth"), &opNode.Spec.OpGeth); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if errs := resources.ValidateOpNodeConfig(field.NewPath("spec", "opNode"), &opNode.Spec.OpNode); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if errs := resources.ValidatePodBandwidthLimit(field.NewPath("spec", "podBandwidthLimit"), opNode.Spec.PodBandwidthLimit); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if errs := resources.ValidateOpNodeOverrides(field.NewPath("spec"), &opNode.Spec); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+
+	// Sequencers are pinned to a single pod, and a user-provided P2P key identifies one pod
+	if opNode.Spec.Replicas != nil {
+		if opNode.Spec.NodeType == "sequencer" && *opNode.Spec.Replicas != 1 {
+			return fmt.Errorf("sequencer nodes run a single replica")
+		}
+		p2p := opNode.Spec.OpNode.P2P
+		if *opNode.Spec.Replicas > 1 && p2p != nil && p2p.PrivateKey != nil && p2p.PrivateKey.SecretRef != nil {
+			return fmt.Errorf("a P2P private key secretRef cannot be shared by multiple replicas; use generate for per-pod keys")
+		}
 	}
 
+	// op-conductor runs next to a sequencer and drives it through the op-node and op-geth RPCs
+	if ha := opNode.Spec.HA; ha != nil && ha.Enabled {
+		if opNode.Spec.NodeType != "sequencer" {
+			return fmt.Errorf("ha is only supported for sequencer nodes")
+		}
+		if opNode.Spec.OpNode.RPC == nil || !opNode.Spec.OpNode.RPC.Enabled {
+			return fmt.Errorf("ha requires the op-node RPC to be enabled")
+		}
+		if resources.ExternalEngine(opNode) {
+			return fmt.Errorf("ha requires a local op-geth; engine.endpoint cannot be set")
+		}
+		if opNode.Spec.OpGeth.Networking == nil || opNode.Spec.OpGeth.Networking.HTTP == nil || !opNode.Spec.OpGeth.Networking.HTTP.Enabled {
+			return fmt.Errorf("ha requires the op-geth HTTP RPC to be enabled")
+		}
+		p2p := opNode.Spec.OpNode.P2P
+		if resources.ConductorMembers(opNode) > 1 && p2p != nil && p2p.PrivateKey != nil && p2p.PrivateKey.SecretRef != nil {
+			return fmt.Errorf("a P2P private key secretRef cannot be shared by multiple ha members; use generate for per-pod keys")
+		}
+	}
+
+	if backup := opNode.Spec.Backup; backup != nil && backup.Interval != "" {
+		if interval, err := time.ParseDuration(backup.Interval); err != nil || interval <= 0 {
+			return fmt.Errorf("invalid backup.interval %q: must be a positive duration", backup.Interval)
+		}
+	}
+
+	if strategy := opNode.Spec.UpgradeStrategy; strategy != nil && strategy.HealthTimeout != "" {
+		if _, err := time.ParseDuration(strategy.HealthTimeout); err != nil {
+			return fmt.Errorf("invalid upgradeStrategy.healthTimeout: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateSnapshotSource checks that exactly one snapshot source is set and that an archive URL can be verified
+func validateSnapshotSource(snapshot *optimismv1alpha1.SnapshotSource) error {
+	sources := 0
+	for _, source := range []string{snapshot.URL, snapshot.VolumeSnapshotName, snapshot.PersistentVolumeClaimName} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("exactly one of url, volumeSnapshotName or persistentVolumeClaimName must be set")
+	}
+	if snapshot.URL != "" && !strings.HasPrefix(snapshot.URL, "http://") && !strings.HasPrefix(snapshot.URL, "https://") {
+		return fmt.Errorf("url must be a valid HTTP/HTTPS URL")
+	}
+	if snapshot.SHA256 != "" {
+		if snapshot.URL == "" {
+			return fmt.Errorf("sha256 only applies to url sources")
+		}
+		if decoded, err := hex.DecodeString(snapshot.SHA256); err != nil || len(decoded) != sha256.Size {
+			return fmt.Errorf("sha256 must be a hex-encoded SHA-256 checksum")
+		}
+	}
 	return nil
 }
 
@@ -275,6 +456,10 @@ func (r *OpNodeReconciler) reconcileSecrets(ctx context.Context, opNode *optimis
 
 // reconcileJWTSecret creates or updates the JWT secret for Engine API
 func (r *OpNodeReconciler) reconcileJWTSecret(ctx context.Context, opNode *optimismv1alpha1.OpNode) error {
+	// A user-provided secret is shared with the execution engine and mounted as is
+	if !resources.GeneratesJWTSecret(opNode) {
+		return nil
+	}
 	secretName := opNode.Name + "-jwt"
 
 	var secret corev1.Secret
@@ -318,23 +503,21 @@ func (r *OpNodeReconciler) reconcileJWTSecret(ctx context.Context, opNode *optim
 	return nil
 }
 
-// reconcileP2PSecret creates or updates the P2P private key secret
+// reconcileP2PSecret creates or updates the P2P private key secret. It holds one key
+// per pod ordinal so every replica has its own peer identity; keys of removed
+// ordinals are kept so pods scaled back up reuse their identity.
 func (r *OpNodeReconciler) reconcileP2PSecret(ctx context.Context, opNode *optimismv1alpha1.OpNode) error {
 	secretName := opNode.Name + "-p2p"
 
 	var secret corev1.Secret
 	key := types.NamespacedName{Name: secretName, Namespace: opNode.Namespace}
 
+	exists := true
 	if err := r.Get(ctx, key, &secret); err != nil {
 		if !apierrors.IsNotFound(err) {
 			return err
 		}
-
-		// Create new P2P private key
-		privateKey, err := generateP2PPrivateKey()
-		if err != nil {
-			return fmt.Errorf("failed to generate P2P private key: %w", err)
-		}
+		exists = false
 
 		secret = corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
@@ -348,18 +531,41 @@ func (r *OpNodeReconciler) reconcileP2PSecret(ctx context.Context, opNode *optim
 				},
 			},
 			Type: corev1.SecretTypeOpaque,
-			Data: map[string][]byte{
-				"private-key": []byte(privateKey),
-			},
 		}
-
 		if err := ctrl.SetControllerReference(opNode, &secret, r.Scheme); err != nil {
 			return err
 		}
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
 
-		return r.Create(ctx, &secret)
+	changed := false
+	// Secrets created for a single pod stored the key as private-key
+	if legacy, ok := secret.Data["private-key"]; ok {
+		if _, ok := secret.Data[resources.P2PKeyName(0)]; !ok {
+			secret.Data[resources.P2PKeyName(0)] = legacy
+			changed = true
+		}
+	}
+	for ordinal := int32(0); ordinal < resources.OpNodeReplicas(opNode); ordinal++ {
+		if _, ok := secret.Data[resources.P2PKeyName(ordinal)]; ok {
+			continue
+		}
+		privateKey, err := generateP2PPrivateKey()
+		if err != nil {
+			return fmt.Errorf("failed to generate P2P private key: %w", err)
+		}
+		secret.Data[resources.P2PKeyName(ordinal)] = []byte(privateKey)
+		changed = true
 	}
 
+	if !exists {
+		return r.Create(ctx, &secret)
+	}
+	if changed {
+		return r.Update(ctx, &secret)
+	}
 	return nil
 }
 
@@ -367,6 +573,16 @@ func (r *OpNodeReconciler) reconcileP2PSecret(ctx context.Context, opNode *optim
 func (r *OpNodeReconciler) reconcileStatefulSet(ctx context.Context, opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.OptimismNetwork) error {
 	desiredStatefulSet := resources.CreateOpNodeStatefulSet(opNode, network)
 
+	// Roll the pods whenever the mounted rollup config or genesis content changes
+	configHash, err := r.networkConfigHash(ctx, opNode.Namespace, network)
+	if err != nil {
+		return err
+	}
+	if desiredStatefulSet.Spec.Template.Annotations == nil {
+		desiredStatefulSet.Spec.Template.Annotations = map[string]string{}
+	}
+	desiredStatefulSet.Spec.Template.Annotations[resources.ConfigHashAnnotation] = configHash
+
 	if err := ctrl.SetControllerReference(opNode, desiredStatefulSet, r.Scheme); err != nil {
 		return err
 	}
@@ -379,10 +595,43 @@ func (r *OpNodeReconciler) reconcileStatefulSet(ctx context.Context, opNode *opt
 			return err
 		}
 
+		if _, err := r.planUpgrade(ctx, opNode, network, nil, containerImages(&desiredStatefulSet.Spec.Template.Spec)); err != nil {
+			return err
+		}
+
 		// Create new StatefulSet
 		return r.Create(ctx, desiredStatefulSet)
 	}
 
+	// The StatefulSet selector cannot change in place; a node type change is only
+	// applied on request by recreating the StatefulSet, which keeps its PVCs
+	if currentNodeType := currentStatefulSet.Labels[resources.NodeTypeLabel]; currentNodeType != opNode.Spec.NodeType {
+		if !optimismv1alpha1.ForceUpdateAllowed(opNode, "spec.nodeType") {
+			return fmt.Errorf("nodeType changed from %q to %q against an existing data directory; set the %s annotation to \"spec.nodeType\" to recreate the StatefulSet",
+				currentNodeType, opNode.Spec.NodeType, optimismv1alpha1.ForceUpdateAnnotation)
+		}
+		if currentStatefulSet.DeletionTimestamp.IsZero() {
+			if err := r.Delete(ctx, &currentStatefulSet, client.PropagationPolicy(metav1.DeletePropagationForeground)); err != nil {
+				return err
+			}
+		}
+		return errRecreatingStatefulSet
+	}
+
+	// Image changes roll out through the upgrade strategy's health gates
+	images, err := r.planUpgrade(ctx, opNode, network, &currentStatefulSet, containerImages(&desiredStatefulSet.Spec.Template.Spec))
+	if err != nil {
+		return err
+	}
+	setContainerImages(&desiredStatefulSet.Spec.Template.Spec, images)
+
+	// The governing Service name is immutable; StatefulSets created before the
+	// headless Service existed keep their original one
+	desiredStatefulSet.Spec.ServiceName = currentStatefulSet.Spec.ServiceName
+	// Volume claim templates are immutable too; storage settings such as a snapshot
+	// source only apply to StatefulSets created after the change
+	desiredStatefulSet.Spec.VolumeClaimTemplates = currentStatefulSet.Spec.VolumeClaimTemplates
+
 	// Update existing StatefulSet if needed
 	currentStatefulSet.Spec = desiredStatefulSet.Spec
 	currentStatefulSet.Labels = desiredStatefulSet.Labels
@@ -391,53 +640,260 @@ func (r *OpNodeReconciler) reconcileStatefulSet(ctx context.Context, opNode *opt
 	return r.Update(ctx, &currentStatefulSet)
 }
 
-// reconcileService manages the Service for OpNode
-func (r *OpNodeReconciler) reconcileService(ctx context.Context, opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.OptimismNetwork) error {
-	desiredService := resources.CreateOpNodeService(opNode, network)
+// networkConfigHash hashes the rollup config and genesis content mounted into the pods
+func (r *OpNodeReconciler) networkConfigHash(ctx context.Context, namespace string, network *optimismv1alpha1.OptimismNetwork) (string, error) {
+	selectors := []corev1.ConfigMapKeySelector{resources.RollupConfigSelector(network)}
+	if genesis, ok := resources.GenesisSelector(network); ok {
+		selectors = append(selectors, genesis)
+	}
 
-	if err := ctrl.SetControllerReference(opNode, desiredService, r.Scheme); err != nil {
-		return err
+	hash := sha256.New()
+	for _, selector := range selectors {
+		var configMap corev1.ConfigMap
+		if err := r.Get(ctx, types.NamespacedName{Name: selector.Name, Namespace: namespace}, &configMap); err != nil {
+			return "", fmt.Errorf("failed to get network ConfigMap %s: %w", selector.Name, err)
+		}
+		value, ok := configMap.Data[selector.Key]
+		if !ok {
+			return "", fmt.Errorf("network ConfigMap %s has no key %q", selector.Name, selector.Key)
+		}
+		fmt.Fprintf(hash, "%s/%s\n%s\n", selector.Name, selector.Key, value)
 	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
 
-	var currentService corev1.Service
-	key := types.NamespacedName{Name: opNode.Name, Namespace: opNode.Namespace}
+// opNodesForConfigMap maps a ConfigMap to the OpNodes that mount it as network configuration
+func (r *OpNodeReconciler) opNodesForConfigMap(ctx context.Context, obj client.Object) []reconcile.Request {
+	var opNodes optimismv1alpha1.OpNodeList
+	if err := r.List(ctx, &opNodes, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
 
-	if err := r.Get(ctx, key, &currentService); err != nil {
-		if !apierrors.IsNotFound(err) {
+	var requests []reconcile.Request
+	for i := range opNodes.Items {
+		opNode := &opNodes.Items[i]
+		network, err := r.fetchOptimismNetwork(ctx, opNode)
+		if err != nil {
+			continue
+		}
+		mounted := resources.RollupConfigSelector(network).Name == obj.GetName()
+		if genesis, ok := resources.GenesisSelector(network); ok && genesis.Name == obj.GetName() {
+			mounted = true
+		}
+		if mounted {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(opNode)})
+		}
+	}
+	return requests
+}
+
+// reconcileService manages the load-balanced RPC Service and the headless Service for OpNode
+func (r *OpNodeReconciler) reconcileService(ctx context.Context, opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.OptimismNetwork) error {
+	for _, desiredService := range []*corev1.Service{
+		resources.CreateOpNodeService(opNode, network),
+		resources.CreateOpNodeHeadlessService(opNode, network),
+	} {
+		if err := ctrl.SetControllerReference(opNode, desiredService, r.Scheme); err != nil {
 			return err
 		}
 
-		// Create new Service
-		return r.Create(ctx, desiredService)
+		var currentService corev1.Service
+		if err := r.Get(ctx, client.ObjectKeyFromObject(desiredService), &currentService); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			// Create new Service
+			if err := r.Create(ctx, desiredService); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Update existing Service if needed
+		currentService.Spec.Ports = desiredService.Spec.Ports
+		currentService.Spec.Type = desiredService.Spec.Type
+		currentService.Labels = desiredService.Labels
+		currentService.Annotations = desiredService.Annotations
+
+		if err := r.Update(ctx, &currentService); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	// Update existing Service if needed
-	currentService.Spec.Ports = desiredService.Spec.Ports
-	currentService.Spec.Type = desiredService.Spec.Type
-	currentService.Labels = desiredService.Labels
-	currentService.Annotations = desiredService.Annotations
+// updateReplicaStatus records the StatefulSet pod counts and the pod selector used by the scale subresource
+func (r *OpNodeReconciler) updateReplicaStatus(ctx context.Context, opNode *optimismv1alpha1.OpNode) error {
+	var statefulSet appsv1.StatefulSet
+	if err := r.Get(ctx, client.ObjectKeyFromObject(opNode), &statefulSet); err != nil {
+		return err
+	}
 
-	return r.Update(ctx, &currentService)
+	opNode.Status.Replicas = statefulSet.Status.Replicas
+	opNode.Status.ReadyReplicas = statefulSet.Status.ReadyReplicas
+	opNode.Status.Selector = metav1.FormatLabelSelector(statefulSet.Spec.Selector)
+	return nil
 }
 
-// updateNodeStatus updates the node operational status
-func (r *OpNodeReconciler) updateNodeStatus(_ context.Context, opNode *optimismv1alpha1.OpNode) {
-	// For now, we'll set basic status information
-	// In a full implementation, this would query the actual node for status
+// updateNodeStatus refreshes the node operational status by polling op-node and op-geth
+// through the Service created by CreateOpNodeService
+func (r *OpNodeReconciler) updateNodeStatus(ctx context.Context, opNode *optimismv1alpha1.OpNode) {
+	logger := log.FromContext(ctx)
 
 	if opNode.Status.NodeInfo == nil {
 		opNode.Status.NodeInfo = &optimismv1alpha1.NodeInfo{}
 	}
 
-	// Set basic connectivity status
-	opNode.Status.NodeInfo.EngineConnected = true
-	opNode.Status.NodeInfo.PeerCount = 0 // Would be queried from actual node
+	// Both endpoints are only exposed on the Service when enabled
+	if opNode.Spec.OpNode.RPC == nil || !opNode.Spec.OpNode.RPC.Enabled {
+		utils.SetCondition(&opNode.Status.Conditions, "ChainSynced", metav1.ConditionUnknown, "RPCDisabled",
+			"op-node RPC is disabled; chain status cannot be polled")
+		return
+	}
+	opNodeURL := resources.GetOpNodeRPCEndpoint(opNode)
+	var opGethURL string
+	if opNode.Spec.OpGeth.Networking != nil && opNode.Spec.OpGeth.Networking.HTTP != nil && opNode.Spec.OpGeth.Networking.HTTP.Enabled &&
+		!resources.ExternalEngine(opNode) {
+		opGethURL = resources.GetOpGethRPCEndpoint(opNode)
+	}
+
+	if err := pollNodeStatus(ctx, opNodeURL, opGethURL, opNode.Status.NodeInfo); err != nil {
+		logger.V(1).Info("unable to poll node status", "error", err.Error())
+		utils.SetCondition(&opNode.Status.Conditions, "ChainSynced", metav1.ConditionUnknown, "StatusUnavailable",
+			fmt.Sprintf("Failed to poll node status: %v", err))
+		return
+	}
+
+	if opNode.Status.NodeInfo.SyncStatus.Syncing {
+		utils.SetCondition(&opNode.Status.Conditions, "ChainSynced", metav1.ConditionFalse, "Syncing",
+			fmt.Sprintf("Node is syncing: unsafe head %d, highest known block %d, head timestamp %s",
+				opNode.Status.NodeInfo.SyncStatus.CurrentBlock, opNode.Status.NodeInfo.SyncStatus.HighestBlock,
+				opNode.Status.NodeInfo.ChainHead.Timestamp.UTC().Format(time.RFC3339)))
+		return
+	}
+	utils.SetCondition(&opNode.Status.Conditions, "ChainSynced", metav1.ConditionTrue, "Synced",
+		fmt.Sprintf("Unsafe head at block %d", opNode.Status.NodeInfo.SyncStatus.CurrentBlock))
+}
+
+// maxHeadLag is how far the unsafe head timestamp may trail wall-clock time before the node is considered syncing
+const maxHeadLag = 5 * time.Minute
+
+// blockRef is the subset of an op-node L1 or L2 block reference used for status reporting
+type blockRef struct {
+	Hash      string `json:"hash"`
+	Number    uint64 `json:"number"`
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// rollupSyncStatus is the subset of the op-node optimism_syncStatus response used for status reporting
+type rollupSyncStatus struct {
+	CurrentL1   blockRef `json:"current_l1"`
+	HeadL1      blockRef `json:"head_l1"`
+	UnsafeL2    blockRef `json:"unsafe_l2"`
+	SafeL2      blockRef `json:"safe_l2"`
+	FinalizedL2 blockRef `json:"finalized_l2"`
+}
+
+// gethSyncProgress is the subset of the op-geth eth_syncing response used for status reporting
+type gethSyncProgress struct {
+	CurrentBlock hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock hexutil.Uint64 `json:"highestBlock"`
+}
+
+// pollNodeStatus queries optimism_syncStatus on op-node and eth_blockNumber, eth_syncing and
+// net_peerCount on op-geth, then fills in info. An empty opGethURL skips the op-geth queries.
+func pollNodeStatus(ctx context.Context, opNodeURL, opGethURL string, info *optimismv1alpha1.NodeInfo) error {
+	pollCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	status, err := pollRollupSyncStatus(pollCtx, opNodeURL)
+	if err != nil {
+		info.EngineConnected = false
+		return err
+	}
+
+	headTime := time.Unix(int64(status.UnsafeL2.Timestamp), 0)
+	info.ChainHead = &optimismv1alpha1.ChainHeadInfo{
+		BlockNumber: int64(status.UnsafeL2.Number),
+		BlockHash:   status.UnsafeL2.Hash,
+		Timestamp:   metav1.NewTime(headTime),
+	}
+	syncStatus := &optimismv1alpha1.SyncStatusInfo{
+		CurrentBlock:   int64(status.UnsafeL2.Number),
+		HighestBlock:   int64(status.UnsafeL2.Number),
+		SafeBlock:      int64(status.SafeL2.Number),
+		FinalizedBlock: int64(status.FinalizedL2.Number),
+		CurrentL1Block: int64(status.CurrentL1.Number),
+		// A stale unsafe head means the node is behind, even if neither client reports syncing
+		Syncing: time.Since(headTime) > maxHeadLag,
+	}
+	info.SyncStatus = syncStatus
+
+	if opGethURL == "" {
+		// op-node only advances the unsafe head through the engine API
+		info.EngineConnected = status.UnsafeL2.Number > 0
+		return nil
+	}
+
+	gethClient, err := rpc.DialContext(pollCtx, opGethURL)
+	if err != nil {
+		info.EngineConnected = false
+		return fmt.Errorf("failed to connect to op-geth: %w", err)
+	}
+	defer gethClient.Close()
+
+	var blockNumber hexutil.Uint64
+	if err := gethClient.CallContext(pollCtx, &blockNumber, "eth_blockNumber"); err != nil {
+		info.EngineConnected = false
+		return fmt.Errorf("eth_blockNumber failed: %w", err)
+	}
+	info.EngineConnected = true
+	if int64(blockNumber) > syncStatus.HighestBlock {
+		syncStatus.HighestBlock = int64(blockNumber)
+	}
+
+	// eth_syncing returns false when idle and a progress object while snap or EL syncing
+	var syncing json.RawMessage
+	if err := gethClient.CallContext(pollCtx, &syncing, "eth_syncing"); err != nil {
+		return fmt.Errorf("eth_syncing failed: %w", err)
+	}
+	if string(syncing) != "false" {
+		var progress gethSyncProgress
+		if err := json.Unmarshal(syncing, &progress); err != nil {
+			return fmt.Errorf("failed to decode eth_syncing result: %w", err)
+		}
+		syncStatus.Syncing = true
+		if int64(progress.HighestBlock) > syncStatus.HighestBlock {
+			syncStatus.HighestBlock = int64(progress.HighestBlock)
+		}
+	}
+
+	var peerCount hexutil.Uint
+	if err := gethClient.CallContext(pollCtx, &peerCount, "net_peerCount"); err != nil {
+		return fmt.Errorf("net_peerCount failed: %w", err)
+	}
+	info.PeerCount = int32(peerCount)
+
+	return nil
+}
+
+// pollRollupSyncStatus queries optimism_syncStatus on op-node
+func pollRollupSyncStatus(ctx context.Context, opNodeURL string) (*rollupSyncStatus, error) {
+	pollCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	nodeClient, err := rpc.DialContext(pollCtx, opNodeURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to op-node: %w", err)
+	}
+	defer nodeClient.Close()
 
-	// Set sync status
-	if opNode.Status.NodeInfo.SyncStatus == nil {
-		opNode.Status.NodeInfo.SyncStatus = &optimismv1alpha1.SyncStatusInfo{}
+	var status rollupSyncStatus
+	if err := nodeClient.CallContext(pollCtx, &status, "optimism_syncStatus"); err != nil {
+		return nil, fmt.Errorf("optimism_syncStatus failed: %w", err)
 	}
-	opNode.Status.NodeInfo.SyncStatus.Syncing = false // Would be queried from actual node
+	return &status, nil
 }
 
 // handleDeletion handles the deletion of OpNode resources
@@ -509,9 +965,12 @@ func (r *OpNodeReconciler) updateStatusWithRetry(ctx context.Context, opNode *op
 			}
 			if opNode.Status.NodeInfo.SyncStatus != nil {
 				latest.Status.NodeInfo.SyncStatus = &optimismv1alpha1.SyncStatusInfo{
-					CurrentBlock: opNode.Status.NodeInfo.SyncStatus.CurrentBlock,
-					HighestBlock: opNode.Status.NodeInfo.SyncStatus.HighestBlock,
-					Syncing:      opNode.Status.NodeInfo.SyncStatus.Syncing,
+					CurrentBlock:   opNode.Status.NodeInfo.SyncStatus.CurrentBlock,
+					HighestBlock:   opNode.Status.NodeInfo.SyncStatus.HighestBlock,
+					Syncing:        opNode.Status.NodeInfo.SyncStatus.Syncing,
+					SafeBlock:      opNode.Status.NodeInfo.SyncStatus.SafeBlock,
+					FinalizedBlock: opNode.Status.NodeInfo.SyncStatus.FinalizedBlock,
+					CurrentL1Block: opNode.Status.NodeInfo.SyncStatus.CurrentL1Block,
 				}
 			}
 			if opNode.Status.NodeInfo.ChainHead != nil {
@@ -522,6 +981,13 @@ func (r *OpNodeReconciler) updateStatusWithRetry(ctx context.Context, opNode *op
 				}
 			}
 		}
+		latest.Status.Upgrade = opNode.Status.Upgrade.DeepCopy()
+		latest.Status.Replicas = opNode.Status.Replicas
+		latest.Status.ReadyReplicas = opNode.Status.ReadyReplicas
+		latest.Status.Selector = opNode.Status.Selector
+		latest.Status.Conductor = opNode.Status.Conductor.DeepCopy()
+		latest.Status.Backups = append([]optimismv1alpha1.BackupSnapshot(nil), opNode.Status.Backups...)
+		latest.Status.Volumes = append([]optimismv1alpha1.VolumeStatus(nil), opNode.Status.Volumes...)
 
 		return r.Status().Update(ctx, latest)
 	})
@@ -534,6 +1000,11 @@ func (r *OpNodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.Secret{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.opNodesForConfigMap)).
+		Watches(&optimismv1alpha1.OptimismNetwork{}, handler.EnqueueRequestsFromMapFunc(networkDependents(mgr.GetClient(), &optimismv1alpha1.OpNodeList{},
+			func(obj client.Object) optimismv1alpha1.OptimismNetworkRef {
+				return obj.(*optimismv1alpha1.OpNode).Spec.OptimismNetworkRef
+			})), builder.WithPredicates(l1EndpointSwitched)).
 		Named("opnode").
 		Complete(r)
 }
@@ -18,16 +18,35 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/config"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
 )
 
 var _ = Describe("OpNode Controller", func() {
@@ -92,4 +111,1482 @@ var _ = Describe("OpNode Controller", func() {
 			// Example: If you expect a certain status condition after reconciliation, verify it here.
 		})
 	})
+
+	Context("Status Polling", func() {
+		ctx := context.Background()
+
+		It("Should populate head, sync and peer information from the node RPCs", func() {
+			headTime := time.Now().Add(-4 * time.Second).Unix()
+			opNodeServer := newJSONRPCStub(map[string]interface{}{
+				"optimism_syncStatus": map[string]interface{}{
+					"current_l1":   map[string]interface{}{"hash": "0x01", "number": 7000100, "timestamp": headTime},
+					"head_l1":      map[string]interface{}{"hash": "0x02", "number": 7000102, "timestamp": headTime},
+					"unsafe_l2":    map[string]interface{}{"hash": "0xabc", "number": 1200, "timestamp": headTime},
+					"safe_l2":      map[string]interface{}{"hash": "0xdef", "number": 1100, "timestamp": headTime},
+					"finalized_l2": map[string]interface{}{"hash": "0x123", "number": 1000, "timestamp": headTime},
+				},
+			})
+			defer opNodeServer.Close()
+			opGethServer := newJSONRPCStub(map[string]interface{}{
+				"eth_blockNumber": hexutil.Uint64(1200),
+				"eth_syncing":     false,
+				"net_peerCount":   hexutil.Uint(12),
+			})
+			defer opGethServer.Close()
+
+			info := &optimismv1alpha1.NodeInfo{}
+			Expect(pollNodeStatus(ctx, opNodeServer.URL, opGethServer.URL, info)).To(Succeed())
+			Expect(info.EngineConnected).To(BeTrue())
+			Expect(info.PeerCount).To(Equal(int32(12)))
+			Expect(info.ChainHead.BlockNumber).To(Equal(int64(1200)))
+			Expect(info.ChainHead.BlockHash).To(Equal("0xabc"))
+			Expect(info.SyncStatus.SafeBlock).To(Equal(int64(1100)))
+			Expect(info.SyncStatus.FinalizedBlock).To(Equal(int64(1000)))
+			Expect(info.SyncStatus.Syncing).To(BeFalse())
+		})
+
+		It("Should report a node with a stale head as syncing", func() {
+			staleTime := time.Now().Add(-48 * time.Hour).Unix()
+			opNodeServer := newJSONRPCStub(map[string]interface{}{
+				"optimism_syncStatus": map[string]interface{}{
+					"unsafe_l2": map[string]interface{}{"hash": "0xabc", "number": 500, "timestamp": staleTime},
+				},
+			})
+			defer opNodeServer.Close()
+			opGethServer := newJSONRPCStub(map[string]interface{}{
+				"eth_blockNumber": hexutil.Uint64(500),
+				"eth_syncing": map[string]interface{}{
+					"currentBlock": hexutil.Uint64(500),
+					"highestBlock": hexutil.Uint64(90000),
+				},
+				"net_peerCount": hexutil.Uint(3),
+			})
+			defer opGethServer.Close()
+
+			info := &optimismv1alpha1.NodeInfo{}
+			Expect(pollNodeStatus(ctx, opNodeServer.URL, opGethServer.URL, info)).To(Succeed())
+			Expect(info.SyncStatus.Syncing).To(BeTrue())
+			Expect(info.SyncStatus.HighestBlock).To(Equal(int64(90000)))
+		})
+
+		It("Should mark the engine disconnected when op-node is unreachable", func() {
+			opNodeServer := newJSONRPCStub(map[string]interface{}{})
+			opNodeServer.Close()
+
+			info := &optimismv1alpha1.NodeInfo{EngineConnected: true}
+			Expect(pollNodeStatus(ctx, opNodeServer.URL, "", info)).NotTo(Succeed())
+			Expect(info.EngineConnected).To(BeFalse())
+		})
+	})
+
+	Context("Network Configuration Mounts", func() {
+		ctx := context.Background()
+
+		newNetwork := func() *optimismv1alpha1.OptimismNetwork {
+			return &optimismv1alpha1.OptimismNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+				Spec: optimismv1alpha1.OptimismNetworkSpec{
+					ChainID:   901,
+					L1ChainID: 900,
+					L1RpcUrl:  "http://l1:8545",
+					RollupConfig: &optimismv1alpha1.ConfigSource{
+						ConfigMapRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "devnet-chain"},
+							Key:                  "rollup-901.json",
+						},
+					},
+					L2Genesis: &optimismv1alpha1.ConfigSource{Inline: `{"config": {"chainId": 901}}`},
+				},
+			}
+		}
+
+		newOpNode := func() *optimismv1alpha1.OpNode {
+			return &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-replica", Namespace: "default"},
+				Spec: optimismv1alpha1.OpNodeSpec{
+					OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+					NodeType:           "replica",
+				},
+			}
+		}
+
+		chainConfigMap := func(rollup string) *corev1.ConfigMap {
+			return &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-chain", Namespace: "default"},
+				Data:       map[string]string{"rollup-901.json": rollup},
+			}
+		}
+
+		genesisConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet-genesis", Namespace: "default"},
+			Data:       map[string]string{resources.GenesisKey: `{"config": {"chainId": 901}}`},
+		}
+
+		It("Should initialize an empty datadir from the genesis with the configured database settings", func() {
+			opNode := newOpNode()
+			opNode.Spec.OpGeth.DataDir = "/data/op-geth"
+			opNode.Spec.OpGeth.StateScheme = "hash"
+			opNode.Spec.OpGeth.DBEngine = "pebble"
+			opNode.Spec.OpGeth.Storage = &optimismv1alpha1.GethStorageConfig{
+				StorageConfig: optimismv1alpha1.StorageConfig{Size: resource.MustParse("100Gi")},
+				Snapshot:      &optimismv1alpha1.SnapshotSource{URL: "https://snapshots.example.com/geth.tar"},
+			}
+			statefulSet := resources.CreateOpNodeStatefulSet(opNode, newNetwork())
+
+			// A restored snapshot takes precedence over the genesis
+			initContainers := statefulSet.Spec.Template.Spec.InitContainers
+			Expect(initContainers).To(HaveLen(2))
+			Expect(initContainers[0].Name).To(Equal("snapshot-restore"))
+			gethInit := initContainers[1]
+			Expect(gethInit.Name).To(Equal(resources.GethInitContainerName))
+			Expect(gethInit.Image).To(Equal(config.DefaultImages.OpGeth))
+			Expect(gethInit.Args).To(Equal([]string{
+				"--datadir=/data/op-geth",
+				"--state.scheme=hash",
+				"--db.engine=pebble",
+				"/genesis/genesis.json",
+			}))
+			// Restarts leave an initialized datadir alone, but not the partial one of an interrupted restore
+			Expect(gethInit.Command[2]).To(ContainSubstring(`[ -d "$DATADIR/geth/chaindata" ]`))
+			Expect(gethInit.Command[2]).To(ContainSubstring(resources.SnapshotRestoringMarker))
+			Expect(gethInit.Env).To(ConsistOf(corev1.EnvVar{Name: "DATADIR", Value: "/data/op-geth"}))
+			Expect(gethInit.VolumeMounts).To(ConsistOf(
+				corev1.VolumeMount{Name: "geth-data", MountPath: "/data/op-geth"},
+				corev1.VolumeMount{Name: "l2-genesis", MountPath: "/genesis", ReadOnly: true},
+			))
+		})
+
+		It("Should only run geth init for networks with an L2 genesis and hold its image with op-geth", func() {
+			network := newNetwork()
+			network.Spec.L2Genesis = nil
+			Expect(resources.CreateOpNodeStatefulSet(newOpNode(), network).Spec.Template.Spec.InitContainers).To(BeEmpty())
+			network.Spec.L2Genesis = &optimismv1alpha1.ConfigSource{}
+			Expect(resources.CreateOpNodeStatefulSet(newOpNode(), network).Spec.Template.Spec.InitContainers).To(BeEmpty())
+
+			podSpec := resources.CreateOpNodeStatefulSet(newOpNode(), newNetwork()).Spec.Template.Spec
+			setContainerImages(&podSpec, optimismv1alpha1.NodeImages{OpGeth: "op-geth:previous", OpNode: "op-node:previous"})
+			Expect(podSpec.InitContainers[0].Image).To(Equal("op-geth:previous"))
+		})
+
+		It("Should run op-geth with the bundled network instead of initializing a referenced genesis", func() {
+			network := newNetwork()
+			network.Spec.L2Genesis = &optimismv1alpha1.ConfigSource{AutoDiscover: true}
+			network.Status.NetworkInfo = &optimismv1alpha1.NetworkInfo{OpGethNetwork: "op-sepolia"}
+			podSpec := resources.CreateOpNodeStatefulSet(newOpNode(), network).Spec.Template.Spec
+
+			Expect(podSpec.InitContainers).To(BeEmpty())
+			Expect(containerArgs(&podSpec, "op-geth")).To(ContainElement("--op-network=op-sepolia"))
+			Expect(podSpec.Volumes).NotTo(ContainElement(HaveField("Name", "l2-genesis")))
+
+			// An explicit opGeth.network wins
+			opNode := newOpNode()
+			opNode.Spec.OpGeth.Network = "op-sepolia"
+			podSpec = resources.CreateOpNodeStatefulSet(opNode, network).Spec.Template.Spec
+			Expect(containerArgs(&podSpec, "op-geth")).To(ContainElement("--op-network=op-sepolia"))
+		})
+
+		It("Should project the referenced key and the managed genesis into the pod", func() {
+			statefulSet := resources.CreateOpNodeStatefulSet(newOpNode(), newNetwork())
+			volumes := statefulSet.Spec.Template.Spec.Volumes
+
+			var rollupVolume, genesisVolume *corev1.Volume
+			for i := range volumes {
+				switch volumes[i].Name {
+				case "rollup-config":
+					rollupVolume = &volumes[i]
+				case "l2-genesis":
+					genesisVolume = &volumes[i]
+				}
+			}
+			Expect(rollupVolume).NotTo(BeNil())
+			Expect(rollupVolume.ConfigMap.Name).To(Equal("devnet-chain"))
+			Expect(rollupVolume.ConfigMap.Items).To(ConsistOf(corev1.KeyToPath{Key: "rollup-901.json", Path: resources.RollupConfigKey}))
+			Expect(genesisVolume).NotTo(BeNil())
+			Expect(genesisVolume.ConfigMap.Name).To(Equal("devnet-genesis"))
+
+			opGeth := statefulSet.Spec.Template.Spec.Containers[0]
+			Expect(opGeth.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "l2-genesis", MountPath: "/genesis", ReadOnly: true}))
+		})
+
+		It("Should change the config hash when referenced content changes", func() {
+			network := newNetwork()
+			reconciler := &OpNodeReconciler{Client: newFakeClient(chainConfigMap(`{"block_time": 2}`), genesisConfigMap)}
+			before, err := reconciler.networkConfigHash(ctx, "default", network)
+			Expect(err).NotTo(HaveOccurred())
+
+			reconciler.Client = newFakeClient(chainConfigMap(`{"block_time": 1}`), genesisConfigMap)
+			after, err := reconciler.networkConfigHash(ctx, "default", network)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after).NotTo(Equal(before))
+
+			reconciler.Client = newFakeClient(genesisConfigMap)
+			_, err = reconciler.networkConfigHash(ctx, "default", network)
+			Expect(err).To(MatchError(ContainSubstring("devnet-chain")))
+		})
+
+		It("Should enqueue OpNodes whose network mounts the changed ConfigMap", func() {
+			reconciler := &OpNodeReconciler{Client: newFakeClient(newNetwork(), newOpNode())}
+
+			requests := reconciler.opNodesForConfigMap(ctx, chainConfigMap(""))
+			Expect(requests).To(ConsistOf(reconcile.Request{NamespacedName: types.NamespacedName{Name: "devnet-replica", Namespace: "default"}}))
+
+			unrelated := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}
+			Expect(reconciler.opNodesForConfigMap(ctx, unrelated)).To(BeEmpty())
+		})
+	})
+
+	Context("Image Overrides", func() {
+		newNetwork := func(images *optimismv1alpha1.ImageOverrides) *optimismv1alpha1.OptimismNetwork {
+			return &optimismv1alpha1.OptimismNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+				Spec:       optimismv1alpha1.OptimismNetworkSpec{ChainID: 901, L1ChainID: 900, Images: images},
+			}
+		}
+		newOpNode := func(images *optimismv1alpha1.ImageOverrides) *optimismv1alpha1.OpNode {
+			return &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-replica", Namespace: "default"},
+				Spec:       optimismv1alpha1.OpNodeSpec{NodeType: "replica", Images: images},
+			}
+		}
+
+		It("Should deploy the default images without overrides", func() {
+			images, err := resources.ResolveImages(newNetwork(nil), newOpNode(nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(images.ImageConfig).To(Equal(config.DefaultImages))
+			Expect(images.PullPolicy).To(Equal(corev1.PullIfNotPresent))
+		})
+
+		It("Should let node-level overrides win over the network images", func() {
+			network := newNetwork(&optimismv1alpha1.ImageOverrides{
+				VersionSet: "stable-v1.13",
+				PullPolicy: corev1.PullAlways,
+			})
+			opNode := newOpNode(&optimismv1alpha1.ImageOverrides{
+				OpGethImage: "registry.local:5000/op-geth:v1.101511.0",
+				PullPolicy:  corev1.PullIfNotPresent,
+			})
+
+			statefulSet := resources.CreateOpNodeStatefulSet(opNode, network)
+			containers := statefulSet.Spec.Template.Spec.Containers
+			Expect(containers[0].Image).To(Equal("registry.local:5000/op-geth:v1.101511.0"))
+			Expect(containers[0].ImagePullPolicy).To(Equal(corev1.PullIfNotPresent))
+			Expect(containers[1].Image).To(Equal(config.OptimismRegistry + "/op-node:v1.13.3"))
+
+			// Other nodes on the network keep the network-level settings
+			images, err := resources.ResolveImages(network, newOpNode(nil))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(images.PullPolicy).To(Equal(corev1.PullAlways))
+		})
+
+		It("Should deploy the network images for components without an images block", func() {
+			network := newNetwork(&optimismv1alpha1.ImageOverrides{OpBatcherImage: "example.com/op-batcher:v1.12.1"})
+			batcher := &optimismv1alpha1.OpBatcher{ObjectMeta: metav1.ObjectMeta{Name: "batcher", Namespace: "default"}}
+
+			deployment := resources.CreateOpBatcherDeployment(batcher, network, newOpNode(nil))
+			Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("example.com/op-batcher:v1.12.1"))
+		})
+
+		It("Should reject an op-geth release that does not match the pinned op-node", func() {
+			network := newNetwork(&optimismv1alpha1.ImageOverrides{VersionSet: "stable-v1.13"})
+			opNode := newOpNode(&optimismv1alpha1.ImageOverrides{OpGethImage: config.OptimismRegistry + "/op-geth:v1.101500.0"})
+
+			_, err := resources.ResolveImages(network, opNode)
+			Expect(err).To(MatchError(ContainSubstring("use op-geth v1.101511.0 (stable-v1.13)")))
+		})
+
+		It("Should allow combinations the compatibility matrix does not cover", func() {
+			opNode := newOpNode(&optimismv1alpha1.ImageOverrides{
+				OpNodeImage: config.OptimismRegistry + "/op-node:v1.14.0",
+				OpGethImage: config.OptimismRegistry + "/op-geth@sha256:0123456789abcdef",
+			})
+			Expect(resources.ResolveImages(newNetwork(nil), opNode)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should reject an unknown version set", func() {
+			_, err := resources.ResolveImages(newNetwork(&optimismv1alpha1.ImageOverrides{VersionSet: "stable-v0.1"}), nil)
+			Expect(err).To(MatchError(ContainSubstring("version set stable-v0.1 not found")))
+		})
+	})
+
+	Context("Version Catalog", func() {
+		const catalog = `
+versionSets:
+  stable-v1.14:
+    opNode: v1.14.0
+    opGeth: v1.101600.0
+    opBatcher: v1.14.0
+    opProposer: v1.10.1
+    opChallenger: v1.5.2
+compatiblePairs:
+  - opNode: v1.13.3
+    opGeth: [v1.101511.1]
+`
+		var catalogPath string
+
+		BeforeEach(func() {
+			catalogPath = filepath.Join(GinkgoT().TempDir(), "version-sets.yaml")
+			Expect(os.WriteFile(catalogPath, []byte(catalog), 0o600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			config.SetVersionCatalog(config.VersionCatalog{})
+		})
+
+		network := &optimismv1alpha1.OptimismNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+			Spec:       optimismv1alpha1.OptimismNetworkSpec{ChainID: 901, L1ChainID: 900},
+		}
+		newOpNode := func(images *optimismv1alpha1.ImageOverrides) *optimismv1alpha1.OpNode {
+			return &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-replica", Namespace: "default"},
+				Spec:       optimismv1alpha1.OpNodeSpec{NodeType: "replica", Images: images},
+			}
+		}
+
+		It("Should resolve version sets and pairings loaded from the catalog", func() {
+			_, err := config.NewVersionCatalogWatcher(catalogPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			images, err := resources.ResolveImages(network, newOpNode(&optimismv1alpha1.ImageOverrides{VersionSet: "stable-v1.14"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(images.OpNode).To(Equal(config.OptimismRegistry + "/op-node:v1.14.0"))
+			Expect(images.OpGeth).To(Equal(config.OptimismRegistry + "/op-geth:v1.101600.0"))
+
+			// Built-in sets remain available
+			Expect(config.GetVersionSet("stable-v1.13")).Error().NotTo(HaveOccurred())
+
+			paired := newOpNode(&optimismv1alpha1.ImageOverrides{OpGethImage: config.OptimismRegistry + "/op-geth:v1.101511.1"})
+			Expect(resources.ResolveImages(network, paired)).Error().NotTo(HaveOccurred())
+
+			unpaired := newOpNode(&optimismv1alpha1.ImageOverrides{OpGethImage: config.OptimismRegistry + "/op-geth:v1.101500.0"})
+			_, err = resources.ResolveImages(network, unpaired)
+			Expect(err).To(MatchError(ContainSubstring("use op-geth v1.101511.0 (stable-v1.13) or v1.101511.1")))
+		})
+
+		It("Should fail to start with an invalid catalog", func() {
+			Expect(os.WriteFile(catalogPath, []byte("versionSets:\n  broken:\n    opNode: v1.14.0\n"), 0o600)).To(Succeed())
+			_, err := config.NewVersionCatalogWatcher(catalogPath)
+			Expect(err).To(MatchError(ContainSubstring("version set broken must pin opNode and opGeth")))
+
+			Expect(os.WriteFile(catalogPath, []byte("versionSet: {}\n"), 0o600)).To(Succeed())
+			Expect(config.NewVersionCatalogWatcher(catalogPath)).Error().To(HaveOccurred())
+		})
+
+		It("Should reload the catalog when the file changes and keep it on invalid content", func() {
+			watcher, err := config.NewVersionCatalogWatcher(catalogPath)
+			Expect(err).NotTo(HaveOccurred())
+			watcher.Interval = 10 * time.Millisecond
+
+			watchCtx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() {
+				defer GinkgoRecover()
+				Expect(watcher.Start(watchCtx)).To(Succeed())
+			}()
+
+			Expect(os.WriteFile(catalogPath, []byte("versionSets:\n  stable-v1.15:\n    opNode: v1.15.0\n    opGeth: v1.101700.0\n"), 0o600)).To(Succeed())
+			Eventually(func() error {
+				_, err := config.GetVersionSet("stable-v1.15")
+				return err
+			}).WithTimeout(2 * time.Second).WithPolling(10 * time.Millisecond).Should(Succeed())
+			Expect(config.GetVersionSet("stable-v1.14")).Error().To(HaveOccurred())
+
+			Expect(os.WriteFile(catalogPath, []byte("not: [valid"), 0o600)).To(Succeed())
+			Consistently(func() error {
+				_, err := config.GetVersionSet("stable-v1.15")
+				return err
+			}).WithTimeout(100 * time.Millisecond).WithPolling(10 * time.Millisecond).Should(Succeed())
+		})
+	})
+
+	Context("Rolling Upgrades", func() {
+		ctx := context.Background()
+
+		var (
+			network         *optimismv1alpha1.OptimismNetwork
+			rollupConfigMap *corev1.ConfigMap
+		)
+
+		BeforeEach(func() {
+			network = &optimismv1alpha1.OptimismNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+				Spec: optimismv1alpha1.OptimismNetworkSpec{
+					ChainID:      901,
+					L1ChainID:    900,
+					L1RpcUrl:     "http://l1:8545",
+					RollupConfig: &optimismv1alpha1.ConfigSource{Inline: "{}"},
+				},
+			}
+			rollupConfigMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: resources.RollupConfigMapName(network), Namespace: "default"},
+				Data:       map[string]string{resources.RollupConfigKey: "{}"},
+			}
+		})
+
+		newOpNode := func(name, nodeType string) *optimismv1alpha1.OpNode {
+			return &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Spec: optimismv1alpha1.OpNodeSpec{
+					OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+					NodeType:           nodeType,
+				},
+			}
+		}
+		upgradedGeth := config.OptimismRegistry + "/op-geth:v1.101511.1"
+		upgrade := func() *optimismv1alpha1.ImageOverrides {
+			return &optimismv1alpha1.ImageOverrides{GlobalVersion: "v1.13.4", OpGethImage: upgradedGeth}
+		}
+		upgradeTo := func(opNode *optimismv1alpha1.OpNode) {
+			opNode.Spec.Images = upgrade()
+		}
+		// OpNode status is not a subresource of the fake client, so a plain update persists it
+		saveStatus := func(c client.Client, opNode *optimismv1alpha1.OpNode) {
+			var stored optimismv1alpha1.OpNode
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(opNode), &stored)).To(Succeed())
+			stored.Status = *opNode.Status.DeepCopy()
+			Expect(c.Update(ctx, &stored)).To(Succeed())
+		}
+		runningImages := func(c client.Client, opNode *optimismv1alpha1.OpNode) optimismv1alpha1.NodeImages {
+			var statefulSet appsv1.StatefulSet
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(opNode), &statefulSet)).To(Succeed())
+			return containerImages(&statefulSet.Spec.Template.Spec)
+		}
+		markRolledOut := func(c client.Client, opNode *optimismv1alpha1.OpNode) {
+			var statefulSet appsv1.StatefulSet
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(opNode), &statefulSet)).To(Succeed())
+			statefulSet.Status.ObservedGeneration = statefulSet.Generation
+			statefulSet.Status.Replicas = 1
+			statefulSet.Status.UpdatedReplicas = 1
+			statefulSet.Status.ReadyReplicas = 1
+			Expect(c.Status().Update(ctx, &statefulSet)).To(Succeed())
+		}
+
+		It("Should complete an upgrade once the new pods pass the health gate", func() {
+			fakeClient := newFakeClient(rollupConfigMap)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+			opNode := newOpNode("devnet-replica", "replica")
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+			previous := *opNode.Status.Upgrade.CurrentImages
+
+			upgradeTo(opNode)
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+			Expect(runningImages(fakeClient, opNode).OpGeth).To(Equal(upgradedGeth))
+			Expect(opNode.Status.Upgrade.Phase).To(Equal(UpgradePhaseProgressing))
+
+			Expect(reconciler.checkUpgradeHealth(ctx, opNode)).To(Succeed())
+			Expect(opNode.Status.Upgrade.Phase).To(Equal(UpgradePhaseProgressing))
+			Expect(*opNode.Status.Upgrade.CurrentImages).To(Equal(previous))
+			Expect(meta.FindStatusCondition(opNode.Status.Conditions, "Upgraded").Message).To(ContainSubstring("upgraded pods to become ready"))
+
+			markRolledOut(fakeClient, opNode)
+			Expect(reconciler.checkUpgradeHealth(ctx, opNode)).To(Succeed())
+			Expect(opNode.Status.Upgrade.Phase).To(Equal(UpgradePhaseSucceeded))
+			Expect(opNode.Status.Upgrade.CurrentImages.OpGeth).To(Equal(upgradedGeth))
+			Expect(meta.IsStatusConditionTrue(opNode.Status.Conditions, "Upgraded")).To(BeTrue())
+		})
+
+		It("Should wait for a synced node with a fresh unsafe head when RPC is enabled", func() {
+			opNode := newOpNode("devnet-replica", "replica")
+			opNode.Spec.OpNode.RPC = &optimismv1alpha1.RPCConfig{Enabled: true}
+			startedAt := metav1.NewTime(time.Now().Add(-time.Minute))
+			opNode.Status.Upgrade = &optimismv1alpha1.UpgradeStatus{Phase: UpgradePhaseProgressing, StartedAt: &startedAt}
+			statefulSet := &appsv1.StatefulSet{Status: appsv1.StatefulSetStatus{UpdatedReplicas: 1, ReadyReplicas: 1}}
+
+			Expect(upgradeHealthGate(opNode, statefulSet)).To(Equal("sync status"))
+
+			opNode.Status.NodeInfo = &optimismv1alpha1.NodeInfo{
+				SyncStatus: &optimismv1alpha1.SyncStatusInfo{Syncing: true},
+				ChainHead:  &optimismv1alpha1.ChainHeadInfo{Timestamp: metav1.NewTime(time.Now())},
+			}
+			Expect(upgradeHealthGate(opNode, statefulSet)).To(Equal("the node to finish syncing"))
+
+			opNode.Status.NodeInfo.SyncStatus.Syncing = false
+			opNode.Status.NodeInfo.ChainHead.Timestamp = metav1.NewTime(startedAt.Add(-time.Second))
+			Expect(upgradeHealthGate(opNode, statefulSet)).To(Equal("a new unsafe head"))
+
+			opNode.Status.NodeInfo.ChainHead.Timestamp = metav1.NewTime(time.Now())
+			Expect(upgradeHealthGate(opNode, statefulSet)).To(BeEmpty())
+		})
+
+		It("Should hold sequencer upgrades until the replicas are upgraded", func() {
+			replica := newOpNode("devnet-replica", "replica")
+			sequencer := newOpNode("devnet-sequencer", "sequencer")
+			fakeClient := newFakeClient(rollupConfigMap, replica)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+			Expect(reconciler.reconcileStatefulSet(ctx, sequencer, network)).To(Succeed())
+			Expect(reconciler.reconcileStatefulSet(ctx, replica, network)).To(Succeed())
+			saveStatus(fakeClient, replica)
+
+			// The network images change for every node at once
+			network.Spec.Images = upgrade()
+			Expect(reconciler.reconcileStatefulSet(ctx, sequencer, network)).To(Succeed())
+			Expect(runningImages(fakeClient, sequencer).OpGeth).NotTo(Equal(upgradedGeth))
+			Expect(sequencer.Status.Upgrade.Phase).To(Equal(UpgradePhaseWaiting))
+			condition := meta.FindStatusCondition(sequencer.Status.Conditions, "Upgraded")
+			Expect(condition.Reason).To(Equal("WaitingForReplicas"))
+			Expect(condition.Message).To(ContainSubstring("devnet-replica"))
+
+			Expect(reconciler.reconcileStatefulSet(ctx, replica, network)).To(Succeed())
+			markRolledOut(fakeClient, replica)
+			Expect(reconciler.checkUpgradeHealth(ctx, replica)).To(Succeed())
+			saveStatus(fakeClient, replica)
+
+			Expect(reconciler.reconcileStatefulSet(ctx, sequencer, network)).To(Succeed())
+			Expect(runningImages(fakeClient, sequencer).OpGeth).To(Equal(upgradedGeth))
+			Expect(sequencer.Status.Upgrade.Phase).To(Equal(UpgradePhaseProgressing))
+		})
+
+		It("Should hold image changes while the strategy is paused", func() {
+			fakeClient := newFakeClient(rollupConfigMap)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+			opNode := newOpNode("devnet-replica", "replica")
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+
+			upgradeTo(opNode)
+			opNode.Spec.UpgradeStrategy = &optimismv1alpha1.UpgradeStrategy{Paused: true}
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+			Expect(runningImages(fakeClient, opNode).OpGeth).NotTo(Equal(upgradedGeth))
+			Expect(meta.FindStatusCondition(opNode.Status.Conditions, "Upgraded").Reason).To(Equal("Paused"))
+
+			opNode.Spec.UpgradeStrategy.Paused = false
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+			Expect(runningImages(fakeClient, opNode).OpGeth).To(Equal(upgradedGeth))
+		})
+
+		It("Should stop on a failed health gate and roll back when enabled", func() {
+			fakeClient := newFakeClient(rollupConfigMap)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+			opNode := newOpNode("devnet-replica", "replica")
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+			previous := *opNode.Status.Upgrade.CurrentImages
+
+			upgradeTo(opNode)
+			opNode.Spec.UpgradeStrategy = &optimismv1alpha1.UpgradeStrategy{HealthTimeout: "1ns"}
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+			Expect(reconciler.checkUpgradeHealth(ctx, opNode)).To(Succeed())
+			Expect(opNode.Status.Upgrade.Phase).To(Equal(UpgradePhaseFailed))
+
+			// A failed upgrade stays on its target instead of retrying
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+			Expect(runningImages(fakeClient, opNode).OpGeth).To(Equal(upgradedGeth))
+			Expect(meta.FindStatusCondition(opNode.Status.Conditions, "Upgraded").Reason).To(Equal("HealthCheckFailed"))
+
+			opNode.Spec.Images.OpGethImage = config.OptimismRegistry + "/op-geth:v1.101511.2"
+			opNode.Spec.UpgradeStrategy.AutoRollback = true
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+			Expect(reconciler.checkUpgradeHealth(ctx, opNode)).To(Succeed())
+			Expect(opNode.Status.Upgrade.Phase).To(Equal(UpgradePhaseRolledBack))
+
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+			Expect(runningImages(fakeClient, opNode)).To(Equal(previous))
+			Expect(meta.FindStatusCondition(opNode.Status.Conditions, "Upgraded").Reason).To(Equal("RolledBack"))
+		})
+	})
+
+	Context("Replica Scaling", func() {
+		ctx := context.Background()
+
+		network := &optimismv1alpha1.OptimismNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+			Spec: optimismv1alpha1.OptimismNetworkSpec{
+				ChainID:      901,
+				L1ChainID:    900,
+				L1RpcUrl:     "http://l1:8545",
+				RollupConfig: &optimismv1alpha1.ConfigSource{Inline: "{}"},
+			},
+		}
+		rollupConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.RollupConfigMapName(network), Namespace: "default"},
+			Data:       map[string]string{resources.RollupConfigKey: "{}"},
+		}
+
+		newOpNode := func(nodeType string, replicas int32) *optimismv1alpha1.OpNode {
+			return &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-rpc", Namespace: "default"},
+				Spec: optimismv1alpha1.OpNodeSpec{
+					OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+					NodeType:           nodeType,
+					Replicas:           &replicas,
+					OpNode: optimismv1alpha1.OpNodeConfig{
+						P2P: &optimismv1alpha1.P2PConfig{
+							Enabled:    true,
+							PrivateKey: &optimismv1alpha1.SecretKeyRef{Generate: true},
+						},
+					},
+				},
+			}
+		}
+
+		It("Should run one pod per replica with its own P2P key and data volume", func() {
+			statefulSet := resources.CreateOpNodeStatefulSet(newOpNode("replica", 3), network)
+			Expect(*statefulSet.Spec.Replicas).To(Equal(int32(3)))
+			Expect(statefulSet.Spec.ServiceName).To(Equal("devnet-rpc-headless"))
+			Expect(statefulSet.Spec.VolumeClaimTemplates).To(HaveLen(1))
+
+			opNodeContainer := statefulSet.Spec.Template.Spec.Containers[1]
+			Expect(opNodeContainer.Args).To(ContainElement("--p2p.priv.path=/secrets/p2p/private-key-$(POD_INDEX)"))
+			Expect(opNodeContainer.Env).To(ContainElement(HaveField("ValueFrom.FieldRef.FieldPath", "metadata.labels['apps.kubernetes.io/pod-index']")))
+		})
+
+		It("Should pin sequencers to a single replica", func() {
+			statefulSet := resources.CreateOpNodeStatefulSet(newOpNode("sequencer", 3), network)
+			Expect(*statefulSet.Spec.Replicas).To(Equal(int32(1)))
+
+			reconciler := &OpNodeReconciler{}
+			opNode := newOpNode("sequencer", 3)
+			opNode.Spec.OpNode.Sequencer = &optimismv1alpha1.SequencerConfig{Enabled: true}
+			Expect(reconciler.validateConfiguration(opNode)).To(MatchError(ContainSubstring("single replica")))
+		})
+
+		It("Should map a user-provided P2P key to the first ordinal", func() {
+			opNode := newOpNode("replica", 1)
+			opNode.Spec.OpNode.P2P.PrivateKey = &optimismv1alpha1.SecretKeyRef{
+				SecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "node-key"},
+					Key:                  "key",
+				},
+			}
+			statefulSet := resources.CreateOpNodeStatefulSet(opNode, network)
+			Expect(statefulSet.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("VolumeSource.Secret.Items",
+				ConsistOf(corev1.KeyToPath{Key: "key", Path: "private-key-0"}))))
+
+			opNode = newOpNode("replica", 2)
+			opNode.Spec.OpNode.P2P.PrivateKey = &optimismv1alpha1.SecretKeyRef{SecretRef: &corev1.SecretKeySelector{Key: "key"}}
+			Expect((&OpNodeReconciler{}).validateConfiguration(opNode)).To(MatchError(ContainSubstring("secretRef")))
+		})
+
+		It("Should generate a P2P key per ordinal and keep existing identities", func() {
+			legacy := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-rpc-p2p", Namespace: "default"},
+				Data:       map[string][]byte{"private-key": []byte("existing")},
+			}
+			fakeClient := newFakeClient(legacy)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+			Expect(reconciler.reconcileP2PSecret(ctx, newOpNode("replica", 3))).To(Succeed())
+			var secret corev1.Secret
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(legacy), &secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue("private-key-0", []byte("existing")))
+			Expect(secret.Data).To(HaveKey("private-key-1"))
+			Expect(secret.Data).To(HaveKey("private-key-2"))
+			Expect(secret.Data["private-key-1"]).NotTo(Equal(secret.Data["private-key-2"]))
+			scaledKey := secret.Data["private-key-2"]
+
+			// Scaling down and up again reuses the key of the ordinal
+			Expect(reconciler.reconcileP2PSecret(ctx, newOpNode("replica", 1))).To(Succeed())
+			Expect(reconciler.reconcileP2PSecret(ctx, newOpNode("replica", 3))).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(legacy), &secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue("private-key-2", scaledKey))
+		})
+
+		It("Should create a load-balanced RPC Service and a headless Service", func() {
+			fakeClient := newFakeClient()
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+			opNode := newOpNode("replica", 2)
+			opNode.Spec.Service = &optimismv1alpha1.ServiceConfig{Type: corev1.ServiceTypeLoadBalancer}
+			Expect(reconciler.reconcileService(ctx, opNode, network)).To(Succeed())
+
+			var service, headless corev1.Service
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "devnet-rpc", Namespace: "default"}, &service)).To(Succeed())
+			Expect(service.Spec.Type).To(Equal(corev1.ServiceTypeLoadBalancer))
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "devnet-rpc-headless", Namespace: "default"}, &headless)).To(Succeed())
+			Expect(headless.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
+			Expect(headless.Spec.PublishNotReadyAddresses).To(BeTrue())
+			Expect(headless.Spec.Selector).To(Equal(service.Spec.Selector))
+		})
+
+		It("Should scale an existing StatefulSet and report the scale status", func() {
+			opNode := newOpNode("replica", 1)
+			existing := resources.CreateOpNodeStatefulSet(opNode, network)
+			existing.Spec.ServiceName = "devnet-rpc"
+			fakeClient := newFakeClient(rollupConfigMap, existing)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+			opNode = newOpNode("replica", 4)
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+
+			var statefulSet appsv1.StatefulSet
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(existing), &statefulSet)).To(Succeed())
+			Expect(*statefulSet.Spec.Replicas).To(Equal(int32(4)))
+			Expect(statefulSet.Spec.ServiceName).To(Equal("devnet-rpc"))
+
+			statefulSet.Status.Replicas = 4
+			statefulSet.Status.ReadyReplicas = 2
+			Expect(fakeClient.Status().Update(ctx, &statefulSet)).To(Succeed())
+			Expect(reconciler.updateReplicaStatus(ctx, opNode)).To(Succeed())
+			Expect(opNode.Status.Replicas).To(Equal(int32(4)))
+			Expect(opNode.Status.ReadyReplicas).To(Equal(int32(2)))
+			Expect(opNode.Status.Selector).To(ContainSubstring("app.kubernetes.io/instance=devnet-rpc"))
+		})
+	})
+
+	Context("Snapshot Bootstrap", func() {
+		ctx := context.Background()
+
+		network := &optimismv1alpha1.OptimismNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+			Spec: optimismv1alpha1.OptimismNetworkSpec{
+				ChainID:      901,
+				L1ChainID:    900,
+				L1RpcUrl:     "http://l1:8545",
+				RollupConfig: &optimismv1alpha1.ConfigSource{Inline: "{}"},
+			},
+		}
+		rollupConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.RollupConfigMapName(network), Namespace: "default"},
+			Data:       map[string]string{resources.RollupConfigKey: "{}"},
+		}
+		checksum := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+		newOpNode := func(snapshot *optimismv1alpha1.SnapshotSource) *optimismv1alpha1.OpNode {
+			return &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-replica", Namespace: "default"},
+				Spec: optimismv1alpha1.OpNodeSpec{
+					OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+					NodeType:           "replica",
+					OpGeth: optimismv1alpha1.OpGethConfig{
+						Storage: &optimismv1alpha1.GethStorageConfig{
+							StorageConfig: optimismv1alpha1.StorageConfig{Size: resource.MustParse("500Gi")},
+							Snapshot:      snapshot,
+						},
+					},
+				},
+			}
+		}
+
+		It("Should stream, verify and extract an archive before op-geth starts", func() {
+			opNode := newOpNode(&optimismv1alpha1.SnapshotSource{URL: "https://snapshots.example.com/geth.tar.gz", SHA256: checksum})
+			statefulSet := resources.CreateOpNodeStatefulSet(opNode, network)
+
+			initContainers := statefulSet.Spec.Template.Spec.InitContainers
+			Expect(initContainers).To(HaveLen(1))
+			restore := initContainers[0]
+			Expect(restore.Image).To(Equal(config.DefaultSnapshotImage))
+			Expect(restore.Env).To(ContainElements(
+				corev1.EnvVar{Name: "DATADIR", Value: resources.DefaultOpGethDataDir},
+				corev1.EnvVar{Name: "SNAPSHOT_URL", Value: "https://snapshots.example.com/geth.tar.gz"},
+				corev1.EnvVar{Name: "SNAPSHOT_SHA256", Value: checksum},
+			))
+			// The archive is hashed while it is extracted, never stored on the volume
+			Expect(restore.Command[2]).To(ContainSubstring(`wget -q -O - "$SNAPSHOT_URL" | tee /tmp/snapshot.fifo | $decompress | tar -xf -`))
+			Expect(restore.Command[2]).To(ContainSubstring("sha256sum </tmp/snapshot.fifo"))
+			// Restarts skip a completed restore, and retry an interrupted one from scratch
+			Expect(restore.Command[2]).To(ContainSubstring(resources.SnapshotRestoredMarker))
+			Expect(restore.Command[2]).To(ContainSubstring(`if [ -e "$DATADIR/` + resources.SnapshotRestoringMarker + `" ]; then`))
+			Expect(restore.VolumeMounts).To(ConsistOf(corev1.VolumeMount{Name: "geth-data", MountPath: resources.DefaultOpGethDataDir}))
+			Expect(statefulSet.Spec.VolumeClaimTemplates[0].Spec.DataSource).To(BeNil())
+		})
+
+		It("Should provision data volumes from a VolumeSnapshot or a PVC", func() {
+			statefulSet := resources.CreateOpNodeStatefulSet(newOpNode(&optimismv1alpha1.SnapshotSource{VolumeSnapshotName: "geth-daily"}), network)
+			Expect(statefulSet.Spec.Template.Spec.InitContainers).To(BeEmpty())
+			dataSource := statefulSet.Spec.VolumeClaimTemplates[0].Spec.DataSource
+			Expect(dataSource.Kind).To(Equal("VolumeSnapshot"))
+			Expect(*dataSource.APIGroup).To(Equal("snapshot.storage.k8s.io"))
+			Expect(dataSource.Name).To(Equal("geth-daily"))
+
+			statefulSet = resources.CreateOpNodeStatefulSet(newOpNode(&optimismv1alpha1.SnapshotSource{PersistentVolumeClaimName: "geth-data-devnet-rpc-0"}), network)
+			dataSource = statefulSet.Spec.VolumeClaimTemplates[0].Spec.DataSource
+			Expect(dataSource.Kind).To(Equal("PersistentVolumeClaim"))
+			Expect(dataSource.APIGroup).To(BeNil())
+		})
+
+		It("Should reject ambiguous or unverifiable snapshot sources", func() {
+			reconciler := &OpNodeReconciler{}
+			Expect(reconciler.validateConfiguration(newOpNode(&optimismv1alpha1.SnapshotSource{URL: "https://snapshots.example.com/geth.tar", SHA256: checksum}))).To(Succeed())
+			Expect(reconciler.validateConfiguration(newOpNode(&optimismv1alpha1.SnapshotSource{URL: "https://snapshots.example.com/geth.tar", VolumeSnapshotName: "geth-daily"}))).
+				To(MatchError(ContainSubstring("exactly one")))
+			Expect(reconciler.validateConfiguration(newOpNode(&optimismv1alpha1.SnapshotSource{URL: "https://snapshots.example.com/geth.tar", SHA256: "abc"}))).
+				To(MatchError(ContainSubstring("sha256")))
+		})
+
+		It("Should not change the volume claim templates of an existing StatefulSet", func() {
+			existing := resources.CreateOpNodeStatefulSet(newOpNode(nil), network)
+			fakeClient := newFakeClient(rollupConfigMap, existing)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+			opNode := newOpNode(&optimismv1alpha1.SnapshotSource{VolumeSnapshotName: "geth-daily"})
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+
+			var statefulSet appsv1.StatefulSet
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(existing), &statefulSet)).To(Succeed())
+			Expect(statefulSet.Spec.VolumeClaimTemplates[0].Spec.DataSource).To(BeNil())
+		})
+	})
+
+	Context("Scheduled Backups", func() {
+		ctx := context.Background()
+
+		newOpNode := func() *optimismv1alpha1.OpNode {
+			return &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-archive", Namespace: "default"},
+				Spec: optimismv1alpha1.OpNodeSpec{
+					OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+					NodeType:           "replica",
+					Backup: &optimismv1alpha1.BackupConfig{
+						Enabled:                 true,
+						Interval:                "24h",
+						Retention:               2,
+						VolumeSnapshotClassName: "csi-snapclass",
+					},
+				},
+			}
+		}
+		dataClaim := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "geth-data-devnet-archive-0", Namespace: "default"},
+		}
+		existingSnapshot := func(opNode *optimismv1alpha1.OpNode, name string, age time.Duration, ready bool) *unstructured.Unstructured {
+			snapshot := resources.CreateOpGethVolumeSnapshot(opNode, name)
+			snapshot.SetCreationTimestamp(metav1.NewTime(time.Now().Add(-age)))
+			snapshot.SetAnnotations(map[string]string{
+				resources.MaxBlockNumberAnnotation:     "1000",
+				resources.MaxSafeBlockNumberAnnotation: "900",
+			})
+			Expect(unstructured.SetNestedField(snapshot.Object, time.Now().Add(-age).UTC().Format(time.RFC3339), "status", "creationTime")).To(Succeed())
+			Expect(unstructured.SetNestedField(snapshot.Object, ready, "status", "readyToUse")).To(Succeed())
+			return snapshot
+		}
+		listSnapshots := func(c client.Client) []unstructured.Unstructured {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(resources.VolumeSnapshotGVK.GroupVersion().WithKind("VolumeSnapshotList"))
+			Expect(c.List(ctx, list, client.InNamespace("default"))).To(Succeed())
+			return list.Items
+		}
+
+		It("Should snapshot the data volume without heads until the cut has happened", func() {
+			opNode := newOpNode()
+			opNode.Status.NodeInfo = &optimismv1alpha1.NodeInfo{
+				SyncStatus: &optimismv1alpha1.SyncStatusInfo{CurrentBlock: 1200, SafeBlock: 1100},
+			}
+			fakeClient := newFakeClient(dataClaim)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+			Expect(reconciler.reconcileBackups(ctx, opNode)).To(Succeed())
+			snapshots := listSnapshots(fakeClient)
+			Expect(snapshots).To(HaveLen(1))
+			source, _, _ := unstructured.NestedString(snapshots[0].Object, "spec", "source", "persistentVolumeClaimName")
+			Expect(source).To(Equal("geth-data-devnet-archive-0"))
+			className, _, _ := unstructured.NestedString(snapshots[0].Object, "spec", "volumeSnapshotClassName")
+			Expect(className).To(Equal("csi-snapclass"))
+			Expect(snapshots[0].GetOwnerReferences()).To(BeEmpty())
+
+			Expect(opNode.Status.Backups).To(HaveLen(1))
+			Expect(snapshots[0].GetAnnotations()).NotTo(HaveKey(resources.MaxBlockNumberAnnotation))
+			Expect(opNode.Status.Backups[0].BlockNumber).To(BeZero())
+			Expect(opNode.Status.Backups[0].SafeBlockNumber).To(BeZero())
+			Expect(meta.FindStatusCondition(opNode.Status.Conditions, "BackedUp").Reason).To(Equal("SnapshotPending"))
+			Expect(nextBackupIn(opNode)).To(BeNumerically("~", 24*time.Hour, time.Minute))
+			// The heads are read soon after the cut rather than on the next periodic reconcile
+			Expect(backupPending(opNode)).To(BeTrue())
+		})
+
+		It("Should wait for the interval and prune snapshots beyond the retention", func() {
+			opNode := newOpNode()
+			fakeClient := newFakeClient(dataClaim,
+				existingSnapshot(opNode, "devnet-archive-1", time.Hour, true),
+				existingSnapshot(opNode, "devnet-archive-2", 25*time.Hour, true),
+				existingSnapshot(opNode, "devnet-archive-3", 49*time.Hour, true))
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+			Expect(reconciler.reconcileBackups(ctx, opNode)).To(Succeed())
+			Expect(listSnapshots(fakeClient)).To(ConsistOf(
+				HaveField("Object", HaveKeyWithValue("metadata", HaveKeyWithValue("name", "devnet-archive-1"))),
+				HaveField("Object", HaveKeyWithValue("metadata", HaveKeyWithValue("name", "devnet-archive-2"))),
+			))
+			Expect(opNode.Status.Backups).To(HaveLen(2))
+			Expect(opNode.Status.Backups[0].Name).To(Equal("devnet-archive-1"))
+			Expect(opNode.Status.Backups[0].BlockNumber).To(Equal(int64(1000)))
+			Expect(meta.IsStatusConditionTrue(opNode.Status.Conditions, "BackedUp")).To(BeTrue())
+			Expect(backupPending(opNode)).To(BeFalse())
+		})
+
+		It("Should count only ready snapshots towards the retention and prune failed ones", func() {
+			opNode := newOpNode()
+			failed := func(name string, age time.Duration) *unstructured.Unstructured {
+				snapshot := existingSnapshot(opNode, name, age, false)
+				Expect(unstructured.SetNestedField(snapshot.Object, "snapshot controller failed to create snapshot content",
+					"status", "error", "message")).To(Succeed())
+				return snapshot
+			}
+			fakeClient := newFakeClient(dataClaim,
+				failed("devnet-archive-1", 30*time.Minute),
+				failed("devnet-archive-2", time.Hour),
+				existingSnapshot(opNode, "devnet-archive-3", 2*time.Hour, false),
+				existingSnapshot(opNode, "devnet-archive-4", 25*time.Hour, true),
+				existingSnapshot(opNode, "devnet-archive-5", 49*time.Hour, true),
+				existingSnapshot(opNode, "devnet-archive-6", 73*time.Hour, true))
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+			Expect(reconciler.reconcileBackups(ctx, opNode)).To(Succeed())
+			names := []string{}
+			for _, snapshot := range listSnapshots(fakeClient) {
+				names = append(names, snapshot.GetName())
+			}
+			// The newest failure and the pending snapshot stay, but only two ready snapshots are kept
+			Expect(names).To(ConsistOf("devnet-archive-1", "devnet-archive-3", "devnet-archive-4", "devnet-archive-5"))
+			Expect(opNode.Status.Backups).To(HaveLen(4))
+			condition := meta.FindStatusCondition(opNode.Status.Conditions, "BackedUp")
+			Expect(condition.Reason).To(Equal("SnapshotFailed"))
+			Expect(condition.Message).To(ContainSubstring("devnet-archive-1 failed"))
+		})
+
+		It("Should record the heads read after the cut as upper bounds", func() {
+			opNode := newOpNode()
+			cut := existingSnapshot(opNode, "devnet-archive-1", time.Minute, false)
+			cut.SetAnnotations(nil)
+			uncut := existingSnapshot(opNode, "devnet-archive-2", 0, false)
+			uncut.SetAnnotations(nil)
+			unstructured.RemoveNestedField(uncut.Object, "status", "creationTime")
+			recorded := existingSnapshot(opNode, "devnet-archive-3", 25*time.Hour, true)
+			fakeClient := newFakeClient(cut, uncut, recorded)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+			opNodeServer := newJSONRPCStub(map[string]interface{}{
+				"optimism_syncStatus": map[string]interface{}{
+					"unsafe_l2": map[string]interface{}{"hash": "0xabc", "number": 1200},
+					"safe_l2":   map[string]interface{}{"hash": "0xdef", "number": 1100},
+				},
+			})
+			defer opNodeServer.Close()
+
+			snapshots, err := reconciler.listBackupSnapshots(ctx, opNode)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.recordBackupHeads(ctx, opNodeServer.URL, snapshots)).To(Succeed())
+
+			heads := map[string]map[string]string{}
+			for _, snapshot := range listSnapshots(fakeClient) {
+				heads[snapshot.GetName()] = snapshot.GetAnnotations()
+			}
+			Expect(heads["devnet-archive-1"]).To(Equal(map[string]string{
+				resources.MaxBlockNumberAnnotation:     "1200",
+				resources.MaxSafeBlockNumberAnnotation: "1100",
+			}))
+			Expect(heads["devnet-archive-2"]).NotTo(HaveKey(resources.MaxBlockNumberAnnotation))
+			Expect(heads["devnet-archive-3"]).To(HaveKeyWithValue(resources.MaxBlockNumberAnnotation, "1000"))
+		})
+
+		It("Should not snapshot before the data volume exists", func() {
+			fakeClient := newFakeClient()
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+			opNode := newOpNode()
+
+			Expect(reconciler.reconcileBackups(ctx, opNode)).To(Succeed())
+			Expect(listSnapshots(fakeClient)).To(BeEmpty())
+			Expect(meta.FindStatusCondition(opNode.Status.Conditions, "BackedUp").Reason).To(Equal("WaitingForVolume"))
+		})
+	})
+
+	Context("Storage Expansion", func() {
+		ctx := context.Background()
+
+		newOpNode := func() *optimismv1alpha1.OpNode {
+			return &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-replica", Namespace: "default"},
+				Spec: optimismv1alpha1.OpNodeSpec{
+					OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+					NodeType:           "replica",
+					OpGeth: optimismv1alpha1.OpGethConfig{
+						Storage: &optimismv1alpha1.GethStorageConfig{
+							StorageConfig: optimismv1alpha1.StorageConfig{Size: resource.MustParse("100Gi")},
+							AutoGrow: &optimismv1alpha1.StorageAutoGrowConfig{
+								Enabled:          true,
+								ThresholdPercent: 80,
+								IncreasePercent:  20,
+								MaxSize:          resource.MustParse("200Gi"),
+							},
+						},
+					},
+				},
+			}
+		}
+		storageClass := func(expandable bool) *storagev1.StorageClass {
+			return &storagev1.StorageClass{
+				ObjectMeta:           metav1.ObjectMeta{Name: "fast-ssd"},
+				Provisioner:          "ebs.csi.aws.com",
+				AllowVolumeExpansion: &expandable,
+			}
+		}
+		dataClaim := func(size string) *corev1.PersistentVolumeClaim {
+			className := "fast-ssd"
+			return &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "geth-data-devnet-replica-0", Namespace: "default"},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: &className,
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+					},
+				},
+				Status: corev1.PersistentVolumeClaimStatus{
+					Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(size)},
+				},
+			}
+		}
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet-replica-0", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "worker-1"},
+		}
+		usage := func(used, capacity string) staticVolumeStats {
+			usedQuantity, capacityQuantity := resource.MustParse(used), resource.MustParse(capacity)
+			return staticVolumeStats{"worker-1": {
+				{Namespace: "default", Name: "geth-data-devnet-replica-0"}: {
+					UsedBytes:     uint64(usedQuantity.Value()),
+					CapacityBytes: uint64(capacityQuantity.Value()),
+				},
+			}}
+		}
+		requestedSize := func(c client.Client) string {
+			var claim corev1.PersistentVolumeClaim
+			Expect(c.Get(ctx, client.ObjectKey{Name: "geth-data-devnet-replica-0", Namespace: "default"}, &claim)).To(Succeed())
+			size := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+			return size.String()
+		}
+
+		It("Should expand existing volumes to a larger storage size", func() {
+			opNode := newOpNode()
+			opNode.Spec.OpGeth.Storage.AutoGrow = nil
+			fakeClient := newFakeClient(storageClass(true), dataClaim("50Gi"))
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+			Expect(reconciler.reconcileStorage(ctx, opNode)).To(Succeed())
+			Expect(requestedSize(fakeClient)).To(Equal("100Gi"))
+			Expect(opNode.Status.Volumes).To(HaveLen(1))
+			Expect(opNode.Status.Volumes[0].Requested.String()).To(Equal("100Gi"))
+			Expect(opNode.Status.Volumes[0].Capacity.String()).To(Equal("50Gi"))
+			Expect(meta.FindStatusCondition(opNode.Status.Conditions, "StorageSized").Reason).To(Equal("Resizing"))
+		})
+
+		It("Should report StorageClasses that do not allow expansion", func() {
+			opNode := newOpNode()
+			fakeClient := newFakeClient(storageClass(false), dataClaim("50Gi"))
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+			Expect(reconciler.reconcileStorage(ctx, opNode)).To(Succeed())
+			Expect(requestedSize(fakeClient)).To(Equal("50Gi"))
+			Expect(meta.FindStatusCondition(opNode.Status.Conditions, "StorageSized").Reason).To(Equal("ExpansionNotSupported"))
+		})
+
+		It("Should grow volumes past the usage threshold", func() {
+			opNode := newOpNode()
+			fakeClient := newFakeClient(storageClass(true), dataClaim("100Gi"), pod)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme(), VolumeStats: usage("85Gi", "100Gi")}
+
+			Expect(reconciler.reconcileStorage(ctx, opNode)).To(Succeed())
+			Expect(requestedSize(fakeClient)).To(Equal("120Gi"))
+			Expect(opNode.Status.Volumes[0].UsedPercent).To(Equal(int32(85)))
+		})
+
+		It("Should leave volumes below the threshold alone", func() {
+			opNode := newOpNode()
+			fakeClient := newFakeClient(storageClass(true), dataClaim("100Gi"), pod)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme(), VolumeStats: usage("50Gi", "100Gi")}
+
+			Expect(reconciler.reconcileStorage(ctx, opNode)).To(Succeed())
+			Expect(requestedSize(fakeClient)).To(Equal("100Gi"))
+			Expect(meta.IsStatusConditionTrue(opNode.Status.Conditions, "StorageSized")).To(BeTrue())
+		})
+
+		It("Should cap growth at the maximum size", func() {
+			opNode := newOpNode()
+			fakeClient := newFakeClient(storageClass(true), dataClaim("180Gi"), pod)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme(), VolumeStats: usage("170Gi", "180Gi")}
+
+			Expect(reconciler.reconcileStorage(ctx, opNode)).To(Succeed())
+			Expect(requestedSize(fakeClient)).To(Equal("200Gi"))
+
+			claim := dataClaim("200Gi")
+			fakeClient = newFakeClient(storageClass(true), claim, pod)
+			reconciler = &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme(), VolumeStats: usage("190Gi", "200Gi")}
+			Expect(reconciler.reconcileStorage(ctx, opNode)).To(Succeed())
+			Expect(requestedSize(fakeClient)).To(Equal("200Gi"))
+			Expect(meta.FindStatusCondition(opNode.Status.Conditions, "StorageSized").Reason).To(Equal("MaxSizeReached"))
+		})
+
+		It("Should parse PVC usage from the kubelet stats summary", func() {
+			summary := []byte(`{"pods":[{"volume":[
+				{"name":"geth-data","usedBytes":850,"capacityBytes":1000,"pvcRef":{"name":"geth-data-devnet-replica-0","namespace":"default"}},
+				{"name":"kube-api-access","usedBytes":10,"capacityBytes":100}
+			]}]}`)
+			usage, err := parseVolumeStats(summary)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(map[types.NamespacedName]VolumeUsage{
+				{Namespace: "default", Name: "geth-data-devnet-replica-0"}: {UsedBytes: 850, CapacityBytes: 1000},
+			}))
+		})
+	})
+
+	Context("Sequencer High Availability", func() {
+		ctx := context.Background()
+
+		network := &optimismv1alpha1.OptimismNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+			Spec: optimismv1alpha1.OptimismNetworkSpec{
+				ChainID:      901,
+				L1ChainID:    900,
+				L1RpcUrl:     "http://l1:8545",
+				RollupConfig: &optimismv1alpha1.ConfigSource{Inline: "{}"},
+			},
+		}
+
+		newOpNode := func() *optimismv1alpha1.OpNode {
+			return &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-sequencer", Namespace: "default"},
+				Spec: optimismv1alpha1.OpNodeSpec{
+					OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+					NodeType:           "sequencer",
+					HA:                 &optimismv1alpha1.SequencerHAConfig{Enabled: true, Members: 3},
+					OpNode: optimismv1alpha1.OpNodeConfig{
+						Sequencer: &optimismv1alpha1.SequencerConfig{Enabled: true},
+						RPC:       &optimismv1alpha1.RPCConfig{Enabled: true},
+						P2P: &optimismv1alpha1.P2PConfig{
+							Enabled:    true,
+							PrivateKey: &optimismv1alpha1.SecretKeyRef{Generate: true},
+						},
+					},
+					OpGeth: optimismv1alpha1.OpGethConfig{
+						Networking: &optimismv1alpha1.GethNetworkingConfig{
+							HTTP: &optimismv1alpha1.HTTPConfig{Enabled: true},
+						},
+					},
+				},
+			}
+		}
+		container := func(podSpec corev1.PodSpec, name string) corev1.Container {
+			for _, c := range podSpec.Containers {
+				if c.Name == name {
+					return c
+				}
+			}
+			Fail("container " + name + " not found")
+			return corev1.Container{}
+		}
+
+		It("Should run one pod per member with an op-conductor sidecar", func() {
+			statefulSet := resources.CreateOpNodeStatefulSet(newOpNode(), network)
+			Expect(*statefulSet.Spec.Replicas).To(Equal(int32(3)))
+			podSpec := statefulSet.Spec.Template.Spec
+			Expect(podSpec.Containers).To(HaveLen(3))
+
+			conductor := container(podSpec, "op-conductor")
+			Expect(conductor.Image).To(Equal(config.DefaultImages.OpConductor))
+			Expect(conductor.Args).To(ContainElements(
+				"--raft.server.id=$(POD_NAME)",
+				"--consensus.advertised=$(POD_NAME).devnet-sequencer-headless.default.svc.cluster.local:50050",
+				"--node.rpc=http://127.0.0.1:9545",
+				"--execution.rpc=http://127.0.0.1:8545",
+				"--rpc.port=8547",
+			))
+			// A member without Raft state bootstraps only when the controller annotates its pod to
+			Expect(conductor.Command[2]).NotTo(ContainSubstring("POD_INDEX"))
+			Expect(conductor.Command[2]).To(ContainSubstring(`'^optimism.io/conductor-raft="bootstrap"$' /etc/podinfo/annotations`))
+			Expect(conductor.Command[2]).To(ContainSubstring("--raft.bootstrap"))
+			Expect(conductor.VolumeMounts).To(ContainElements(
+				HaveField("SubPath", "conductor"),
+				And(HaveField("Name", "pod-info"), HaveField("MountPath", "/etc/podinfo")),
+			))
+			Expect(podSpec.Volumes).To(ContainElement(And(HaveField("Name", "pod-info"),
+				HaveField("VolumeSource.DownwardAPI.Items", ConsistOf(HaveField("FieldRef.FieldPath", "metadata.annotations"))))))
+
+			// Every member starts with the sequencer stopped until op-conductor elects it
+			opNodeContainer := container(podSpec, "op-node")
+			Expect(opNodeContainer.Command).To(Equal([]string{"op-node"}))
+			Expect(opNodeContainer.Args).To(ContainElements("--sequencer.stopped", "--conductor.enabled",
+				"--conductor.rpc=http://127.0.0.1:8547", "--rpc.enable-admin"))
+
+			headless := resources.CreateOpNodeHeadlessService(newOpNode(), network)
+			Expect(headless.Spec.Ports).To(ContainElements(HaveField("Port", int32(50050)), HaveField("Port", int32(8547))))
+		})
+
+		It("Should leave a sequencer without HA on a single pod", func() {
+			opNode := newOpNode()
+			opNode.Spec.HA.Enabled = false
+			statefulSet := resources.CreateOpNodeStatefulSet(opNode, network)
+			Expect(*statefulSet.Spec.Replicas).To(Equal(int32(1)))
+			Expect(statefulSet.Spec.Template.Spec.Containers).To(HaveLen(2))
+			Expect(container(statefulSet.Spec.Template.Spec, "op-node").Command).To(Equal([]string{"op-node"}))
+		})
+
+		It("Should require the RPCs op-conductor drives", func() {
+			reconciler := &OpNodeReconciler{}
+			Expect(reconciler.validateConfiguration(newOpNode())).To(Succeed())
+
+			opNode := newOpNode()
+			opNode.Spec.OpNode.RPC = nil
+			Expect(reconciler.validateConfiguration(opNode)).To(MatchError(ContainSubstring("op-node RPC")))
+
+			opNode = newOpNode()
+			opNode.Spec.NodeType = "replica"
+			Expect(reconciler.validateConfiguration(opNode)).To(MatchError(ContainSubstring("only supported for sequencer")))
+		})
+
+		It("Should report the leader and add running members as voters", func() {
+			opNode := newOpNode()
+			members := conductorMembers(opNode)
+			Expect(members).To(HaveLen(3))
+			Expect(members[1].Address).To(Equal("devnet-sequencer-1.devnet-sequencer-headless.default.svc.cluster.local:50050"))
+
+			membership := raftMembership{
+				Servers: []raftServer{{ID: members[0].ID, Addr: members[0].Address}},
+				Version: 1,
+			}
+			var added []string
+			leaderServer := newJSONRPCStub(map[string]interface{}{
+				"conductor_leader": true,
+				"conductor_clusterMembership": jsonRPCHandler(func(json.RawMessage) (interface{}, error) {
+					return membership, nil
+				}),
+				"conductor_addServerAsVoter": jsonRPCHandler(func(params json.RawMessage) (interface{}, error) {
+					var args []interface{}
+					Expect(json.Unmarshal(params, &args)).To(Succeed())
+					Expect(args[2]).To(BeNumerically("==", membership.Version))
+					added = append(added, args[0].(string))
+					membership.Servers = append(membership.Servers, raftServer{ID: args[0].(string), Addr: args[1].(string)})
+					membership.Version++
+					return nil, nil
+				}),
+			})
+			defer leaderServer.Close()
+			followerServer := newJSONRPCStub(map[string]interface{}{"conductor_leader": false})
+			defer followerServer.Close()
+			downServer := newJSONRPCStub(map[string]interface{}{})
+			downServer.Close()
+
+			members[0].Endpoint = leaderServer.URL
+			members[1].Endpoint = followerServer.URL
+			members[2].Endpoint = downServer.URL
+
+			status, err := reconcileConductorCluster(ctx, members)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(added).To(Equal([]string{"devnet-sequencer-1"}))
+			Expect(status.Leader).To(Equal("devnet-sequencer-0"))
+			Expect(status.Members).To(ConsistOf(
+				optimismv1alpha1.RaftMember{ID: "devnet-sequencer-0", Address: members[0].Address, Suffrage: "Voter"},
+				optimismv1alpha1.RaftMember{ID: "devnet-sequencer-1", Address: members[1].Address, Suffrage: "Voter"},
+			))
+		})
+
+		It("Should report no leader while the cluster is electing", func() {
+			followerServer := newJSONRPCStub(map[string]interface{}{"conductor_leader": false})
+			defer followerServer.Close()
+
+			status, err := reconcileConductorCluster(ctx, []conductorMember{{ID: "devnet-sequencer-0", Endpoint: followerServer.URL}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Leader).To(BeEmpty())
+
+			followerServer.Close()
+			_, err = reconcileConductorCluster(ctx, []conductorMember{{ID: "devnet-sequencer-0", Endpoint: followerServer.URL}})
+			Expect(err).To(MatchError(ContainSubstring("no op-conductor member is reachable")))
+		})
+
+		Describe("Raft bootstrap", func() {
+			memberPod := func(name string, ready bool) *corev1.Pod {
+				status := corev1.ConditionFalse
+				if ready {
+					status = corev1.ConditionTrue
+				}
+				return &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+					Status:     corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}}},
+				}
+			}
+			decisions := func(c client.Client) map[string]string {
+				result := map[string]string{}
+				for _, name := range []string{"devnet-sequencer-0", "devnet-sequencer-1", "devnet-sequencer-2"} {
+					var pod corev1.Pod
+					if err := c.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, &pod); err == nil {
+						result[name] = pod.Annotations[resources.ConductorRaftAnnotation]
+					}
+				}
+				return result
+			}
+
+			It("Should let only the first member of a new cluster bootstrap", func() {
+				opNode := newOpNode()
+				fakeClient := newFakeClient(memberPod("devnet-sequencer-0", false),
+					memberPod("devnet-sequencer-1", false), memberPod("devnet-sequencer-2", false))
+				reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+				members := conductorMembers(opNode)
+				bootstrappedBy, err := reconciler.reconcileConductorBootstrap(ctx, opNode, members, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bootstrappedBy).To(Equal("devnet-sequencer-0"))
+				Expect(decisions(fakeClient)).To(Equal(map[string]string{
+					"devnet-sequencer-0": "bootstrap",
+					"devnet-sequencer-1": "join",
+					"devnet-sequencer-2": "join",
+				}))
+
+				// Once a leader is elected, a member that loses its Raft state joins instead
+				elected := &optimismv1alpha1.ConductorStatus{Leader: "devnet-sequencer-0"}
+				bootstrappedBy, err = reconciler.reconcileConductorBootstrap(ctx, opNode, members, elected, bootstrappedBy)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bootstrappedBy).To(Equal("devnet-sequencer-0"))
+				Expect(decisions(fakeClient)).To(HaveKeyWithValue("devnet-sequencer-0", "join"))
+			})
+
+			It("Should never bootstrap next to members that report a cluster", func() {
+				opNode := newOpNode()
+				members := conductorMembers(opNode)
+
+				// Members of an existing cluster are electing a leader after the first pod was replaced
+				fakeClient := newFakeClient(memberPod("devnet-sequencer-0", false),
+					memberPod("devnet-sequencer-1", true), memberPod("devnet-sequencer-2", true))
+				reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+				electing := &optimismv1alpha1.ConductorStatus{Members: []optimismv1alpha1.RaftMember{
+					{ID: "devnet-sequencer-1", Suffrage: "Voter"}, {ID: "devnet-sequencer-2", Suffrage: "Voter"},
+				}}
+				bootstrappedBy, err := reconciler.reconcileConductorBootstrap(ctx, opNode, members, electing, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bootstrappedBy).To(BeEmpty())
+				Expect(decisions(fakeClient)).To(HaveEach("join"))
+
+				// Running members that cannot be queried may belong to a cluster as well
+				fakeClient = newFakeClient(memberPod("devnet-sequencer-0", false), memberPod("devnet-sequencer-1", true))
+				reconciler = &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+				bootstrappedBy, err = reconciler.reconcileConductorBootstrap(ctx, opNode, members, nil, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bootstrappedBy).To(BeEmpty())
+				Expect(decisions(fakeClient)).To(HaveEach("join"))
+			})
+
+			It("Should report the Raft configuration of a follower while no leader is elected", func() {
+				followerServer := newJSONRPCStub(map[string]interface{}{
+					"conductor_leader": false,
+					"conductor_clusterMembership": raftMembership{
+						Servers: []raftServer{{ID: "devnet-sequencer-1", Addr: "devnet-sequencer-1:50050"}},
+					},
+				})
+				defer followerServer.Close()
+
+				status, err := reconcileConductorCluster(ctx, []conductorMember{{ID: "devnet-sequencer-1", Endpoint: followerServer.URL}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status.Leader).To(BeEmpty())
+				Expect(status.Members).To(ConsistOf(HaveField("ID", "devnet-sequencer-1")))
+			})
+		})
+	})
+
+	Context("Forced Node Type Changes", func() {
+		ctx := context.Background()
+
+		network := &optimismv1alpha1.OptimismNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+			Spec: optimismv1alpha1.OptimismNetworkSpec{
+				ChainID:      901,
+				L1ChainID:    900,
+				L1RpcUrl:     "http://l1:8545",
+				RollupConfig: &optimismv1alpha1.ConfigSource{Inline: "{}"},
+			},
+		}
+		rollupConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.RollupConfigMapName(network), Namespace: "default"},
+			Data:       map[string]string{resources.RollupConfigKey: "{}"},
+		}
+
+		newOpNode := func(nodeType string) *optimismv1alpha1.OpNode {
+			return &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-node", Namespace: "default"},
+				Spec: optimismv1alpha1.OpNodeSpec{
+					OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+					NodeType:           nodeType,
+				},
+			}
+		}
+
+		It("Should refuse to rewrite the StatefulSet of another node type", func() {
+			existing := resources.CreateOpNodeStatefulSet(newOpNode("replica"), network)
+			fakeClient := newFakeClient(rollupConfigMap, existing)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+			err := reconciler.reconcileStatefulSet(ctx, newOpNode("sequencer"), network)
+			Expect(err).To(MatchError(ContainSubstring(optimismv1alpha1.ForceUpdateAnnotation)))
+
+			var statefulSet appsv1.StatefulSet
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(existing), &statefulSet)).To(Succeed())
+			Expect(statefulSet.Labels).To(HaveKeyWithValue(resources.NodeTypeLabel, "replica"))
+		})
+
+		It("Should recreate the StatefulSet when the change is forced", func() {
+			existing := resources.CreateOpNodeStatefulSet(newOpNode("replica"), network)
+			fakeClient := newFakeClient(rollupConfigMap, existing)
+			reconciler := &OpNodeReconciler{Client: fakeClient, Scheme: fakeClient.Scheme()}
+
+			opNode := newOpNode("sequencer")
+			opNode.Annotations = map[string]string{optimismv1alpha1.ForceUpdateAnnotation: "spec.nodeType"}
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(MatchError(errRecreatingStatefulSet))
+
+			var statefulSet appsv1.StatefulSet
+			err := fakeClient.Get(ctx, client.ObjectKeyFromObject(existing), &statefulSet)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			Expect(reconciler.reconcileStatefulSet(ctx, opNode, network)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(existing), &statefulSet)).To(Succeed())
+			Expect(statefulSet.Labels).To(HaveKeyWithValue(resources.NodeTypeLabel, "sequencer"))
+		})
+
+		It("Should drop the force-update annotation once applied", func() {
+			opNode := newOpNode("sequencer")
+			opNode.Annotations = map[string]string{
+				optimismv1alpha1.ForceUpdateAnnotation: "spec.nodeType",
+				"team":                                 "infra",
+			}
+			fakeClient := newFakeClient(opNode)
+
+			Expect(clearForceUpdate(ctx, fakeClient, opNode)).To(Succeed())
+
+			var stored optimismv1alpha1.OpNode
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(opNode), &stored)).To(Succeed())
+			Expect(stored.Annotations).NotTo(HaveKey(optimismv1alpha1.ForceUpdateAnnotation))
+			Expect(stored.Annotations).To(HaveKeyWithValue("team", "infra"))
+		})
+	})
 })
+
+// newFakeClient returns an in-memory client seeded with objs for specs that need no API server
+func newFakeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	Expect(optimismv1alpha1.AddToScheme(scheme)).To(Succeed())
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+// staticVolumeStats serves fixed volume usage per node
+type staticVolumeStats map[string]map[types.NamespacedName]VolumeUsage
+
+func (s staticVolumeStats) VolumeUsage(_ context.Context, nodeName string) (map[types.NamespacedName]VolumeUsage, error) {
+	return s[nodeName], nil
+}
+
+// jsonRPCHandler computes a JSON-RPC result from the raw request params
+type jsonRPCHandler func(params json.RawMessage) (interface{}, error)
+
+// newJSONRPCStub starts an HTTP server answering JSON-RPC calls from a method to result map.
+// A jsonRPCHandler value is invoked with the request params instead of returned verbatim.
+func newJSONRPCStub(results map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		result, ok := results[req.Method]
+		if !ok {
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		} else if handler, isHandler := result.(jsonRPCHandler); isHandler {
+			if value, err := handler(req.Params); err != nil {
+				resp["error"] = map[string]interface{}{"code": 3, "message": err.Error()}
+			} else {
+				resp["result"] = value
+			}
+		} else {
+			resp["result"] = result
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+// opNodeGoldenDir holds the expected op-node arguments, one per line
+const opNodeGoldenDir = "testdata/opnode-flags"
+
+var _ = Describe("op-node Flags", func() {
+	newNetwork := func() *optimismv1alpha1.OptimismNetwork {
+		return &optimismv1alpha1.OptimismNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+			Spec: optimismv1alpha1.OptimismNetworkSpec{
+				ChainID:   901,
+				L1ChainID: 900,
+				L1RpcUrl:  "http://l1:8545",
+			},
+		}
+	}
+	newOpNode := func(config optimismv1alpha1.OpNodeConfig) *optimismv1alpha1.OpNode {
+		return &optimismv1alpha1.OpNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet-replica", Namespace: "default"},
+			Spec: optimismv1alpha1.OpNodeSpec{
+				OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+				NodeType:           "replica",
+				OpNode:             config,
+			},
+		}
+	}
+
+	DescribeTable("Should render every configured setting",
+		func(golden string, config optimismv1alpha1.OpNodeConfig, beaconURL string) {
+			Expect(resources.ValidateOpNodeConfig(field.NewPath("spec", "opNode"), &config)).To(BeEmpty())
+			network := newNetwork()
+			network.Spec.L1BeaconUrl = beaconURL
+			statefulSet := resources.CreateOpNodeStatefulSet(newOpNode(config), network)
+			expectGoldenArgs(opNodeGoldenDir, golden, containerArgs(&statefulSet.Spec.Template.Spec, "op-node"))
+		},
+		Entry("defaults", "defaults", optimismv1alpha1.OpNodeConfig{}, ""),
+		Entry("L1 beacon and RPC client", "l1", optimismv1alpha1.OpNodeConfig{
+			SyncMode: "execution-layer",
+			L1:       &optimismv1alpha1.L1ClientConfig{RPCKind: "erigon", TrustRPC: true},
+		}, "http://beacon:5052"),
+		Entry("peer-to-peer", "p2p", optimismv1alpha1.OpNodeConfig{
+			P2P: &optimismv1alpha1.P2PConfig{
+				Enabled:     true,
+				ListenPort:  9222,
+				Discovery:   &optimismv1alpha1.P2PDiscoveryConfig{Enabled: true, Bootnodes: []string{"enr:-J64QBbwPjPLZ6IOOToOLsSjtFUjjzN66qmBZdUexpO32Klrc", "enode://ca21@10.0.0.1:30305"}},
+				Static:      []string{"/ip4/10.0.0.2/tcp/9222/p2p/16Uiu2HAmQ8DR4a3fu8CZ8ZcixFD86rsBnNVwPTgh1cPvgDuiVZ8y"},
+				PeerScoring: &optimismv1alpha1.P2PScoringConfig{Enabled: true},
+			},
+		}, ""),
+		Entry("peer scoring disabled", "p2p-no-scoring", optimismv1alpha1.OpNodeConfig{
+			P2P: &optimismv1alpha1.P2PConfig{
+				Enabled:     true,
+				Discovery:   &optimismv1alpha1.P2PDiscoveryConfig{Enabled: false},
+				PeerScoring: &optimismv1alpha1.P2PScoringConfig{},
+			},
+		}, ""),
+		Entry("external engine", "engine", optimismv1alpha1.OpNodeConfig{
+			Engine: &optimismv1alpha1.EngineConfig{Endpoint: "http://reth.execution:8551"},
+		}, ""),
+	)
+
+	It("Should drive an external engine without deploying op-geth", func() {
+		opNode := newOpNode(optimismv1alpha1.OpNodeConfig{
+			Engine: &optimismv1alpha1.EngineConfig{
+				Endpoint: "http://reth.execution:8551",
+				JWTSecret: &optimismv1alpha1.SecretKeyRef{SecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "reth-jwt"},
+					Key:                  "jwt.hex",
+				}},
+			},
+		})
+		statefulSet := resources.CreateOpNodeStatefulSet(opNode, newNetwork())
+
+		podSpec := statefulSet.Spec.Template.Spec
+		Expect(podSpec.Containers).To(ConsistOf(HaveField("Name", "op-node")))
+		Expect(podSpec.InitContainers).To(BeEmpty())
+		Expect(statefulSet.Spec.VolumeClaimTemplates).To(BeEmpty())
+		Expect(podSpec.Volumes).To(ContainElement(SatisfyAll(
+			HaveField("Name", "jwt-secret"),
+			HaveField("VolumeSource.Secret.SecretName", "reth-jwt"),
+			HaveField("VolumeSource.Secret.Items", ConsistOf(corev1.KeyToPath{Key: "jwt.hex", Path: "jwt"})),
+		)))
+		Expect(resources.GeneratesJWTSecret(opNode)).To(BeFalse())
+	})
+
+	It("Should limit the pod bandwidth through the CNI annotations", func() {
+		opNode := newOpNode(optimismv1alpha1.OpNodeConfig{})
+		opNode.Spec.PodBandwidthLimit = "100M"
+		annotations := resources.CreateOpNodeStatefulSet(opNode, newNetwork()).Spec.Template.Annotations
+		Expect(annotations).To(HaveKeyWithValue(resources.IngressBandwidthAnnotation, "100M"))
+		Expect(annotations).To(HaveKeyWithValue(resources.EgressBandwidthAnnotation, "100M"))
+
+		errs := resources.ValidatePodBandwidthLimit(field.NewPath("spec", "podBandwidthLimit"), "10MB")
+		Expect(errs.ToAggregate()).To(MatchError(ContainSubstring(`spec.podBandwidthLimit: Invalid value: "10MB"`)))
+	})
+
+	It("Should reject invalid settings", func() {
+		errs := resources.ValidateOpNodeConfig(field.NewPath("spec", "opNode"), &optimismv1alpha1.OpNodeConfig{
+			SyncMode: "snap",
+			L1:       &optimismv1alpha1.L1ClientConfig{RPCKind: "geth"},
+			P2P: &optimismv1alpha1.P2PConfig{
+				Discovery: &optimismv1alpha1.P2PDiscoveryConfig{Bootnodes: []string{"10.0.0.1:30305"}},
+				Static:    []string{"10.0.0.2:9222"},
+			},
+			Sequencer: &optimismv1alpha1.SequencerConfig{MaxTxPerBlock: -1},
+			Engine:    &optimismv1alpha1.EngineConfig{Endpoint: "reth:8551"},
+		})
+		Expect(errs.ToAggregate().Error()).To(SatisfyAll(
+			ContainSubstring(`spec.opNode.syncMode: Unsupported value: "snap"`),
+			ContainSubstring(`spec.opNode.l1.rpcKind: Unsupported value: "geth"`),
+			ContainSubstring("spec.opNode.p2p.discovery.bootnodes[0]"),
+			ContainSubstring("spec.opNode.p2p.static[0]"),
+			ContainSubstring("spec.opNode.sequencer.maxTxPerBlock"),
+			ContainSubstring("spec.opNode.engine.endpoint"),
+		))
+	})
+})
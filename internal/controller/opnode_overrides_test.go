@@ -0,0 +1,158 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+var _ = Describe("OpNode Overrides", func() {
+	var (
+		network *optimismv1alpha1.OptimismNetwork
+		opNode  *optimismv1alpha1.OpNode
+	)
+
+	BeforeEach(func() {
+		network = &optimismv1alpha1.OptimismNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+			Spec: optimismv1alpha1.OptimismNetworkSpec{
+				ChainID:   901,
+				L1ChainID: 900,
+				L1RpcUrl:  "http://l1:8545",
+			},
+		}
+		opNode = &optimismv1alpha1.OpNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "devnet-replica", Namespace: "default"},
+			Spec: optimismv1alpha1.OpNodeSpec{
+				OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "devnet"},
+				NodeType:           "replica",
+			},
+		}
+	})
+
+	container := func(podSpec *corev1.PodSpec, name string) corev1.Container {
+		for _, c := range podSpec.Containers {
+			if c.Name == name {
+				return c
+			}
+		}
+		Fail("no " + name + " container")
+		return corev1.Container{}
+	}
+
+	It("Should merge extra container settings, volumes and sidecars", func() {
+		opNode.Spec.OpNode.ExtraArgs = []string{"--log.level=debug", "--metrics.enabled"}
+		opNode.Spec.OpGeth.ExtraArgs = []string{"--verbosity", "4"}
+		opNode.Spec.OpGeth.ExtraEnv = []corev1.EnvVar{{Name: "GOMEMLIMIT", Value: "28GiB"}}
+		opNode.Spec.OpGeth.ExtraVolumeMounts = []corev1.VolumeMount{{Name: "ancient", MountPath: "/ancient"}}
+		opNode.Spec.ExtraVolumes = []corev1.Volume{{
+			Name:         "ancient",
+			VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "ancient"}},
+		}}
+		opNode.Spec.Sidecars = []corev1.Container{{Name: "exporter", Image: "exporter:v1"}}
+
+		Expect(resources.OpNodeOverrideConflicts(opNode, network)).To(BeEmpty())
+		podSpec := resources.CreateOpNodeStatefulSet(opNode, network).Spec.Template.Spec
+
+		Expect(container(&podSpec, "op-node").Args).To(HaveExactElements(
+			ContainSubstring("--l1="), ContainSubstring("--l2="), ContainSubstring("--l2.jwt-secret="), ContainSubstring("--rollup.config="),
+			"--log.level=debug", "--metrics.enabled",
+		))
+		geth := container(&podSpec, "op-geth")
+		Expect(geth.Args[len(geth.Args)-2:]).To(Equal([]string{"--verbosity", "4"}))
+		Expect(geth.Env).To(ContainElement(corev1.EnvVar{Name: "GOMEMLIMIT", Value: "28GiB"}))
+		Expect(geth.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "ancient", MountPath: "/ancient"}))
+		Expect(podSpec.Volumes).To(ContainElement(HaveField("Name", "ancient")))
+		Expect(podSpec.Containers[len(podSpec.Containers)-1].Name).To(Equal("exporter"))
+	})
+
+	It("Should leave out and report overrides of operator-managed settings", func() {
+		opNode.Spec.OpNode.ExtraArgs = []string{"--l1=http://other:8545", "--log.level=debug"}
+		opNode.Spec.OpGeth.ExtraArgs = []string{"--syncmode", "full", "--verbosity=4"}
+		opNode.Spec.OpGeth.ExtraVolumeMounts = []corev1.VolumeMount{{Name: "scratch", MountPath: resources.DefaultOpGethDataDir}}
+		opNode.Spec.ExtraVolumes = []corev1.Volume{{Name: "jwt-secret"}}
+		opNode.Spec.Sidecars = []corev1.Container{{Name: "op-node", Image: "op-node:fork"}}
+
+		Expect(resources.OpNodeOverrideConflicts(opNode, network)).To(ConsistOf(
+			"spec.opNode.extraArgs[0]: flag --l1 is managed by the operator",
+			"spec.opGeth.extraArgs[0]: flag --syncmode is managed by the operator",
+			"spec.opGeth.extraVolumeMounts[0]: mount path "+resources.DefaultOpGethDataDir+" is managed by the operator",
+			"spec.extraVolumes[0]: volume jwt-secret is managed by the operator",
+			"spec.sidecars[0]: container op-node is managed by the operator",
+		))
+
+		podSpec := resources.CreateOpNodeStatefulSet(opNode, network).Spec.Template.Spec
+		Expect(container(&podSpec, "op-node").Args).To(ContainElement("--l1=http://l1:8545"))
+		Expect(container(&podSpec, "op-node").Args).NotTo(ContainElement("--l1=http://other:8545"))
+		Expect(container(&podSpec, "op-node").Args).To(ContainElement("--log.level=debug"))
+		gethArgs := container(&podSpec, "op-geth").Args
+		Expect(gethArgs).NotTo(ContainElement("full"))
+		Expect(gethArgs).To(ContainElement("--verbosity=4"))
+		Expect(podSpec.Containers).To(HaveLen(2))
+	})
+
+	It("Should report op-geth overrides when an external engine replaces op-geth", func() {
+		opNode.Spec.OpNode.Engine = &optimismv1alpha1.EngineConfig{Endpoint: "http://reth.execution:8551"}
+		opNode.Spec.OpGeth.ExtraArgs = []string{"--verbosity=4"}
+		Expect(resources.OpNodeOverrideConflicts(opNode, network)).To(ConsistOf("spec.opGeth: no op-geth container runs in the pod"))
+	})
+
+	It("Should strategically merge the pod template overrides", func() {
+		opNode.Spec.PodTemplate = &optimismv1alpha1.PodTemplateOverrides{
+			NodeSelector:      map[string]string{"pool": "nvme"},
+			Tolerations:       []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "op-stack", Effect: corev1.TaintEffectNoSchedule}},
+			PriorityClassName: "op-stack-critical",
+			Affinity: &corev1.Affinity{PodAntiAffinity: &corev1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []corev1.PodAffinityTerm{{
+					TopologyKey:   "kubernetes.io/hostname",
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "op-node"}},
+				}},
+			}},
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+				MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: corev1.ScheduleAnyway,
+			}},
+		}
+
+		Expect(resources.OpNodeOverrideConflicts(opNode, network)).To(BeEmpty())
+		podSpec := resources.CreateOpNodeStatefulSet(opNode, network).Spec.Template.Spec
+		Expect(podSpec.NodeSelector).To(Equal(map[string]string{"pool": "nvme"}))
+		Expect(podSpec.Tolerations).To(Equal(opNode.Spec.PodTemplate.Tolerations))
+		Expect(podSpec.PriorityClassName).To(Equal("op-stack-critical"))
+		Expect(podSpec.Affinity).To(Equal(opNode.Spec.PodTemplate.Affinity))
+		Expect(podSpec.TopologySpreadConstraints).To(Equal(opNode.Spec.PodTemplate.TopologySpreadConstraints))
+		Expect(podSpec.Containers).To(HaveLen(2))
+		Expect(podSpec.SecurityContext).NotTo(BeNil())
+	})
+
+	It("Should reject duplicate volumes and sidecars and empty arguments", func() {
+		opNode.Spec.OpNode.ExtraArgs = []string{" "}
+		opNode.Spec.ExtraVolumes = []corev1.Volume{{Name: "scratch"}, {Name: "scratch"}}
+		opNode.Spec.Sidecars = []corev1.Container{{Name: "exporter"}, {Name: "exporter"}}
+		Expect(resources.ValidateOpNodeOverrides(field.NewPath("spec"), &opNode.Spec).ToAggregate().Error()).To(SatisfyAll(
+			ContainSubstring("spec.opNode.extraArgs[0]"),
+			ContainSubstring(`spec.extraVolumes[1].name: Duplicate value: "scratch"`),
+			ContainSubstring(`spec.sidecars[1].name: Duplicate value: "exporter"`),
+		))
+	})
+})
@@ -0,0 +1,208 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/utils"
+)
+
+const (
+	// gibibyte is the granularity of automatic volume expansions
+	gibibyte = 1 << 30
+
+	// storageRequeueInterval is how often volumes are checked while an expansion is in progress
+	storageRequeueInterval = time.Minute
+)
+
+// reconcileStorage expands the op-geth data volumes to the requested size, which volume
+// claim templates cannot do for existing PVCs, and with auto-grow expands volumes whose
+// usage passed the threshold. Volumes are never shrunk.
+func (r *OpNodeReconciler) reconcileStorage(ctx context.Context, opNode *optimismv1alpha1.OpNode) error {
+	logger := log.FromContext(ctx)
+
+	desired := resources.OpGethStorageSize(opNode)
+	var autoGrow *optimismv1alpha1.StorageAutoGrowConfig
+	if storage := opNode.Spec.OpGeth.Storage; storage != nil && storage.AutoGrow != nil && storage.AutoGrow.Enabled {
+		autoGrow = storage.AutoGrow
+	}
+
+	var volumes []optimismv1alpha1.VolumeStatus
+	var resizing, notExpandable, maxedOut []string
+	nodeUsage := map[string]map[types.NamespacedName]VolumeUsage{}
+	for ordinal := int32(0); ordinal < resources.OpNodeReplicas(opNode); ordinal++ {
+		var claim corev1.PersistentVolumeClaim
+		claimKey := client.ObjectKey{Name: resources.OpGethDataClaimName(opNode, ordinal), Namespace: opNode.Namespace}
+		if err := r.Get(ctx, claimKey, &claim); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		volume := optimismv1alpha1.VolumeStatus{
+			ClaimName: claim.Name,
+			Requested: claim.Spec.Resources.Requests[corev1.ResourceStorage],
+			Capacity:  claim.Status.Capacity[corev1.ResourceStorage],
+		}
+		inProgress := volume.Capacity.Cmp(volume.Requested) < 0
+
+		target := volume.Requested
+		if desired.Cmp(target) > 0 {
+			target = desired
+		}
+		if autoGrow != nil && r.VolumeStats != nil {
+			usage, ok, err := r.claimUsage(ctx, opNode, ordinal, claimKey, nodeUsage)
+			if err != nil {
+				logger.V(1).Info("unable to read volume usage", "claim", claim.Name, "error", err.Error())
+			} else if ok && usage.CapacityBytes > 0 {
+				volume.UsedPercent = int32(usage.UsedBytes * 100 / usage.CapacityBytes)
+				if !inProgress && volume.UsedPercent >= autoGrowThreshold(autoGrow) {
+					grown, capped := grownSize(volume.Requested, autoGrow)
+					if grown.Cmp(target) > 0 {
+						target = grown
+					}
+					if capped && grown.Cmp(volume.Requested) <= 0 {
+						maxedOut = append(maxedOut, claim.Name)
+					}
+				}
+			}
+		}
+
+		if target.Cmp(volume.Requested) > 0 {
+			expandable, err := r.storageClassAllowsExpansion(ctx, &claim)
+			if err != nil {
+				return err
+			}
+			if !expandable {
+				notExpandable = append(notExpandable, claim.Name)
+			} else {
+				claim.Spec.Resources.Requests[corev1.ResourceStorage] = target
+				if err := r.Update(ctx, &claim); err != nil {
+					return fmt.Errorf("failed to expand %s: %w", claim.Name, err)
+				}
+				logger.Info("expanding op-geth data volume", "claim", claim.Name, "from", volume.Requested.String(), "to", target.String())
+				volume.Requested = target
+				inProgress = true
+			}
+		}
+		if inProgress {
+			resizing = append(resizing, claim.Name)
+		}
+		volumes = append(volumes, volume)
+	}
+	opNode.Status.Volumes = volumes
+
+	switch {
+	case len(notExpandable) > 0:
+		utils.SetCondition(&opNode.Status.Conditions, "StorageSized", metav1.ConditionFalse, "ExpansionNotSupported",
+			fmt.Sprintf("The StorageClass does not allow volume expansion for %s", strings.Join(notExpandable, ", ")))
+	case len(maxedOut) > 0:
+		utils.SetCondition(&opNode.Status.Conditions, "StorageSized", metav1.ConditionFalse, "MaxSizeReached",
+			fmt.Sprintf("%s passed the auto-grow threshold at the maximum size %s", strings.Join(maxedOut, ", "), autoGrow.MaxSize.String()))
+	case len(resizing) > 0:
+		utils.SetCondition(&opNode.Status.Conditions, "StorageSized", metav1.ConditionFalse, "Resizing",
+			fmt.Sprintf("Expanding %s", strings.Join(resizing, ", ")))
+	default:
+		utils.SetCondition(&opNode.Status.Conditions, "StorageSized", metav1.ConditionTrue, "Sized",
+			"Data volumes have their requested size")
+	}
+	return nil
+}
+
+// claimUsage reads the usage of a data volume from the node running its pod. Node
+// summaries are cached in nodeUsage for the duration of a reconcile.
+func (r *OpNodeReconciler) claimUsage(ctx context.Context, opNode *optimismv1alpha1.OpNode, ordinal int32, claimKey types.NamespacedName, nodeUsage map[string]map[types.NamespacedName]VolumeUsage) (VolumeUsage, bool, error) {
+	var pod corev1.Pod
+	podKey := client.ObjectKey{Name: fmt.Sprintf("%s-%d", opNode.Name, ordinal), Namespace: opNode.Namespace}
+	if err := r.Get(ctx, podKey, &pod); err != nil {
+		return VolumeUsage{}, false, client.IgnoreNotFound(err)
+	}
+	if pod.Spec.NodeName == "" {
+		return VolumeUsage{}, false, nil
+	}
+
+	usage, cached := nodeUsage[pod.Spec.NodeName]
+	if !cached {
+		var err error
+		if usage, err = r.VolumeStats.VolumeUsage(ctx, pod.Spec.NodeName); err != nil {
+			return VolumeUsage{}, false, err
+		}
+		nodeUsage[pod.Spec.NodeName] = usage
+	}
+	claimUsage, ok := usage[claimKey]
+	return claimUsage, ok, nil
+}
+
+// storageClassAllowsExpansion reports whether the StorageClass of a claim allows volume expansion
+func (r *OpNodeReconciler) storageClassAllowsExpansion(ctx context.Context, claim *corev1.PersistentVolumeClaim) (bool, error) {
+	if claim.Spec.StorageClassName == nil || *claim.Spec.StorageClassName == "" {
+		return false, nil
+	}
+	var storageClass storagev1.StorageClass
+	if err := r.Get(ctx, client.ObjectKey{Name: *claim.Spec.StorageClassName}, &storageClass); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	return storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion, nil
+}
+
+// grownSize returns the size an auto-grow expansion of current leads to, rounded up to
+// whole GiB and capped at the maximum size, and whether the cap applied
+func grownSize(current resource.Quantity, autoGrow *optimismv1alpha1.StorageAutoGrowConfig) (resource.Quantity, bool) {
+	increase := autoGrow.IncreasePercent
+	if increase <= 0 {
+		increase = resources.DefaultAutoGrowIncreasePercent
+	}
+	bytes := current.Value()
+	grown := bytes + bytes*int64(increase)/100
+	grown = (grown + gibibyte - 1) / gibibyte * gibibyte
+
+	if !autoGrow.MaxSize.IsZero() && grown >= autoGrow.MaxSize.Value() {
+		return autoGrow.MaxSize, true
+	}
+	return *resource.NewQuantity(grown, resource.BinarySI), false
+}
+
+// autoGrowThreshold returns the volume usage, in percent, that triggers an expansion
+func autoGrowThreshold(autoGrow *optimismv1alpha1.StorageAutoGrowConfig) int32 {
+	if autoGrow.ThresholdPercent > 0 {
+		return autoGrow.ThresholdPercent
+	}
+	return resources.DefaultAutoGrowThresholdPercent
+}
+
+// storageResizing reports whether an expansion of a data volume is in progress
+func storageResizing(opNode *optimismv1alpha1.OpNode) bool {
+	condition := meta.FindStatusCondition(opNode.Status.Conditions, "StorageSized")
+	return condition != nil && condition.Reason == "Resizing"
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/utils"
+)
+
+// Upgrade phase constants for OpNode status
+const (
+	UpgradePhaseWaiting     = "Waiting"
+	UpgradePhaseProgressing = "Progressing"
+	UpgradePhaseSucceeded   = "Succeeded"
+	UpgradePhaseFailed      = "Failed"
+	UpgradePhaseRolledBack  = "RolledBack"
+)
+
+// upgradeRequeueInterval is how often health gates are re-evaluated while an upgrade is pending
+const upgradeRequeueInterval = 30 * time.Second
+
+// planUpgrade decides which images the StatefulSet runs. Image changes are held while
+// the strategy is paused or, for sequencers, while replicas of the same network are
+// still upgrading; a failed upgrade keeps its target, or the previous images when
+// rolled back, until the spec moves on to other images.
+func (r *OpNodeReconciler) planUpgrade(ctx context.Context, opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.OptimismNetwork, current *appsv1.StatefulSet, desired optimismv1alpha1.NodeImages) (optimismv1alpha1.NodeImages, error) {
+	if opNode.Status.Upgrade == nil {
+		opNode.Status.Upgrade = &optimismv1alpha1.UpgradeStatus{}
+	}
+	upgrade := opNode.Status.Upgrade
+
+	// A new StatefulSet has nothing to upgrade from
+	if current == nil {
+		*upgrade = optimismv1alpha1.UpgradeStatus{CurrentImages: &desired}
+		setUpgradedCondition(opNode, metav1.ConditionTrue, "UpToDate", "Running the desired images")
+		return desired, nil
+	}
+
+	running := containerImages(&current.Spec.Template.Spec)
+	if upgrade.CurrentImages == nil {
+		upgrade.CurrentImages = &running
+	}
+
+	if upgrade.TargetImages != nil && *upgrade.TargetImages == desired {
+		switch upgrade.Phase {
+		case UpgradePhaseProgressing:
+			return desired, nil
+		case UpgradePhaseFailed:
+			setUpgradedCondition(opNode, metav1.ConditionFalse, "HealthCheckFailed",
+				fmt.Sprintf("%s did not pass the health gate; change the images to retry", describeImages(desired)))
+			return desired, nil
+		case UpgradePhaseRolledBack:
+			setUpgradedCondition(opNode, metav1.ConditionFalse, "RolledBack",
+				fmt.Sprintf("%s did not pass the health gate; rolled back to %s", describeImages(desired), describeImages(*upgrade.CurrentImages)))
+			return *upgrade.CurrentImages, nil
+		}
+	}
+
+	if desired == running {
+		if upgrade.Phase != UpgradePhaseSucceeded {
+			*upgrade = optimismv1alpha1.UpgradeStatus{CurrentImages: upgrade.CurrentImages}
+		}
+		setUpgradedCondition(opNode, metav1.ConditionTrue, "UpToDate", "Running the desired images")
+		return running, nil
+	}
+
+	upgrade.TargetImages = &desired
+	upgrade.StartedAt = nil
+	if strategy := opNode.Spec.UpgradeStrategy; strategy != nil && strategy.Paused {
+		upgrade.Phase = UpgradePhaseWaiting
+		setUpgradedCondition(opNode, metav1.ConditionFalse, "Paused",
+			fmt.Sprintf("Upgrade to %s is paused by spec.upgradeStrategy.paused", describeImages(desired)))
+		return running, nil
+	}
+
+	// Replicas absorb a bad release before it reaches the sequencer
+	if opNode.Spec.NodeType == "sequencer" {
+		pending, err := r.replicasPendingUpgrade(ctx, opNode, network)
+		if err != nil {
+			return running, err
+		}
+		if len(pending) > 0 {
+			upgrade.Phase = UpgradePhaseWaiting
+			setUpgradedCondition(opNode, metav1.ConditionFalse, "WaitingForReplicas",
+				fmt.Sprintf("Upgrade to %s waits for replicas to finish upgrading: %s", describeImages(desired), strings.Join(pending, ", ")))
+			return running, nil
+		}
+	}
+
+	log.FromContext(ctx).Info("Starting image upgrade", "from", describeImages(running), "to", describeImages(desired))
+	now := metav1.Now()
+	upgrade.Phase = UpgradePhaseProgressing
+	upgrade.StartedAt = &now
+	setUpgradedCondition(opNode, metav1.ConditionFalse, "RollingOut", fmt.Sprintf("Rolling out %s", describeImages(desired)))
+	return desired, nil
+}
+
+// replicasPendingUpgrade lists the replicas of the network that do not yet run their
+// desired images successfully
+func (r *OpNodeReconciler) replicasPendingUpgrade(ctx context.Context, opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.OptimismNetwork) ([]string, error) {
+	var opNodes optimismv1alpha1.OpNodeList
+	if err := r.List(ctx, &opNodes, client.InNamespace(opNode.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var pending []string
+	for i := range opNodes.Items {
+		replica := &opNodes.Items[i]
+		if replica.Spec.NodeType != "replica" || replica.Spec.OptimismNetworkRef.Name != opNode.Spec.OptimismNetworkRef.Name {
+			continue
+		}
+
+		images, err := resources.ResolveImages(network, replica)
+		if err != nil {
+			pending = append(pending, replica.Name)
+			continue
+		}
+		want := optimismv1alpha1.NodeImages{OpGeth: images.OpGeth, OpNode: images.OpNode}
+		if resources.ExternalEngine(replica) {
+			want.OpGeth = ""
+		}
+
+		upgrade := replica.Status.Upgrade
+		if upgrade == nil || upgrade.CurrentImages == nil || *upgrade.CurrentImages != want ||
+			(upgrade.Phase != "" && upgrade.Phase != UpgradePhaseSucceeded) {
+			pending = append(pending, replica.Name)
+		}
+	}
+	return pending, nil
+}
+
+// checkUpgradeHealth completes a progressing upgrade once the node passes its health
+// gate, and fails it, or rolls it back, when the gate is not passed in time
+func (r *OpNodeReconciler) checkUpgradeHealth(ctx context.Context, opNode *optimismv1alpha1.OpNode) error {
+	upgrade := opNode.Status.Upgrade
+	if upgrade == nil || upgrade.Phase != UpgradePhaseProgressing || upgrade.TargetImages == nil {
+		return nil
+	}
+
+	var statefulSet appsv1.StatefulSet
+	if err := r.Get(ctx, client.ObjectKeyFromObject(opNode), &statefulSet); err != nil {
+		return err
+	}
+
+	target := *upgrade.TargetImages
+	waitingFor := upgradeHealthGate(opNode, &statefulSet)
+	if waitingFor == "" {
+		upgrade.Phase = UpgradePhaseSucceeded
+		upgrade.CurrentImages = &target
+		setUpgradedCondition(opNode, metav1.ConditionTrue, "UpgradeSucceeded", fmt.Sprintf("Upgraded to %s", describeImages(target)))
+		return nil
+	}
+
+	timeout := upgradeHealthTimeout(opNode)
+	if upgrade.StartedAt == nil || time.Since(upgrade.StartedAt.Time) < timeout {
+		setUpgradedCondition(opNode, metav1.ConditionFalse, "RollingOut",
+			fmt.Sprintf("Rolling out %s: waiting for %s", describeImages(target), waitingFor))
+		return nil
+	}
+
+	log.FromContext(ctx).Info("Upgrade failed its health gate", "images", describeImages(target), "waitingFor", waitingFor)
+	if strategy := opNode.Spec.UpgradeStrategy; strategy != nil && strategy.AutoRollback {
+		upgrade.Phase = UpgradePhaseRolledBack
+		setUpgradedCondition(opNode, metav1.ConditionFalse, "RolledBack",
+			fmt.Sprintf("%s still waiting for %s after %s; rolling back to %s", describeImages(target), waitingFor, timeout, describeImages(*upgrade.CurrentImages)))
+		return nil
+	}
+	upgrade.Phase = UpgradePhaseFailed
+	setUpgradedCondition(opNode, metav1.ConditionFalse, "HealthCheckFailed",
+		fmt.Sprintf("%s still waiting for %s after %s; change the images to retry", describeImages(target), waitingFor, timeout))
+	return nil
+}
+
+// upgradeHealthGate returns what an upgraded node is still waiting for, or "" once the
+// new pods are ready and the node reports synced with an unsafe head newer than the upgrade
+func upgradeHealthGate(opNode *optimismv1alpha1.OpNode, statefulSet *appsv1.StatefulSet) string {
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+	if statefulSet.Status.ObservedGeneration < statefulSet.Generation ||
+		statefulSet.Status.UpdatedReplicas < replicas || statefulSet.Status.ReadyReplicas < replicas {
+		return "upgraded pods to become ready"
+	}
+
+	// Without RPC the sync status cannot be polled, so pod readiness is the only gate
+	if opNode.Spec.OpNode.RPC == nil || !opNode.Spec.OpNode.RPC.Enabled {
+		return ""
+	}
+
+	info := opNode.Status.NodeInfo
+	if info == nil || info.SyncStatus == nil || info.ChainHead == nil {
+		return "sync status"
+	}
+	if info.SyncStatus.Syncing {
+		return "the node to finish syncing"
+	}
+	if startedAt := opNode.Status.Upgrade.StartedAt; startedAt != nil && info.ChainHead.Timestamp.Before(startedAt) {
+		return "a new unsafe head"
+	}
+	return ""
+}
+
+// upgradeHealthTimeout returns how long an upgrade may take to pass its health gate
+func upgradeHealthTimeout(opNode *optimismv1alpha1.OpNode) time.Duration {
+	value := resources.DefaultUpgradeHealthTimeout
+	if strategy := opNode.Spec.UpgradeStrategy; strategy != nil && strategy.HealthTimeout != "" {
+		value = strategy.HealthTimeout
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		timeout, _ = time.ParseDuration(resources.DefaultUpgradeHealthTimeout)
+	}
+	return timeout
+}
+
+// upgradeInProgress reports whether health gates must be re-evaluated soon
+func upgradeInProgress(opNode *optimismv1alpha1.OpNode) bool {
+	upgrade := opNode.Status.Upgrade
+	return upgrade != nil && (upgrade.Phase == UpgradePhaseWaiting || upgrade.Phase == UpgradePhaseProgressing)
+}
+
+// containerImages reads the op-geth and op-node images from a pod spec
+func containerImages(podSpec *corev1.PodSpec) optimismv1alpha1.NodeImages {
+	var images optimismv1alpha1.NodeImages
+	for _, container := range podSpec.Containers {
+		switch container.Name {
+		case "op-geth":
+			images.OpGeth = container.Image
+		case "op-node":
+			images.OpNode = container.Image
+		}
+	}
+	return images
+}
+
+// setContainerImages sets the op-geth and op-node images of a pod spec, including
+// the geth init container, which must not roll the pods ahead of op-geth
+func setContainerImages(podSpec *corev1.PodSpec, images optimismv1alpha1.NodeImages) {
+	for i := range podSpec.Containers {
+		switch podSpec.Containers[i].Name {
+		case "op-geth":
+			podSpec.Containers[i].Image = images.OpGeth
+		case "op-node":
+			podSpec.Containers[i].Image = images.OpNode
+		}
+	}
+	for i := range podSpec.InitContainers {
+		if podSpec.InitContainers[i].Name == resources.GethInitContainerName {
+			podSpec.InitContainers[i].Image = images.OpGeth
+		}
+	}
+}
+
+// describeImages formats images for conditions and logs
+func describeImages(images optimismv1alpha1.NodeImages) string {
+	return fmt.Sprintf("op-node %s and op-geth %s", images.OpNode, images.OpGeth)
+}
+
+// setUpgradedCondition records upgrade progress in the Upgraded condition
+func setUpgradedCondition(opNode *optimismv1alpha1.OpNode, status metav1.ConditionStatus, reason, message string) {
+	utils.SetCondition(&opNode.Status.Conditions, "Upgraded", status, reason, message)
+}
@@ -18,15 +18,59 @@ package controller
 
 import (
 	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/utils"
 )
 
+// OpProposerFinalizer is the finalizer for OpProposer resources
+const OpProposerFinalizer = "opproposer.optimism.io/finalizer"
+
+// Phase constants for OpProposer status
+const (
+	OpProposerPhasePending = "Pending"
+	OpProposerPhaseRunning = "Running"
+	OpProposerPhaseError   = "Error"
+	OpProposerPhaseStopped = "Stopped"
+)
+
+// maxProposerScanGames bounds the number of dispute games inspected per reconcile
+const maxProposerScanGames = 32
+
+// outputContractsABI covers the read-only methods of the DisputeGameFactory,
+// FaultDisputeGame and L2OutputOracle used to track proposals
+const outputContractsABI = `[
+	{"type":"function","name":"gameCount","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"gameAtIndex","stateMutability":"view","inputs":[{"name":"_index","type":"uint256"}],"outputs":[{"name":"gameType_","type":"uint32"},{"name":"timestamp_","type":"uint64"},{"name":"proxy_","type":"address"}]},
+	{"type":"function","name":"gameCreator","stateMutability":"pure","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"rootClaim","stateMutability":"pure","inputs":[],"outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"function","name":"l2BlockNumber","stateMutability":"pure","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"latestOutputIndex","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"getL2Output","stateMutability":"view","inputs":[{"name":"_l2OutputIndex","type":"uint256"}],"outputs":[{"name":"","type":"tuple","components":[{"name":"outputRoot","type":"bytes32"},{"name":"timestamp","type":"uint128"},{"name":"l2BlockNumber","type":"uint128"}]}]}
+]`
+
 // OpProposerReconciler reconciles a OpProposer object
 type OpProposerReconciler struct {
 	client.Client
@@ -36,28 +80,485 @@ type OpProposerReconciler struct {
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=opproposers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=opproposers/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=opproposers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=optimism.optimism.io,resources=opnodes;optimismnetworks,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the OpProposer object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.20.0/pkg/reconcile
 func (r *OpProposerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = log.FromContext(ctx)
+	logger := log.FromContext(ctx)
+
+	// Fetch the OpProposer instance
+	var opProposer optimismv1alpha1.OpProposer
+	if err := r.Get(ctx, req.NamespacedName, &opProposer); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "unable to fetch OpProposer")
+		return ctrl.Result{}, err
+	}
+
+	// Handle deletion
+	if opProposer.DeletionTimestamp != nil {
+		return r.handleDeletion(ctx, &opProposer)
+	}
+
+	// Add finalizer if not present
+	if !controllerutil.ContainsFinalizer(&opProposer, OpProposerFinalizer) {
+		controllerutil.AddFinalizer(&opProposer, OpProposerFinalizer)
+		if err := r.Update(ctx, &opProposer); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Validate configuration
+	if err := r.validateConfiguration(&opProposer); err != nil {
+		utils.SetCondition(&opProposer.Status.Conditions, "ConfigurationValid", metav1.ConditionFalse, "InvalidConfiguration", err.Error())
+		opProposer.Status.Phase = OpProposerPhaseError
+		opProposer.Status.ObservedGeneration = opProposer.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opProposer); statusErr != nil {
+			logger.Error(statusErr, "failed to update status after validation error")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
+	}
+
+	utils.SetCondition(&opProposer.Status.Conditions, "ConfigurationValid", metav1.ConditionTrue, "ValidConfiguration", "OpProposer configuration is valid")
+
+	// Fetch referenced OptimismNetwork
+	network, err := r.fetchOptimismNetwork(ctx, &opProposer)
+	if err != nil {
+		utils.SetCondition(&opProposer.Status.Conditions, "NetworkReference", metav1.ConditionFalse, "NetworkNotFound", fmt.Sprintf("Failed to fetch OptimismNetwork: %v", err))
+		opProposer.Status.Phase = OpProposerPhaseError
+		opProposer.Status.ObservedGeneration = opProposer.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opProposer); statusErr != nil {
+			logger.Error(statusErr, "failed to update status after network fetch error")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute * 2}, nil
+	}
+
+	utils.SetCondition(&opProposer.Status.Conditions, "NetworkReference", metav1.ConditionTrue, "NetworkFound", "OptimismNetwork reference resolved successfully")
+
+	if network.Status.Phase != PhaseReady {
+		utils.SetCondition(&opProposer.Status.Conditions, "NetworkReady", metav1.ConditionFalse, "NetworkNotReady", "OptimismNetwork is not ready")
+		opProposer.Status.Phase = OpProposerPhasePending
+		opProposer.Status.ObservedGeneration = opProposer.Generation
+		if err := r.updateStatusWithRetry(ctx, &opProposer); err != nil {
+			logger.Error(err, "failed to update status for network pending")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}
+	utils.SetCondition(&opProposer.Status.Conditions, "NetworkReady", metav1.ConditionTrue, "NetworkReady", "OptimismNetwork is ready")
+
+	// The output contract comes from the network's discovered addresses
+	contractAddr, contractType := resources.ResolveProposerOutputContract(network)
+	if contractAddr == "" {
+		utils.SetCondition(&opProposer.Status.Conditions, "OutputContractResolved", metav1.ConditionFalse, "OutputContractMissing",
+			"OptimismNetwork has neither a DisputeGameFactory nor an L2OutputOracle address")
+		opProposer.Status.Phase = OpProposerPhasePending
+		opProposer.Status.ObservedGeneration = opProposer.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opProposer); statusErr != nil {
+			logger.Error(statusErr, "failed to update status for missing output contract")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute * 2}, nil
+	}
+	utils.SetCondition(&opProposer.Status.Conditions, "OutputContractResolved", metav1.ConditionTrue, "OutputContractFound",
+		fmt.Sprintf("Proposing to %s at %s", contractType, contractAddr))
+
+	// Resolve the OpNode serving the rollup RPC
+	rollupNode, err := r.fetchRollupNode(ctx, &opProposer)
+	if err != nil {
+		utils.SetCondition(&opProposer.Status.Conditions, "RollupRPCReference", metav1.ConditionFalse, "OpNodeNotFound", fmt.Sprintf("Failed to resolve rollup RPC OpNode: %v", err))
+		opProposer.Status.Phase = OpProposerPhasePending
+		opProposer.Status.ObservedGeneration = opProposer.Generation
+		if statusErr := r.updateStatusWithRetry(ctx, &opProposer); statusErr != nil {
+			logger.Error(statusErr, "failed to update status after rollup node fetch error")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}
+	utils.SetCondition(&opProposer.Status.Conditions, "RollupRPCReference", metav1.ConditionTrue, "OpNodeFound", "Rollup RPC OpNode reference resolved successfully")
+
+	// 1) Reconcile Deployment
+	if err := r.reconcileDeployment(ctx, &opProposer, network, rollupNode); err != nil {
+		utils.SetCondition(&opProposer.Status.Conditions, "DeploymentReady", metav1.ConditionFalse, "DeploymentReconciliationFailed", fmt.Sprintf("Failed to reconcile Deployment: %v", err))
+		opProposer.Status.Phase = OpProposerPhaseError
+		goto updateStatus
+	}
+	utils.SetCondition(&opProposer.Status.Conditions, "DeploymentReady", metav1.ConditionTrue, "DeploymentReconciled", "Deployment is ready")
+
+	// 2) All done
+	r.updateProposerStatus(ctx, &opProposer, network, contractAddr, contractType)
+	opProposer.Status.Phase = OpProposerPhaseRunning
+
+updateStatus:
+	// Consolidated status update
+	opProposer.Status.ObservedGeneration = opProposer.Generation
+	if err := r.updateStatusWithRetry(ctx, &opProposer); err != nil {
+		logger.Error(err, "failed to update status")
+	}
+	// Decide requeue interval
+	var requeueAfter time.Duration
+	switch opProposer.Status.Phase {
+	case OpProposerPhaseError:
+		requeueAfter = time.Minute * 2
+	case OpProposerPhaseRunning:
+		requeueAfter = time.Minute * 5
+	default:
+		requeueAfter = time.Minute
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// validateConfiguration validates the OpProposer configuration
+func (r *OpProposerReconciler) validateConfiguration(opProposer *optimismv1alpha1.OpProposer) error {
+	if opProposer.Spec.OptimismNetworkRef.Name == "" {
+		return fmt.Errorf("optimismNetworkRef.name is required")
+	}
+
+	if opProposer.Spec.RollupRPCRef.Name == "" {
+		return fmt.Errorf("rollupRpcRef.name is required")
+	}
+
+	// The proposer key must hold funds (and bonds) on L1, so it cannot be generated by the operator
+	if opProposer.Spec.PrivateKey.SecretRef == nil {
+		return fmt.Errorf("privateKey.secretRef is required")
+	}
+	if opProposer.Spec.PrivateKey.Generate {
+		return fmt.Errorf("privateKey.generate is not supported for op-proposer; provide a funded key via secretRef")
+	}
+
+	if opProposer.Spec.GameType < 0 {
+		return fmt.Errorf("gameType must not be negative")
+	}
+
+	if opProposer.Spec.ProposalInterval != "" {
+		if _, err := time.ParseDuration(opProposer.Spec.ProposalInterval); err != nil {
+			return fmt.Errorf("proposalInterval is not a valid duration: %w", err)
+		}
+	}
+	if opProposer.Spec.PollInterval != "" {
+		if _, err := time.ParseDuration(opProposer.Spec.PollInterval); err != nil {
+			return fmt.Errorf("pollInterval is not a valid duration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// fetchOptimismNetwork fetches the referenced OptimismNetwork
+func (r *OpProposerReconciler) fetchOptimismNetwork(ctx context.Context, opProposer *optimismv1alpha1.OpProposer) (*optimismv1alpha1.OptimismNetwork, error) {
+	namespace := opProposer.Spec.OptimismNetworkRef.Namespace
+	if namespace == "" {
+		namespace = opProposer.Namespace
+	}
+
+	var network optimismv1alpha1.OptimismNetwork
+	key := k8stypes.NamespacedName{
+		Name:      opProposer.Spec.OptimismNetworkRef.Name,
+		Namespace: namespace,
+	}
+
+	if err := r.Get(ctx, key, &network); err != nil {
+		return nil, err
+	}
+
+	return &network, nil
+}
+
+// fetchRollupNode fetches the OpNode referenced for the rollup RPC
+func (r *OpProposerReconciler) fetchRollupNode(ctx context.Context, opProposer *optimismv1alpha1.OpProposer) (*optimismv1alpha1.OpNode, error) {
+	namespace := opProposer.Spec.RollupRPCRef.Namespace
+	if namespace == "" {
+		namespace = opProposer.Namespace
+	}
+
+	var opNode optimismv1alpha1.OpNode
+	key := k8stypes.NamespacedName{
+		Name:      opProposer.Spec.RollupRPCRef.Name,
+		Namespace: namespace,
+	}
+
+	if err := r.Get(ctx, key, &opNode); err != nil {
+		return nil, err
+	}
+
+	// op-proposer reads output roots from the op-node RPC, so it must be served
+	if opNode.Spec.OpNode.RPC == nil || !opNode.Spec.OpNode.RPC.Enabled {
+		return nil, fmt.Errorf("OpNode %s/%s does not have the op-node RPC enabled", namespace, opNode.Name)
+	}
+
+	return &opNode, nil
+}
+
+// reconcileDeployment manages the Deployment for OpProposer
+func (r *OpProposerReconciler) reconcileDeployment(
+	ctx context.Context,
+	opProposer *optimismv1alpha1.OpProposer,
+	network *optimismv1alpha1.OptimismNetwork,
+	rollupNode *optimismv1alpha1.OpNode,
+) error {
+	desiredDeployment := resources.CreateOpProposerDeployment(opProposer, network, rollupNode)
+
+	if err := ctrl.SetControllerReference(opProposer, desiredDeployment, r.Scheme); err != nil {
+		return err
+	}
+
+	var currentDeployment appsv1.Deployment
+	key := k8stypes.NamespacedName{Name: opProposer.Name, Namespace: opProposer.Namespace}
+
+	if err := r.Get(ctx, key, &currentDeployment); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
 
-	// TODO(user): your logic here
+		// Create new Deployment
+		return r.Create(ctx, desiredDeployment)
+	}
+
+	// Update existing Deployment if needed
+	currentDeployment.Spec = desiredDeployment.Spec
+	currentDeployment.Labels = desiredDeployment.Labels
+	currentDeployment.Annotations = desiredDeployment.Annotations
+
+	return r.Update(ctx, &currentDeployment)
+}
+
+// updateProposerStatus refreshes the operational proposer information.
+// Every step is best effort: a failure is logged and the previous value is kept.
+func (r *OpProposerReconciler) updateProposerStatus(
+	ctx context.Context,
+	opProposer *optimismv1alpha1.OpProposer,
+	network *optimismv1alpha1.OptimismNetwork,
+	contractAddr, contractType string,
+) {
+	logger := log.FromContext(ctx)
+
+	if opProposer.Status.ProposerInfo == nil {
+		opProposer.Status.ProposerInfo = &optimismv1alpha1.ProposerInfo{}
+	}
+	info := opProposer.Status.ProposerInfo
+	info.OutputContract = contractAddr
+	info.OutputContractType = contractType
+
+	proposer, err := resolveSignerAddress(ctx, r.Client, opProposer.Namespace, opProposer.Spec.PrivateKey.SecretRef)
+	if err != nil {
+		logger.Error(err, "failed to derive proposer address")
+		return
+	}
+	info.ProposerAddress = proposer.Hex()
+
+	proposal, err := fetchLatestProposal(ctx, r.Client, network, common.HexToAddress(contractAddr), contractType, proposer, uint32(opProposer.Spec.GameType))
+	if err != nil {
+		logger.Error(err, "failed to query latest output proposal")
+		return
+	}
+	if proposal != nil {
+		info.LastProposal = proposal
+	}
+}
+
+// fetchLatestProposal queries L1 for the most recent output root submitted by the proposer
+func fetchLatestProposal(
+	ctx context.Context,
+	c client.Reader,
+	network *optimismv1alpha1.OptimismNetwork,
+	contract common.Address,
+	contractType string,
+	proposer common.Address,
+	gameType uint32,
+) (_ *optimismv1alpha1.OutputProposalInfo, err error) {
+	timeout := 10 * time.Second
+	if network.Spec.L1RpcTimeout != 0 {
+		timeout = network.Spec.L1RpcTimeout
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	l1RpcURL, err := activeL1RpcURL(queryCtx, c, network)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = redactL1URL(err, optimismv1alpha1.ActiveL1RpcEndpoint(network), l1RpcURL) }()
+
+	l1Client, err := ethclient.DialContext(queryCtx, l1RpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to L1 RPC: %w", err)
+	}
+	defer l1Client.Close()
+
+	contractABI, err := abi.JSON(strings.NewReader(outputContractsABI))
+	if err != nil {
+		return nil, err
+	}
+
+	if contractType == resources.OutputContractL2OutputOracle {
+		return latestL2OutputOracleProposal(queryCtx, l1Client, contractABI, contract)
+	}
+	return latestDisputeGameProposal(queryCtx, l1Client, contractABI, contract, proposer, gameType)
+}
+
+// latestDisputeGameProposal walks the most recent dispute games for one created by the proposer
+func latestDisputeGameProposal(
+	ctx context.Context,
+	caller ethereum.ContractCaller,
+	contractABI abi.ABI,
+	factory, proposer common.Address,
+	gameType uint32,
+) (_ *optimismv1alpha1.OutputProposalInfo, err error) {
+	out, err := callContract(ctx, caller, contractABI, factory, "gameCount")
+	if err != nil {
+		return nil, err
+	}
+	count := out[0].(*big.Int).Int64()
+
+	for index := count - 1; index >= 0 && index >= count-maxProposerScanGames; index-- {
+		out, err := callContract(ctx, caller, contractABI, factory, "gameAtIndex", big.NewInt(index))
+		if err != nil {
+			return nil, err
+		}
+		if out[0].(uint32) != gameType {
+			continue
+		}
+		created := out[1].(uint64)
+		game := out[2].(common.Address)
+
+		out, err = callContract(ctx, caller, contractABI, game, "gameCreator")
+		if err != nil {
+			return nil, err
+		}
+		if out[0].(common.Address) != proposer {
+			continue
+		}
+
+		rootClaim, err := callContract(ctx, caller, contractABI, game, "rootClaim")
+		if err != nil {
+			return nil, err
+		}
+		l2Block, err := callContract(ctx, caller, contractABI, game, "l2BlockNumber")
+		if err != nil {
+			return nil, err
+		}
+
+		return &optimismv1alpha1.OutputProposalInfo{
+			OutputRoot:    common.Hash(rootClaim[0].([32]byte)).Hex(),
+			L2BlockNumber: l2Block[0].(*big.Int).Int64(),
+			GameAddress:   game.Hex(),
+			Timestamp:     metav1.NewTime(time.Unix(int64(created), 0)),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// l2OutputProposal mirrors the Types.OutputProposal struct returned by the L2OutputOracle
+type l2OutputProposal struct {
+	OutputRoot    [32]byte
+	Timestamp     *big.Int
+	L2BlockNumber *big.Int
+}
+
+// latestL2OutputOracleProposal reads the latest output stored in the L2OutputOracle
+func latestL2OutputOracleProposal(
+	ctx context.Context,
+	caller ethereum.ContractCaller,
+	contractABI abi.ABI,
+	oracle common.Address,
+) (*optimismv1alpha1.OutputProposalInfo, error) {
+	out, err := callContract(ctx, caller, contractABI, oracle, "latestOutputIndex")
+	if err != nil {
+		// latestOutputIndex reverts until the first output is proposed
+		return nil, nil
+	}
+
+	out, err = callContract(ctx, caller, contractABI, oracle, "getL2Output", out[0].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+
+	output := *abi.ConvertType(out[0], new(l2OutputProposal)).(*l2OutputProposal)
+
+	return &optimismv1alpha1.OutputProposalInfo{
+		OutputRoot:    common.Hash(output.OutputRoot).Hex(),
+		L2BlockNumber: output.L2BlockNumber.Int64(),
+		Timestamp:     metav1.NewTime(time.Unix(output.Timestamp.Int64(), 0)),
+	}, nil
+}
+
+// callContract performs an eth_call against a contract method and unpacks the result
+func callContract(
+	ctx context.Context,
+	caller ethereum.ContractCaller,
+	contractABI abi.ABI,
+	contract common.Address,
+	method string,
+	args ...interface{},
+) ([]interface{}, error) {
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s call: %w", method, err)
+	}
+
+	result, err := caller.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s call to %s failed: %w", method, contract.Hex(), err)
+	}
+
+	out, err := contractABI.Unpack(method, result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s result: %w", method, err)
+	}
+
+	return out, nil
+}
+
+// handleDeletion handles the deletion of OpProposer resources
+func (r *OpProposerReconciler) handleDeletion(ctx context.Context, opProposer *optimismv1alpha1.OpProposer) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// The owned Deployment is removed through owner references
+	logger.Info("Cleaning up OpProposer resources", "name", opProposer.Name)
+
+	// Remove finalizer
+	controllerutil.RemoveFinalizer(opProposer, OpProposerFinalizer)
+	if err := r.Update(ctx, opProposer); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	return ctrl.Result{}, nil
 }
 
+// updateStatusWithRetry updates the OpProposer status with retry logic to handle conflicts
+func (r *OpProposerReconciler) updateStatusWithRetry(ctx context.Context, opProposer *optimismv1alpha1.OpProposer) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		// Get the latest version of the resource
+		latest := &optimismv1alpha1.OpProposer{}
+		if err := r.Get(ctx, k8stypes.NamespacedName{Name: opProposer.Name, Namespace: opProposer.Namespace}, latest); err != nil {
+			return err
+		}
+
+		// Copy status fields from opProposer to latest to avoid race conditions
+		latest.Status.Phase = opProposer.Status.Phase
+		latest.Status.ObservedGeneration = opProposer.Status.ObservedGeneration
+		latest.Status.Conditions = opProposer.Status.Conditions
+		if opProposer.Status.ProposerInfo != nil {
+			latest.Status.ProposerInfo = opProposer.Status.ProposerInfo.DeepCopy()
+		}
+
+		return r.Status().Update(ctx, latest)
+	})
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *OpProposerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&optimismv1alpha1.OpProposer{}).
+		Owns(&appsv1.Deployment{}).
+		Watches(&optimismv1alpha1.OptimismNetwork{}, handler.EnqueueRequestsFromMapFunc(networkDependents(mgr.GetClient(), &optimismv1alpha1.OpProposerList{},
+			func(obj client.Object) optimismv1alpha1.OptimismNetworkRef {
+				return obj.(*optimismv1alpha1.OpProposer).Spec.OptimismNetworkRef
+			})), builder.WithPredicates(l1EndpointSwitched)).
 		Named("opproposer").
 		Complete(r)
 }
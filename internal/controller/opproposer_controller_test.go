@@ -21,6 +21,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -28,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
 )
 
 var _ = Describe("OpProposer Controller", func() {
@@ -38,7 +40,7 @@ var _ = Describe("OpProposer Controller", func() {
 
 		typeNamespacedName := types.NamespacedName{
 			Name:      resourceName,
-			Namespace: "default", // TODO(user):Modify as needed
+			Namespace: "default",
 		}
 		opproposer := &optimismv1alpha1.OpProposer{}
 
@@ -51,14 +53,26 @@ var _ = Describe("OpProposer Controller", func() {
 						Name:      resourceName,
 						Namespace: "default",
 					},
-					// TODO(user): Specify other spec details if needed.
+					Spec: optimismv1alpha1.OpProposerSpec{
+						OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{
+							Name: "test-network",
+						},
+						RollupRPCRef: optimismv1alpha1.OpNodeReference{
+							Name: "test-node",
+						},
+						PrivateKey: optimismv1alpha1.SecretKeyRef{
+							SecretRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "proposer-key"},
+								Key:                  "private-key",
+							},
+						},
+					},
 				}
 				Expect(k8sClient.Create(ctx, resource)).To(Succeed())
 			}
 		})
 
 		AfterEach(func() {
-			// TODO(user): Cleanup logic after each test, like removing the resource instance.
 			resource := &optimismv1alpha1.OpProposer{}
 			err := k8sClient.Get(ctx, typeNamespacedName, resource)
 			Expect(err).NotTo(HaveOccurred())
@@ -77,8 +91,111 @@ var _ = Describe("OpProposer Controller", func() {
 				NamespacedName: typeNamespacedName,
 			})
 			Expect(err).NotTo(HaveOccurred())
-			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
-			// Example: If you expect a certain status condition after reconciliation, verify it here.
+		})
+	})
+
+	Context("Configuration Validation", func() {
+		reconciler := &OpProposerReconciler{}
+
+		newProposer := func() *optimismv1alpha1.OpProposer {
+			return &optimismv1alpha1.OpProposer{
+				Spec: optimismv1alpha1.OpProposerSpec{
+					OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "test-network"},
+					RollupRPCRef:       optimismv1alpha1.OpNodeReference{Name: "test-node"},
+					PrivateKey: optimismv1alpha1.SecretKeyRef{
+						SecretRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "proposer-key"},
+							Key:                  "private-key",
+						},
+					},
+				},
+			}
+		}
+
+		It("Should accept a minimal valid configuration", func() {
+			Expect(reconciler.validateConfiguration(newProposer())).To(Succeed())
+		})
+
+		It("Should require a rollup RPC reference", func() {
+			proposer := newProposer()
+			proposer.Spec.RollupRPCRef.Name = ""
+			Expect(reconciler.validateConfiguration(proposer)).To(MatchError(ContainSubstring("rollupRpcRef.name")))
+		})
+
+		It("Should reject a generated private key", func() {
+			proposer := newProposer()
+			proposer.Spec.PrivateKey.Generate = true
+			Expect(reconciler.validateConfiguration(proposer)).NotTo(Succeed())
+		})
+
+		It("Should reject an invalid proposal interval", func() {
+			proposer := newProposer()
+			proposer.Spec.ProposalInterval = "hourly"
+			Expect(reconciler.validateConfiguration(proposer)).To(MatchError(ContainSubstring("proposalInterval")))
+		})
+	})
+
+	Context("Deployment Rendering", func() {
+		rollupNode := &optimismv1alpha1.OpNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-node", Namespace: "default"},
+		}
+		proposer := &optimismv1alpha1.OpProposer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-proposer", Namespace: "default"},
+			Spec: optimismv1alpha1.OpProposerSpec{
+				PrivateKey: optimismv1alpha1.SecretKeyRef{
+					SecretRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "proposer-key"},
+						Key:                  "private-key",
+					},
+				},
+				ProposalInterval: "30m",
+				GameType:         1,
+			},
+		}
+
+		newNetwork := func(contracts *optimismv1alpha1.NetworkContractAddresses) *optimismv1alpha1.OptimismNetwork {
+			return &optimismv1alpha1.OptimismNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-network", Namespace: "default"},
+				Spec: optimismv1alpha1.OptimismNetworkSpec{
+					NetworkName: "op-sepolia",
+					ChainID:     11155420,
+					L1ChainID:   11155111,
+					L1RpcUrl:    "https://sepolia.example.com",
+				},
+				Status: optimismv1alpha1.OptimismNetworkStatus{
+					NetworkInfo: &optimismv1alpha1.NetworkInfo{DiscoveredContracts: contracts},
+				},
+			}
+		}
+
+		It("Should prefer the DisputeGameFactory when discovered", func() {
+			network := newNetwork(&optimismv1alpha1.NetworkContractAddresses{
+				DisputeGameFactoryAddr: "0x05F9613aDB30026FFd634f38e5C4dFd30a197Fa1",
+				L2OutputOracleAddr:     "0x90E9c4f8a994a250F6aEfd61CAFb4F2e895D458F",
+			})
+
+			deployment := resources.CreateOpProposerDeployment(proposer, network, rollupNode)
+			container := deployment.Spec.Template.Spec.Containers[0]
+			Expect(container.Args).To(ContainElements(
+				"--l1-eth-rpc=https://sepolia.example.com",
+				"--rollup-rpc=http://test-node.default.svc.cluster.local:9545",
+				"--game-factory-address=0x05F9613aDB30026FFd634f38e5C4dFd30a197Fa1",
+				"--game-type=1",
+				"--proposal-interval=30m",
+			))
+			Expect(container.Args).NotTo(ContainElement(ContainSubstring("--l2oo-address")))
+			Expect(container.Env[0].Name).To(Equal("OP_PROPOSER_PRIVATE_KEY"))
+		})
+
+		It("Should fall back to the L2OutputOracle", func() {
+			network := newNetwork(&optimismv1alpha1.NetworkContractAddresses{
+				L2OutputOracleAddr: "0x90E9c4f8a994a250F6aEfd61CAFb4F2e895D458F",
+			})
+
+			deployment := resources.CreateOpProposerDeployment(proposer, network, rollupNode)
+			container := deployment.Spec.Template.Spec.Containers[0]
+			Expect(container.Args).To(ContainElement("--l2oo-address=0x90E9c4f8a994a250F6aEfd61CAFb4F2e895D458F"))
+			Expect(container.Args).NotTo(ContainElement(ContainSubstring("--game-factory-address")))
 		})
 	})
 })
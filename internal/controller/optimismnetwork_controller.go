@@ -18,7 +18,11 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"strings"
+	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -26,16 +30,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
 	"github.com/ethereum-optimism/op-stack-operator/pkg/discovery"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/rollup"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/superchain"
 	"github.com/ethereum-optimism/op-stack-operator/pkg/utils"
 )
 
@@ -53,6 +62,12 @@ type OptimismNetworkReconciler struct {
 	client.Client
 	Scheme           *runtime.Scheme
 	DiscoveryService *discovery.ContractDiscoveryService
+	// SuperchainRegistry resolves registered chains offline; the embedded
+	// snapshot is used when nil
+	SuperchainRegistry *superchain.Registry
+
+	// discoveryOnce guards the default DiscoveryService across concurrent reconciles
+	discoveryOnce sync.Once
 }
 
 // +kubebuilder:rbac:groups=optimism.optimism.io,resources=optimismnetworks,verbs=get;list;watch;create;update;patch;delete
@@ -101,6 +116,17 @@ func (r *OptimismNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	utils.SetCondition(&network.Status.Conditions, "ConfigurationValid", metav1.ConditionTrue, "ValidConfiguration", "Network configuration is valid")
 
+	// Resolve component images; every resource on the network deploys from them
+	if _, err := resources.ResolveImages(&network, nil); err != nil {
+		utils.SetCondition(&network.Status.Conditions, "ImagesValid", metav1.ConditionFalse, "InvalidImages", err.Error())
+		network.Status.Phase = PhaseError
+		if statusErr := r.updateStatusWithRetry(ctx, &network); statusErr != nil {
+			logger.Error(statusErr, "failed to update status")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute * 5}, nil
+	}
+	utils.SetCondition(&network.Status.Conditions, "ImagesValid", metav1.ConditionTrue, "ImagesResolved", "Component images are resolved and compatible")
+
 	// Test L1 connectivity
 	if err := r.testL1Connectivity(ctx, &network); err != nil {
 		utils.SetCondition(&network.Status.Conditions, "L1Connected", metav1.ConditionFalse, "L1ConnectionFailed", fmt.Sprintf("Failed to connect to L1: %v", err))
@@ -108,10 +134,10 @@ func (r *OptimismNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		if statusErr := r.updateStatusWithRetry(ctx, &network); statusErr != nil {
 			logger.Error(statusErr, "failed to update status")
 		}
-		return ctrl.Result{RequeueAfter: time.Minute * 2}, nil
+		return ctrl.Result{RequeueAfter: min(time.Minute*2, l1RequeueInterval(&network))}, nil
 	}
 
-	utils.SetCondition(&network.Status.Conditions, "L1Connected", metav1.ConditionTrue, "L1ConnectionSuccess", "Successfully connected to L1 RPC endpoint")
+	utils.SetCondition(&network.Status.Conditions, "L1Connected", metav1.ConditionTrue, "L1ConnectionSuccess", l1ConnectedMessage(&network))
 
 	// Discover contract addresses
 	addresses, err := r.discoverContractAddresses(ctx, &network)
@@ -136,6 +162,7 @@ func (r *OptimismNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	// Create ConfigMaps for rollup config and genesis
 	if err := r.reconcileConfigMaps(ctx, &network, addresses); err != nil {
 		logger.Error(err, "failed to reconcile ConfigMaps")
+		utils.SetCondition(&network.Status.Conditions, "ConfigMapsReady", metav1.ConditionFalse, "ConfigGenerationFailed", err.Error())
 		network.Status.Phase = PhaseError
 		if statusErr := r.updateStatusWithRetry(ctx, &network); statusErr != nil {
 			logger.Error(statusErr, "failed to update status")
@@ -143,6 +170,13 @@ func (r *OptimismNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{RequeueAfter: time.Minute * 2}, nil
 	}
 
+	utils.SetCondition(&network.Status.Conditions, "ConfigMapsReady", metav1.ConditionTrue, "ConfigMapsReconciled", "Network configuration ConfigMaps are up to date")
+
+	// Forced chain ID changes are now reflected in the generated configuration
+	if err := clearForceUpdate(ctx, r.Client, &network); err != nil {
+		logger.Error(err, "failed to remove force-update annotation")
+	}
+
 	// Update final status
 	network.Status.Phase = PhaseReady
 	network.Status.ObservedGeneration = network.Generation
@@ -152,7 +186,7 @@ func (r *OptimismNetworkReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	logger.Info("OptimismNetwork reconciled successfully", "name", network.Name, "phase", network.Status.Phase)
-	return ctrl.Result{RequeueAfter: time.Hour}, nil // Re-check contract addresses periodically
+	return ctrl.Result{RequeueAfter: l1RequeueInterval(&network)}, nil // Re-check L1 endpoints and contract addresses periodically
 }
 
 // validateConfiguration validates the OptimismNetwork configuration
@@ -164,8 +198,11 @@ func (r *OptimismNetworkReconciler) validateConfiguration(network *optimismv1alp
 	if network.Spec.L1ChainID == 0 {
 		return fmt.Errorf("l1ChainID is required")
 	}
-	if network.Spec.L1RpcUrl == "" {
-		return fmt.Errorf("l1RpcUrl is required")
+	if errs := resources.ValidateL1Endpoints(field.NewPath("spec"), &network.Spec); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if beacon := network.Spec.L1BeaconUrl; beacon != "" && !strings.HasPrefix(beacon, "http://") && !strings.HasPrefix(beacon, "https://") {
+		return fmt.Errorf("l1BeaconUrl must be a valid HTTP/HTTPS URL")
 	}
 
 	// Validate chain ID relationship
@@ -181,6 +218,12 @@ func (r *OptimismNetworkReconciler) validateConfiguration(network *optimismv1alp
 		return err
 	}
 
+	if params := network.Spec.ChainParameters; params != nil {
+		if params.ProtocolVersionsAddr != "" && !common.IsHexAddress(params.ProtocolVersionsAddr) {
+			return fmt.Errorf("chainParameters.protocolVersionsAddr is not a valid address")
+		}
+	}
+
 	return nil
 }
 
@@ -208,195 +251,340 @@ func (r *OptimismNetworkReconciler) validateConfigSource(source *optimismv1alpha
 	return nil
 }
 
-// testL1Connectivity tests connectivity to the L1 RPC endpoint
+// testL1Connectivity probes the L1 RPC endpoints, selects the one the components use and
+// records the probes in status. It fails only when no endpoint is healthy.
 func (r *OptimismNetworkReconciler) testL1Connectivity(ctx context.Context, network *optimismv1alpha1.OptimismNetwork) error {
-	// Create L1 client with timeout
-	timeout := 10 * time.Second
-	if network.Spec.L1RpcTimeout != 0 {
-		timeout = network.Spec.L1RpcTimeout
-	}
-
-	connectCtx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
-
-	client, err := ethclient.DialContext(connectCtx, network.Spec.L1RpcUrl)
-	if err != nil {
-		return fmt.Errorf("failed to connect to L1 RPC: %w", err)
-	}
-
-	// Ensure client is always closed, even on early returns
-	defer func() {
-		if client != nil {
-			client.Close()
+	endpoints := optimismv1alpha1.L1RpcEndpoints(network)
+	statuses := probeL1Endpoints(ctx, r.Client, network, endpoints)
+	network.Status.L1Endpoints = statuses
+
+	best := bestL1Endpoint(endpoints, statuses)
+	selectL1Endpoint(network, endpoints, best, time.Now())
+
+	// The probe of the active endpoint feeds the chain health metrics, reachable or not
+	for _, status := range statuses {
+		if status.Name == network.Status.ActiveL1Endpoint {
+			network.Status.L1 = &optimismv1alpha1.L1Status{
+				Reachable:           status.Healthy,
+				LatencyMilliseconds: status.LatencyMilliseconds,
+				HeadBlock:           status.HeadBlock,
+				LastChecked:         status.LastChecked,
+			}
 		}
-	}()
-
-	// Get chain ID to verify connection and configuration
-	// Use a shorter timeout for the actual RPC call
-	callCtx, callCancel := context.WithTimeout(ctx, timeout/2)
-	defer callCancel()
-
-	chainID, err := client.ChainID(callCtx)
-	if err != nil {
-		return fmt.Errorf("failed to get L1 chain ID: %w", err)
 	}
 
-	if chainID.Int64() != network.Spec.L1ChainID {
-		return fmt.Errorf("L1 chain ID mismatch: expected %d, got %d", network.Spec.L1ChainID, chainID.Int64())
+	if best == "" {
+		return l1EndpointsError(statuses)
 	}
-
 	return nil
 }
 
 // discoverContractAddresses discovers and caches contract addresses
 func (r *OptimismNetworkReconciler) discoverContractAddresses(ctx context.Context, network *optimismv1alpha1.OptimismNetwork) (*optimismv1alpha1.NetworkContractAddresses, error) {
-	if r.DiscoveryService == nil {
-		r.DiscoveryService = discovery.NewContractDiscoveryService(24 * time.Hour)
-	}
+	r.discoveryOnce.Do(func() {
+		if r.DiscoveryService == nil {
+			r.DiscoveryService = discovery.NewContractDiscoveryService(24 * time.Hour)
+			if r.SuperchainRegistry != nil {
+				r.DiscoveryService.SetRegistry(r.SuperchainRegistry)
+			}
+		}
+	})
 
-	addresses, err := r.DiscoveryService.DiscoverContracts(ctx, network)
+	l1RpcURL, err := activeL1RpcURL(ctx, r.Client, network)
 	if err != nil {
 		return nil, err
 	}
+	addresses, err := r.DiscoveryService.DiscoverContracts(ctx, network, l1RpcURL)
+	if err != nil {
+		return nil, redactL1URL(err, optimismv1alpha1.ActiveL1RpcEndpoint(network), l1RpcURL)
+	}
 
 	return addresses, nil
 }
 
-// reconcileConfigMaps manages ConfigMaps for rollup config and genesis data
+// reconcileConfigMaps manages ConfigMaps for rollup config and genesis data.
+// Inline and auto-discovered content is written to the managed ConfigMaps;
+// ConfigMapRef sources are validated in place and mounted directly by OpNodes.
 func (r *OptimismNetworkReconciler) reconcileConfigMaps(ctx context.Context, network *optimismv1alpha1.OptimismNetwork, addresses *optimismv1alpha1.NetworkContractAddresses) error {
-	// Create ConfigMap for rollup configuration
-	if network.Spec.RollupConfig != nil && network.Spec.RollupConfig.AutoDiscover {
-		rollupConfigMap := &corev1.ConfigMap{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      network.Name + "-rollup-config",
-				Namespace: network.Namespace,
-			},
-			Data: map[string]string{
-				"rollup.json": r.generateRollupConfig(network, addresses),
-			},
+	// Registered chains use the published rollup config and genesis when available
+	registered, err := r.registeredChain(network.Spec.ChainID)
+	if err != nil {
+		return err
+	}
+
+	// Rollup configuration
+	if source := network.Spec.RollupConfig; source != nil {
+		var rollupConfig string
+		switch {
+		case source.Inline != "":
+			rollupConfig = source.Inline
+		case source.ConfigMapRef != nil:
+			rollupConfig, err = r.configMapKey(ctx, network.Namespace, source.ConfigMapRef)
+		case source.AutoDiscover:
+			rollupConfig, err = r.generateRollupConfig(ctx, network, addresses, registered)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to resolve rollup config: %w", err)
 		}
 
-		if err := controllerutil.SetControllerReference(network, rollupConfigMap, r.Scheme); err != nil {
+		cfg, err := rollup.Parse([]byte(rollupConfig))
+		if err != nil {
 			return err
 		}
+		if err := cfg.Check(); err != nil {
+			return fmt.Errorf("rollup config is invalid: %w", err)
+		}
 
-		if err := r.createOrUpdateConfigMap(ctx, rollupConfigMap); err != nil {
-			return fmt.Errorf("failed to create rollup config map: %w", err)
+		if source.ConfigMapRef == nil {
+			if err := r.writeManagedConfigMap(ctx, network, resources.RollupConfigMapName(network), resources.RollupConfigKey, rollupConfig); err != nil {
+				return fmt.Errorf("failed to create rollup config map: %w", err)
+			}
 		}
 	}
 
-	// Create ConfigMap for L2 genesis
-	if network.Spec.L2Genesis != nil && network.Spec.L2Genesis.AutoDiscover {
-		genesisConfigMap := &corev1.ConfigMap{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      network.Name + "-genesis",
-				Namespace: network.Namespace,
-			},
-			Data: map[string]string{
-				"genesis.json": r.generateGenesisConfig(network, addresses),
-			},
+	// L2 genesis
+	var bundledGenesis string
+	if source := network.Spec.L2Genesis; source != nil {
+		var genesis string
+		switch {
+		case source.Inline != "":
+			genesis = source.Inline
+		case source.ConfigMapRef != nil:
+			genesis, err = r.configMapKey(ctx, network.Namespace, source.ConfigMapRef)
+		case source.AutoDiscover:
+			genesis, bundledGenesis, err = registeredGenesis(network, registered)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to resolve L2 genesis: %w", err)
 		}
 
-		if err := controllerutil.SetControllerReference(network, genesisConfigMap, r.Scheme); err != nil {
-			return err
+		if bundledGenesis == "" {
+			if !json.Valid([]byte(genesis)) {
+				return fmt.Errorf("L2 genesis is not valid JSON")
+			}
+			if source.ConfigMapRef == nil {
+				if err := r.writeManagedConfigMap(ctx, network, resources.GenesisConfigMapName(network), resources.GenesisKey, genesis); err != nil {
+					return fmt.Errorf("failed to create genesis config map: %w", err)
+				}
+			}
 		}
+	}
 
-		if err := r.createOrUpdateConfigMap(ctx, genesisConfigMap); err != nil {
-			return fmt.Errorf("failed to create genesis config map: %w", err)
+	// OpNodes read the bundled genesis network from status
+	if bundledGenesis != "" || network.Status.NetworkInfo != nil {
+		if network.Status.NetworkInfo == nil {
+			network.Status.NetworkInfo = &optimismv1alpha1.NetworkInfo{}
 		}
+		network.Status.NetworkInfo.OpGethNetwork = bundledGenesis
 	}
 
 	return nil
 }
 
-// generateRollupConfig generates a ConfigMap with rollup configuration
-func (r *OptimismNetworkReconciler) generateRollupConfig(network *optimismv1alpha1.OptimismNetwork, addresses *optimismv1alpha1.NetworkContractAddresses) string {
-	// Generate a basic rollup configuration
-	// In a real implementation, this would create a proper rollup.json structure
-	return fmt.Sprintf(`{
-	"genesis": {
-		"l1": {
-			"hash": "0x0000000000000000000000000000000000000000000000000000000000000000",
-			"number": 0
+// registeredGenesis resolves an auto-discovered L2 genesis from the superchain registry. It
+// returns the genesis document when the registry embeds one, or otherwise the op-geth network
+// that bundles it. Only the registry publishes a genesis; it cannot be derived from L1.
+func registeredGenesis(network *optimismv1alpha1.OptimismNetwork, chain *superchain.Chain) (genesis, opGethNetwork string, err error) {
+	switch {
+	case chain == nil:
+		return "", "", fmt.Errorf("L2 genesis of unregistered chain %d cannot be auto-discovered; provide l2Genesis inline or from a ConfigMap",
+			network.Spec.ChainID)
+	case len(chain.Genesis) > 0:
+		return string(chain.Genesis), "", nil
+	case chain.GenesisRef != nil && chain.GenesisRef.OpGethNetwork != "":
+		return "", chain.GenesisRef.OpGethNetwork, nil
+	}
+	return "", "", fmt.Errorf("registered chain %d (%s) has no genesis or genesis reference; add %s to the superchain registry override, "+
+		"or provide l2Genesis inline or from a ConfigMap", chain.ChainID, chain.Identifier, fmt.Sprintf("genesis-%d.json", chain.ChainID))
+}
+
+// configMapKey reads a single key of a user-provided ConfigMap
+func (r *OptimismNetworkReconciler) configMapKey(ctx context.Context, namespace string, ref *corev1.ConfigMapKeySelector) (string, error) {
+	var configMap corev1.ConfigMap
+	if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, &configMap); err != nil {
+		return "", fmt.Errorf("failed to get ConfigMap %s: %w", ref.Name, err)
+	}
+	value, ok := configMap.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("ConfigMap %s has no key %q", ref.Name, ref.Key)
+	}
+	return value, nil
+}
+
+// writeManagedConfigMap creates or updates a network-owned ConfigMap holding a single file
+func (r *OptimismNetworkReconciler) writeManagedConfigMap(ctx context.Context, network *optimismv1alpha1.OptimismNetwork, name, key, content string) error {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: network.Namespace,
 		},
-		"l2": {
-			"hash": "0x0000000000000000000000000000000000000000000000000000000000000000",
-			"number": 0
+		Data: map[string]string{
+			key: content,
 		},
-		"l2_time": 0,
-		"system_config": {
-			"batcherAddr": "0x0000000000000000000000000000000000000000",
-			"overhead": "0x00000000000000000000000000000000000000000000000000000000000000bc",
-			"scalar": "0x00000000000000000000000000000000000000000000000000000000000a6fe0",
-			"gasLimit": 30000000
+	}
+
+	if err := controllerutil.SetControllerReference(network, configMap, r.Scheme); err != nil {
+		return err
+	}
+
+	return r.createOrUpdateConfigMap(ctx, configMap)
+}
+
+// registeredChain returns the superchain registry entry for chainID, or nil if unregistered
+func (r *OptimismNetworkReconciler) registeredChain(chainID int64) (*superchain.Chain, error) {
+	registry := r.SuperchainRegistry
+	if registry == nil {
+		var err error
+		if registry, err = superchain.Embedded(); err != nil {
+			return nil, err
 		}
-	},
-	"block_time": 2,
-	"max_sequencer_drift": 600,
-	"seq_window_size": 3600,
-	"channel_timeout": 300,
-	"l1_chain_id": %d,
-	"l2_chain_id": %d,
-	"regolith_time": 0,
-	"canyon_time": 0,
-	"delta_time": 0,
-	"ecotone_time": 0,
-	"fjord_time": 0,
-	"granite_time": 0,
-	"holocene_time": 0,
-	"batch_inbox_address": "0xff00000000000000000000000000000000000000",
-	"deposit_contract_address": "%s",
-	"l1_system_config_address": "%s"
-}`, network.Spec.L1ChainID, network.Spec.ChainID,
-		addresses.OptimismPortalAddr, addresses.SystemConfigAddr)
+	}
+
+	chain, ok := registry.ChainByID(chainID)
+	if !ok {
+		return nil, nil
+	}
+	return chain, nil
 }
 
-// generateGenesisConfig generates a ConfigMap with L2 genesis configuration
-func (r *OptimismNetworkReconciler) generateGenesisConfig(network *optimismv1alpha1.OptimismNetwork, _ *optimismv1alpha1.NetworkContractAddresses) string {
-	// Generate a basic L2 genesis configuration
-	// In a real implementation, this would create a proper genesis.json structure
-	return fmt.Sprintf(`{
-	"config": {
-		"chainId": %d,
-		"homesteadBlock": 0,
-		"eip150Block": 0,
-		"eip155Block": 0,
-		"eip158Block": 0,
-		"byzantiumBlock": 0,
-		"constantinopleBlock": 0,
-		"petersburgBlock": 0,
-		"istanbulBlock": 0,
-		"muirGlacierBlock": 0,
-		"berlinBlock": 0,
-		"londonBlock": 4,
-		"arrowGlacierBlock": 4,
-		"grayGlacierBlock": 4,
-		"mergeNetsplitBlock": 4,
-		"shanghaiTime": 4,
-		"cancunTime": 4,
-		"terminalTotalDifficulty": 0,
-		"terminalTotalDifficultyPassed": true,
-		"optimism": {
-			"eip1559Elasticity": 6,
-			"eip1559Denominator": 50,
-			"eip1559DenominatorCanyon": 250
+// generateRollupConfig produces a validated rollup.json. Registered chains use the
+// published superchain-registry config; other chains are built from L1 state.
+func (r *OptimismNetworkReconciler) generateRollupConfig(ctx context.Context, network *optimismv1alpha1.OptimismNetwork, addresses *optimismv1alpha1.NetworkContractAddresses, registered *superchain.Chain) (string, error) {
+	if registered != nil && len(registered.RollupConfig) > 0 {
+		cfg, err := rollup.Parse(registered.RollupConfig)
+		if err != nil {
+			return "", err
+		}
+		if cfg.L2ChainID != uint64(network.Spec.ChainID) || cfg.L1ChainID != uint64(network.Spec.L1ChainID) {
+			return "", fmt.Errorf("registry rollup config is for chain %d on L1 %d, not chain %d on L1 %d",
+				cfg.L2ChainID, cfg.L1ChainID, network.Spec.ChainID, network.Spec.L1ChainID)
+		}
+		if err := cfg.Check(); err != nil {
+			return "", fmt.Errorf("registry rollup config is invalid: %w", err)
+		}
+		// Returned verbatim, as Config does not carry every field op-node reads
+		return string(registered.RollupConfig), nil
+	}
+
+	inputs, err := rollupInputs(network, addresses)
+	if err != nil {
+		return "", err
+	}
+
+	// The genesis is read from L1 once. Regenerating it would pick up SystemConfig changes
+	// made since, alter rollup.json and roll every OpNode of the network.
+	genesis, stored, err := r.storedRollupGenesis(ctx, network, inputs)
+	if err != nil {
+		return "", err
+	}
+	if !stored {
+		if genesis, err = r.readRollupGenesis(ctx, network, inputs); err != nil {
+			return "", err
 		}
-	},
-	"nonce": "0x0",
-	"timestamp": "0x4",
-	"extraData": "0x",
-	"gasLimit": "0x1c9c380",
-	"difficulty": "0x0",
-	"mixHash": "0x0000000000000000000000000000000000000000000000000000000000000000",
-	"coinbase": "0x4200000000000000000000000000000000000011",
-	"alloc": {},
-	"number": "0x0",
-	"gasUsed": "0x0",
-	"parentHash": "0x0000000000000000000000000000000000000000000000000000000000000000",
-	"baseFeePerGas": "0x3b9aca00",
-	"excessBlobGas": "0x0",
-	"blobGasUsed": "0x0"
-}`, network.Spec.ChainID)
+	}
+
+	cfg, err := rollup.Assemble(inputs, genesis)
+	if err != nil {
+		return "", err
+	}
+	return cfg.Marshal()
+}
+
+// readRollupGenesis reads the genesis of a chain outside the superchain registry from L1
+func (r *OptimismNetworkReconciler) readRollupGenesis(ctx context.Context, network *optimismv1alpha1.OptimismNetwork, inputs rollup.Inputs) (rollup.Genesis, error) {
+	l1RpcURL, err := activeL1RpcURL(ctx, r.Client, network)
+	if err != nil {
+		return rollup.Genesis{}, err
+	}
+	client, err := ethclient.DialContext(ctx, l1RpcURL)
+	if err != nil {
+		return rollup.Genesis{}, fmt.Errorf("failed to connect to L1 RPC: %w", redactL1URL(err, optimismv1alpha1.ActiveL1RpcEndpoint(network), l1RpcURL))
+	}
+	defer client.Close()
+
+	genesis, err := rollup.ReadGenesis(ctx, client, inputs)
+	return genesis, redactL1URL(err, optimismv1alpha1.ActiveL1RpcEndpoint(network), l1RpcURL)
+}
+
+// storedRollupGenesis returns the genesis of the rollup config generated before, as long as it
+// belongs to the same chain, SystemConfig and L2 genesis block
+func (r *OptimismNetworkReconciler) storedRollupGenesis(ctx context.Context, network *optimismv1alpha1.OptimismNetwork, inputs rollup.Inputs) (rollup.Genesis, bool, error) {
+	var configMap corev1.ConfigMap
+	err := r.Get(ctx, types.NamespacedName{Name: resources.RollupConfigMapName(network), Namespace: network.Namespace}, &configMap)
+	if apierrors.IsNotFound(err) {
+		return rollup.Genesis{}, false, nil
+	}
+	if err != nil {
+		return rollup.Genesis{}, false, fmt.Errorf("failed to get stored rollup config: %w", err)
+	}
+
+	cfg, err := rollup.Parse([]byte(configMap.Data[resources.RollupConfigKey]))
+	if err != nil || cfg.Check() != nil {
+		return rollup.Genesis{}, false, nil
+	}
+	if cfg.L1ChainID != inputs.L1ChainID || cfg.L2ChainID != inputs.L2ChainID ||
+		cfg.L1SystemConfigAddress != inputs.SystemConfig || cfg.Genesis.L2 != inputs.L2Genesis ||
+		inputs.L2GenesisTime != 0 && cfg.Genesis.L2Time != inputs.L2GenesisTime {
+		return rollup.Genesis{}, false, nil
+	}
+	return cfg.Genesis, true, nil
+}
+
+// rollupInputs collects the rollup config inputs from the spec and discovered addresses
+func rollupInputs(network *optimismv1alpha1.OptimismNetwork, addresses *optimismv1alpha1.NetworkContractAddresses) (rollup.Inputs, error) {
+	params := network.Spec.ChainParameters
+	if params == nil || params.L2GenesisHash == "" {
+		return rollup.Inputs{}, fmt.Errorf("chainParameters.l2GenesisHash is required to generate a rollup config for chains outside the superchain registry")
+	}
+	for name, address := range map[string]string{
+		"SystemConfig":   addresses.SystemConfigAddr,
+		"OptimismPortal": addresses.OptimismPortalAddr,
+		"BatchInbox":     addresses.BatchInboxAddr,
+	} {
+		if !common.IsHexAddress(address) {
+			return rollup.Inputs{}, fmt.Errorf("%s address is required to generate a rollup config", name)
+		}
+	}
+
+	inputs := rollup.Inputs{
+		L1ChainID:         uint64(network.Spec.L1ChainID),
+		L2ChainID:         uint64(network.Spec.ChainID),
+		SystemConfig:      common.HexToAddress(addresses.SystemConfigAddr),
+		OptimismPortal:    common.HexToAddress(addresses.OptimismPortalAddr),
+		BatchInbox:        common.HexToAddress(addresses.BatchInboxAddr),
+		L2Genesis:         rollup.BlockID{Hash: common.HexToHash(params.L2GenesisHash), Number: uint64(params.L2GenesisBlock)},
+		L2GenesisTime:     uint64(params.L2GenesisTime),
+		BlockTime:         uint64(params.BlockTime),
+		MaxSequencerDrift: uint64(params.MaxSequencerDrift),
+		SeqWindowSize:     uint64(params.SeqWindowSize),
+		ChannelTimeout:    uint64(params.ChannelTimeout),
+	}
+	if params.ProtocolVersionsAddr != "" {
+		protocolVersions := common.HexToAddress(params.ProtocolVersionsAddr)
+		inputs.ProtocolVersions = &protocolVersions
+	}
+	if forks := params.Hardforks; forks != nil {
+		inputs.Hardforks = rollup.Hardforks{
+			Regolith: forkTime(forks.Regolith),
+			Canyon:   forkTime(forks.Canyon),
+			Delta:    forkTime(forks.Delta),
+			Ecotone:  forkTime(forks.Ecotone),
+			Fjord:    forkTime(forks.Fjord),
+			Granite:  forkTime(forks.Granite),
+			Holocene: forkTime(forks.Holocene),
+			Isthmus:  forkTime(forks.Isthmus),
+		}
+	}
+	return inputs, nil
+}
+
+// forkTime converts an optional spec timestamp to the rollup config representation
+func forkTime(t *int64) *uint64 {
+	if t == nil {
+		return nil
+	}
+	value := uint64(*t)
+	return &value
 }
 
 // createOrUpdateConfigMap creates or updates a ConfigMap
@@ -410,7 +598,10 @@ func (r *OptimismNetworkReconciler) createOrUpdateConfigMap(ctx context.Context,
 		return err
 	}
 
-	// Update existing ConfigMap
+	// Update existing ConfigMap; unchanged content is left alone on every requeue
+	if maps.Equal(existing.Data, configMap.Data) {
+		return nil
+	}
 	existing.Data = configMap.Data
 	return r.Update(ctx, &existing)
 }
@@ -446,6 +637,11 @@ func (r *OptimismNetworkReconciler) updateStatusWithRetry(ctx context.Context, n
 		latest.Status.ObservedGeneration = network.Status.ObservedGeneration
 		latest.Status.Conditions = network.Status.Conditions
 		latest.Status.NetworkInfo = network.Status.NetworkInfo
+		latest.Status.L1 = network.Status.L1
+		latest.Status.L1Endpoints = network.Status.L1Endpoints
+		latest.Status.ActiveL1Endpoint = network.Status.ActiveL1Endpoint
+		latest.Status.PendingL1Endpoint = network.Status.PendingL1Endpoint
+		latest.Status.PendingL1EndpointSince = network.Status.PendingL1EndpointSince
 
 		return r.Status().Update(ctx, &latest)
 	})
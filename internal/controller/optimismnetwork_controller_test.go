@@ -17,14 +17,34 @@ limitations under the License.
 package controller
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/discovery"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/rollup"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/superchain"
 )
 
 var _ = Describe("OptimismNetwork Controller Unit Tests", func() {
@@ -164,4 +184,898 @@ var _ = Describe("OptimismNetwork Controller Unit Tests", func() {
 			}
 		})
 	})
+
+	Context("SystemConfig Discovery", func() {
+		const systemConfigAddr = "0x034edD2A225f7f429A63E0f1D2084B9E0A93b538"
+
+		getters := map[string]common.Address{
+			"optimismPortal()":         common.HexToAddress("0x16Fc5058F25648194471939df75CF27A2fdC48BC"),
+			"l1CrossDomainMessenger()": common.HexToAddress("0x58Cc85b8D04EA49cC6DBd3CbFFd00B4B8D6cb3ef"),
+			"l1StandardBridge()":       common.HexToAddress("0xFBb0621E0B23b5478B630BD55a5f21f67730B0F1"),
+			"disputeGameFactory()":     common.HexToAddress("0x05F9613aDB30026FFd634f38e5C4dFd30a197Fa1"),
+			"batchInbox()":             common.HexToAddress("0xff00000000000000000000000000000011155420"),
+		}
+
+		// failures are getters whose call fails for a reason other than a revert
+		failures := map[string]string{}
+
+		// newSystemConfigStub serves eth_call for the SystemConfig getters and returns its URL.
+		// Selectors without a result revert, like getters missing from a SystemConfig version.
+		newSystemConfigStub := func() string {
+			failed := make(map[string]string)
+			for signature, message := range failures {
+				failed[hexutil.Encode(crypto.Keccak256([]byte(signature))[:4])] = message
+			}
+			results := make(map[string][]byte)
+			for signature, address := range getters {
+				results[hexutil.Encode(crypto.Keccak256([]byte(signature))[:4])] = common.LeftPadBytes(address.Bytes(), 32)
+			}
+			results[hexutil.Encode(crypto.Keccak256([]byte("startBlock()"))[:4])] = common.LeftPadBytes(big.NewInt(4071248).Bytes(), 32)
+
+			server := newJSONRPCStub(map[string]interface{}{
+				"eth_call": jsonRPCHandler(func(params json.RawMessage) (interface{}, error) {
+					var args []json.RawMessage
+					if err := json.Unmarshal(params, &args); err != nil {
+						return nil, err
+					}
+					var call struct {
+						To    common.Address `json:"to"`
+						Input hexutil.Bytes  `json:"input"`
+					}
+					if err := json.Unmarshal(args[0], &call); err != nil {
+						return nil, err
+					}
+					if call.To != common.HexToAddress(systemConfigAddr) {
+						return hexutil.Bytes{}, nil
+					}
+					if message, ok := failed[hexutil.Encode(call.Input[:4])]; ok {
+						return nil, errors.New(message)
+					}
+					result, ok := results[hexutil.Encode(call.Input[:4])]
+					if !ok {
+						return nil, fmt.Errorf("execution reverted")
+					}
+					return hexutil.Bytes(result), nil
+				}),
+			})
+			DeferCleanup(server.Close)
+			return server.URL
+		}
+
+		newNetwork := func(l1RpcUrl string) *optimismv1alpha1.OptimismNetwork {
+			return &optimismv1alpha1.OptimismNetwork{
+				Spec: optimismv1alpha1.OptimismNetworkSpec{
+					NetworkName: "custom-devnet",
+					ChainID:     901,
+					L1ChainID:   900,
+					L1RpcUrl:    l1RpcUrl,
+					ContractAddresses: &optimismv1alpha1.ContractAddressConfig{
+						SystemConfigAddr: systemConfigAddr,
+						DiscoveryMethod:  "system-config",
+					},
+				},
+			}
+		}
+
+		It("Should resolve every contract address from the SystemConfig getters", func() {
+			service := discovery.NewContractDiscoveryService(time.Hour)
+			network := newNetwork(newSystemConfigStub())
+			addresses, err := service.DiscoverContracts(context.Background(), network, network.Spec.L1RpcUrl)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(addresses.DiscoveryMethod).To(Equal("system-config"))
+			Expect(addresses.SystemConfigAddr).To(Equal(systemConfigAddr))
+			Expect(addresses.OptimismPortalAddr).To(Equal("0x16Fc5058F25648194471939df75CF27A2fdC48BC"))
+			Expect(addresses.L1CrossDomainMessengerAddr).To(Equal("0x58Cc85b8D04EA49cC6DBd3CbFFd00B4B8D6cb3ef"))
+			Expect(addresses.L1StandardBridgeAddr).To(Equal("0xFBb0621E0B23b5478B630BD55a5f21f67730B0F1"))
+			Expect(addresses.DisputeGameFactoryAddr).To(Equal("0x05F9613aDB30026FFd634f38e5C4dFd30a197Fa1"))
+			Expect(addresses.BatchInboxAddr).To(Equal(getters["batchInbox()"].Hex()))
+			Expect(addresses.SystemConfigStartBlock).To(Equal(int64(4071248)))
+			Expect(addresses.L2ToL1MessagePasserAddr).To(Equal(discovery.L2ToL1MessagePasserPredeploy))
+
+			// l2OutputOracle() reverts on the stub, so it is left empty rather than failing discovery
+			Expect(addresses.L2OutputOracleAddr).To(BeEmpty())
+		})
+
+		It("Should fail when a required getter reverts", func() {
+			delete(getters, "optimismPortal()")
+			DeferCleanup(func() {
+				getters["optimismPortal()"] = common.HexToAddress("0x16Fc5058F25648194471939df75CF27A2fdC48BC")
+			})
+
+			service := discovery.NewContractDiscoveryService(time.Hour)
+			network := newNetwork(newSystemConfigStub())
+			_, err := service.DiscoverContracts(context.Background(), network, network.Spec.L1RpcUrl)
+			Expect(err).To(MatchError(ContainSubstring("optimismPortal")))
+		})
+
+		It("Should fail when an optional getter fails without reverting", func() {
+			failures["l2OutputOracle()"] = "request timed out"
+			DeferCleanup(func() { delete(failures, "l2OutputOracle()") })
+
+			service := discovery.NewContractDiscoveryService(time.Hour)
+			network := newNetwork(newSystemConfigStub())
+			_, err := service.DiscoverContracts(context.Background(), network, network.Spec.L1RpcUrl)
+			Expect(err).To(MatchError(ContainSubstring("SystemConfig.l2OutputOracle() call failed: request timed out")))
+		})
+	})
+
+	Context("Superchain Registry Discovery", func() {
+		newNetwork := func(chainID int64) *optimismv1alpha1.OptimismNetwork {
+			return &optimismv1alpha1.OptimismNetwork{
+				Spec: optimismv1alpha1.OptimismNetworkSpec{
+					ChainID:   chainID,
+					L1ChainID: 11155111,
+					L1RpcUrl:  "http://127.0.0.1:0",
+					ContractAddresses: &optimismv1alpha1.ContractAddressConfig{
+						DiscoveryMethod: "superchain-registry",
+					},
+				},
+			}
+		}
+
+		It("Should resolve a registered chain from the embedded snapshot", func() {
+			service := discovery.NewContractDiscoveryService(time.Hour)
+			addresses, err := service.DiscoverContracts(context.Background(), newNetwork(84532), "http://127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(addresses.DiscoveryMethod).To(Equal("superchain-registry"))
+			Expect(addresses.SystemConfigAddr).To(Equal("0xf272670eb55e895584501d564AfEB048bEd26194"))
+			Expect(addresses.OptimismPortalAddr).To(Equal("0x49f53e41452C74589E85cA1677426Ba426459e85"))
+			Expect(addresses.BatchInboxAddr).To(Equal("0xff00000000000000000000000000000000084532"))
+			Expect(addresses.L2StandardBridgeAddr).To(Equal(discovery.L2StandardBridgePredeploy))
+		})
+
+		It("Should embed addresses, a rollup config and a genesis reference for every listed chain", func() {
+			registry, err := superchain.Embedded()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(registry.ChainIDs()).NotTo(BeEmpty())
+			for _, chainID := range registry.ChainIDs() {
+				chain, _ := registry.ChainByID(chainID)
+				Expect(chain.Address(superchain.SystemConfigProxy)).NotTo(BeEmpty(), "chain %d", chainID)
+				cfg, err := rollup.Parse(chain.RollupConfig)
+				Expect(err).NotTo(HaveOccurred(), "chain %d", chainID)
+				Expect(cfg.L2ChainID).To(Equal(uint64(chainID)))
+				Expect(chain.GenesisRef).NotTo(BeNil(), "chain %d", chainID)
+				Expect(chain.GenesisRef.OpGethNetwork).To(Equal(superchain.OpNetworkName(chain.Identifier)))
+				Expect(chain.GenesisRef.L2GenesisHash).To(Equal(cfg.Genesis.L2.Hash.Hex()), "chain %d", chainID)
+			}
+		})
+
+		It("Should fail for chains missing from the registry", func() {
+			service := discovery.NewContractDiscoveryService(time.Hour)
+			_, err := service.DiscoverContracts(context.Background(), newNetwork(901), "http://127.0.0.1:0")
+			Expect(err).To(MatchError(ContainSubstring("not in the superchain registry")))
+		})
+
+		It("Should overlay chains, rollup config and genesis from an override directory", func() {
+			dir := GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(dir, superchain.ChainListFile), []byte(`[
+				{"name": "Devnet", "identifier": "sepolia/devnet", "chainId": 901, "parent": {"type": "L2", "chain": "sepolia"}}
+			]`), 0o600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, superchain.AddressesFile), []byte(`{
+				"901": {"OptimismPortalProxy": "0x1111111111111111111111111111111111111111", "SystemConfigProxy": "0x2222222222222222222222222222222222222222"}
+			}`), 0o600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "rollup-901.json"), []byte(`{"l2_chain_id": 901, "batch_inbox_address": "0xff00000000000000000000000000000000000901"}`), 0o600)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "genesis-901.json"), []byte(`{"config": {"chainId": 901}}`), 0o600)).To(Succeed())
+
+			registry, err := superchain.NewRegistry(dir)
+			Expect(err).NotTo(HaveOccurred())
+
+			chain, ok := registry.ChainByID(901)
+			Expect(ok).To(BeTrue())
+			Expect(chain.Superchain).To(Equal("sepolia"))
+			Expect(string(chain.Genesis)).To(ContainSubstring(`"chainId": 901`))
+
+			// Embedded chains remain available alongside the override
+			_, ok = registry.ChainByID(10)
+			Expect(ok).To(BeTrue())
+
+			service := discovery.NewContractDiscoveryService(time.Hour)
+			service.SetRegistry(registry)
+			addresses, err := service.DiscoverContracts(context.Background(), newNetwork(901), "http://127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(addresses.OptimismPortalAddr).To(Equal("0x1111111111111111111111111111111111111111"))
+			Expect(addresses.BatchInboxAddr).To(Equal("0xff00000000000000000000000000000000000901"))
+
+			// The override is applied to a copy, never to the shared embedded snapshot
+			embedded, err := superchain.Embedded()
+			Expect(err).NotTo(HaveOccurred())
+			_, ok = embedded.ChainByID(901)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("Should share one embedded snapshot across concurrent discoveries", func() {
+			service := discovery.NewContractDiscoveryService(time.Hour)
+			reconciler := &OptimismNetworkReconciler{}
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					_, err := service.DiscoverContracts(context.Background(), newNetwork(84532), "http://127.0.0.1:0")
+					Expect(err).NotTo(HaveOccurred())
+					chain, err := reconciler.registeredChain(84532)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(chain).NotTo(BeNil())
+				}()
+			}
+			wg.Wait()
+
+			first, err := superchain.Embedded()
+			Expect(err).NotTo(HaveOccurred())
+			second, err := superchain.Embedded()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(second).To(BeIdenticalTo(first))
+		})
+
+		It("Should use the registered rollup config for auto-discovered ConfigMaps", func() {
+			reconciler := &OptimismNetworkReconciler{}
+			chain, err := reconciler.registeredChain(11155420)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chain).NotTo(BeNil())
+
+			var rollup map[string]interface{}
+			Expect(json.Unmarshal(chain.RollupConfig, &rollup)).To(Succeed())
+			Expect(rollup["l2_chain_id"]).To(BeEquivalentTo(11155420))
+			Expect(rollup["l1_system_config_address"]).To(Equal("0x034edd2a225f7f429a63e0f1d2084b9e0a93b538"))
+		})
+	})
+
+	Context("Rollup Config Generation", func() {
+		const systemConfigAddr = "0x2222222222222222222222222222222222222222"
+		batcher := common.HexToAddress("0x3333333333333333333333333333333333333333")
+		l1Origin := &types.Header{
+			Number:     big.NewInt(4071408),
+			Time:       1691802432,
+			Difficulty: big.NewInt(0),
+			BaseFee:    big.NewInt(1000000000),
+		}
+
+		// failures are selectors whose call fails for a reason other than a revert
+		failures := map[string]string{}
+
+		// newL1Stub serves the SystemConfig getters and the L1 start block header. The batcher and
+		// gas limit were updated after the start block, so the latest state differs from genesis.
+		newL1Stub := func() string {
+			selectors := func(results map[string][]byte) map[string][]byte {
+				bySelector := make(map[string][]byte)
+				for signature, result := range results {
+					bySelector[hexutil.Encode(crypto.Keccak256([]byte(signature))[:4])] = result
+				}
+				return bySelector
+			}
+			startBlock := common.LeftPadBytes(l1Origin.Number.Bytes(), 32)
+			states := map[string]map[string][]byte{
+				hexutil.EncodeBig(l1Origin.Number): selectors(map[string][]byte{
+					"startBlock()":  startBlock,
+					"batcherHash()": common.LeftPadBytes(batcher.Bytes(), 32),
+					"gasLimit()":    common.LeftPadBytes(big.NewInt(30000000).Bytes(), 32),
+				}),
+				"latest": selectors(map[string][]byte{
+					"startBlock()":  startBlock,
+					"batcherHash()": common.LeftPadBytes(common.HexToAddress("0x4444444444444444444444444444444444444444").Bytes(), 32),
+					"gasLimit()":    common.LeftPadBytes(big.NewInt(60000000).Bytes(), 32),
+				}),
+			}
+
+			server := newJSONRPCStub(map[string]interface{}{
+				"eth_call": jsonRPCHandler(func(params json.RawMessage) (interface{}, error) {
+					var args []json.RawMessage
+					if err := json.Unmarshal(params, &args); err != nil {
+						return nil, err
+					}
+					var call struct {
+						Input hexutil.Bytes `json:"input"`
+					}
+					var block string
+					if err := json.Unmarshal(args[0], &call); err != nil {
+						return nil, err
+					}
+					if err := json.Unmarshal(args[1], &block); err != nil {
+						return nil, err
+					}
+					state, ok := states[block]
+					if !ok {
+						return nil, fmt.Errorf("missing trie node for block %s", block)
+					}
+					if message, ok := failures[hexutil.Encode(call.Input[:4])]; ok {
+						return nil, errors.New(message)
+					}
+					result, ok := state[hexutil.Encode(call.Input[:4])]
+					if !ok {
+						return nil, fmt.Errorf("execution reverted")
+					}
+					return hexutil.Bytes(result), nil
+				}),
+				"eth_getBlockByNumber": jsonRPCHandler(func(params json.RawMessage) (interface{}, error) {
+					var args []interface{}
+					if err := json.Unmarshal(params, &args); err != nil {
+						return nil, err
+					}
+					if args[0] != hexutil.EncodeBig(l1Origin.Number) {
+						return nil, fmt.Errorf("unexpected block %v", args[0])
+					}
+					return l1Origin, nil
+				}),
+			})
+			DeferCleanup(server.Close)
+			return server.URL
+		}
+
+		newNetwork := func(l1RpcUrl string) *optimismv1alpha1.OptimismNetwork {
+			canyon := int64(1699981200)
+			return &optimismv1alpha1.OptimismNetwork{
+				Spec: optimismv1alpha1.OptimismNetworkSpec{
+					ChainID:   901,
+					L1ChainID: 11155111,
+					L1RpcUrl:  l1RpcUrl,
+					ChainParameters: &optimismv1alpha1.ChainParameters{
+						L2GenesisHash: "0x102de6ffb001480cc9b8b548fd05c34cd4f46ae4aa91759393db90ea0409887d",
+						Hardforks:     &optimismv1alpha1.HardforkTimes{Canyon: &canyon},
+					},
+				},
+			}
+		}
+
+		addresses := &optimismv1alpha1.NetworkContractAddresses{
+			SystemConfigAddr:   systemConfigAddr,
+			OptimismPortalAddr: "0x1111111111111111111111111111111111111111",
+			BatchInboxAddr:     "0xff00000000000000000000000000000000000901",
+		}
+
+		It("Should build the rollup config from the SystemConfig and L1 start block", func() {
+			reconciler := &OptimismNetworkReconciler{Client: newFakeClient()}
+			generated, err := reconciler.generateRollupConfig(context.Background(), newNetwork(newL1Stub()), addresses, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := rollup.Parse([]byte(generated))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Check()).To(Succeed())
+
+			Expect(cfg.Genesis.L1).To(Equal(rollup.BlockID{Hash: l1Origin.Hash(), Number: 4071408}))
+			Expect(cfg.Genesis.L2.Hash.Hex()).To(Equal("0x102de6ffb001480cc9b8b548fd05c34cd4f46ae4aa91759393db90ea0409887d"))
+			Expect(cfg.Genesis.L2Time).To(Equal(l1Origin.Time))
+			Expect(cfg.Genesis.SystemConfig.BatcherAddr).To(Equal(batcher))
+			Expect(cfg.Genesis.SystemConfig.GasLimit).To(Equal(uint64(30000000)))
+			Expect(cfg.BlockTime).To(Equal(uint64(rollup.DefaultBlockTime)))
+			Expect(cfg.L2ChainID).To(Equal(uint64(901)))
+			Expect(cfg.BatchInboxAddress).To(Equal(common.HexToAddress(addresses.BatchInboxAddr)))
+			Expect(*cfg.RegolithTime).To(BeZero())
+			Expect(*cfg.CanyonTime).To(Equal(uint64(1699981200)))
+			Expect(cfg.IsthmusTime).To(BeNil())
+		})
+
+		It("Should fail when a deprecated getter fails without reverting", func() {
+			failures[hexutil.Encode(crypto.Keccak256([]byte("scalar()"))[:4])] = "429 Too Many Requests"
+			DeferCleanup(func() { clear(failures) })
+
+			reconciler := &OptimismNetworkReconciler{Client: newFakeClient()}
+			_, err := reconciler.generateRollupConfig(context.Background(), newNetwork(newL1Stub()), addresses, nil)
+			Expect(err).To(MatchError(ContainSubstring("SystemConfig.scalar() call at L1 block 4071408 failed")))
+		})
+
+		It("Should keep the stored genesis instead of reading L1 again", func() {
+			reconciler := &OptimismNetworkReconciler{Client: newFakeClient()}
+			network := newNetwork(newL1Stub())
+			generated, err := reconciler.generateRollupConfig(context.Background(), network, addresses, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reconciler.Create(context.Background(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: resources.RollupConfigMapName(network)},
+				Data:       map[string]string{resources.RollupConfigKey: generated},
+			})).To(Succeed())
+
+			// The genesis comes from the ConfigMap while the spec still applies
+			network.Spec.L1RpcUrl = "http://127.0.0.1:1"
+			fjord := int64(1720627201)
+			network.Spec.ChainParameters.Hardforks = &optimismv1alpha1.HardforkTimes{Fjord: &fjord,
+				Canyon: &fjord, Delta: &fjord, Ecotone: &fjord}
+			regenerated, err := reconciler.generateRollupConfig(context.Background(), network, addresses, nil)
+			Expect(err).NotTo(HaveOccurred())
+			cfg, err := rollup.Parse([]byte(regenerated))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Genesis.SystemConfig.BatcherAddr).To(Equal(batcher))
+			Expect(*cfg.FjordTime).To(Equal(uint64(1720627201)))
+
+			// A different L2 genesis block needs a new genesis from L1
+			network.Spec.ChainParameters.L2GenesisBlock = 1
+			_, err = reconciler.generateRollupConfig(context.Background(), network, addresses, nil)
+			Expect(err).To(MatchError(ContainSubstring("SystemConfig.startBlock() call failed")))
+		})
+
+		It("Should reject scheduled forks that precede their predecessor", func() {
+			network := newNetwork(newL1Stub())
+			delta := int64(100)
+			network.Spec.ChainParameters.Hardforks.Delta = &delta
+
+			reconciler := &OptimismNetworkReconciler{Client: newFakeClient()}
+			_, err := reconciler.generateRollupConfig(context.Background(), network, addresses, nil)
+			Expect(err).To(MatchError(ContainSubstring("delta_time (100)")))
+		})
+
+		It("Should leave forks after a partial schedule inactive", func() {
+			network := newNetwork(newL1Stub())
+			ecotone := int64(1708534800)
+			forks := network.Spec.ChainParameters.Hardforks
+			forks.Delta, forks.Ecotone = forks.Canyon, &ecotone
+
+			reconciler := &OptimismNetworkReconciler{Client: newFakeClient()}
+			generated, err := reconciler.generateRollupConfig(context.Background(), network, addresses, nil)
+			Expect(err).NotTo(HaveOccurred())
+			cfg, err := rollup.Parse([]byte(generated))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*cfg.RegolithTime).To(BeZero())
+			Expect(*cfg.DeltaTime).To(Equal(uint64(1699981200)))
+			Expect(*cfg.EcotoneTime).To(Equal(uint64(1708534800)))
+			Expect(cfg.FjordTime).To(BeNil())
+			Expect(cfg.GraniteTime).To(BeNil())
+			Expect(cfg.HoloceneTime).To(BeNil())
+
+			// A fork is never activated just because a later one is scheduled
+			forks.Delta = nil
+			_, err = reconciler.generateRollupConfig(context.Background(), network, addresses, nil)
+			Expect(err).To(MatchError(ContainSubstring("hardfork ecotone is scheduled but delta is not")))
+		})
+
+		It("Should activate every fork up to Holocene at genesis without a schedule", func() {
+			network := newNetwork(newL1Stub())
+			network.Spec.ChainParameters.Hardforks = nil
+
+			reconciler := &OptimismNetworkReconciler{Client: newFakeClient()}
+			generated, err := reconciler.generateRollupConfig(context.Background(), network, addresses, nil)
+			Expect(err).NotTo(HaveOccurred())
+			cfg, err := rollup.Parse([]byte(generated))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*cfg.RegolithTime).To(BeZero())
+			Expect(*cfg.HoloceneTime).To(BeZero())
+			Expect(cfg.IsthmusTime).To(BeNil())
+		})
+
+		It("Should require the L2 genesis hash for unregistered chains", func() {
+			network := newNetwork("http://127.0.0.1:0")
+			network.Spec.ChainParameters.L2GenesisHash = ""
+
+			reconciler := &OptimismNetworkReconciler{Client: newFakeClient()}
+			_, err := reconciler.generateRollupConfig(context.Background(), network, addresses, nil)
+			Expect(err).To(MatchError(ContainSubstring("l2GenesisHash")))
+		})
+
+		It("Should emit the validated registry config for registered chains", func() {
+			reconciler := &OptimismNetworkReconciler{Client: newFakeClient()}
+			registered, err := reconciler.registeredChain(11155420)
+			Expect(err).NotTo(HaveOccurred())
+
+			network := &optimismv1alpha1.OptimismNetwork{
+				Spec: optimismv1alpha1.OptimismNetworkSpec{ChainID: 11155420, L1ChainID: 11155111},
+			}
+			generated, err := reconciler.generateRollupConfig(context.Background(), network, addresses, registered)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg, err := rollup.Parse([]byte(generated))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.Genesis.L1.Number).To(Equal(uint64(4071408)))
+			Expect(*cfg.HoloceneTime).To(Equal(uint64(1732633200)))
+
+			network.Spec.L1ChainID = 1
+			_, err = reconciler.generateRollupConfig(context.Background(), network, addresses, registered)
+			Expect(err).To(MatchError(ContainSubstring("not chain 11155420 on L1 1")))
+		})
+
+		It("Should accept rollup configs with fields the operator does not read", func() {
+			// Legacy plasma fields and fields of newer op-node releases are left to op-node
+			cfg, err := rollup.Parse([]byte(`{"block_time": 2, "use_plasma": false,
+				"da_challenge_contract_address": "0x0000000000000000000000000000000000000000", "future_field": {}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.BlockTime).To(Equal(uint64(2)))
+
+			_, err = rollup.Parse([]byte(`{"block_time": "2s"}`))
+			Expect(err).To(MatchError(ContainSubstring("invalid rollup config")))
+		})
+
+		It("Should report an empty rollup config", func() {
+			_, err := rollup.Parse([]byte(" \n"))
+			Expect(err).To(MatchError("empty rollup config"))
+		})
+	})
+
+	Context("Configuration Sources", func() {
+		ctx := context.Background()
+
+		var sepoliaRollup string
+		BeforeEach(func() {
+			registry, err := superchain.Embedded()
+			Expect(err).NotTo(HaveOccurred())
+			chain, _ := registry.ChainByID(11155420)
+			sepoliaRollup = string(chain.RollupConfig)
+		})
+
+		newNetwork := func(rollupConfig, genesis *optimismv1alpha1.ConfigSource) *optimismv1alpha1.OptimismNetwork {
+			return &optimismv1alpha1.OptimismNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "op-sepolia", Namespace: "default", UID: "network-uid"},
+				Spec: optimismv1alpha1.OptimismNetworkSpec{
+					ChainID:      11155420,
+					L1ChainID:    11155111,
+					L1RpcUrl:     "http://127.0.0.1:0",
+					RollupConfig: rollupConfig,
+					L2Genesis:    genesis,
+				},
+			}
+		}
+
+		newReconciler := func(objs ...client.Object) *OptimismNetworkReconciler {
+			c := newFakeClient(objs...)
+			return &OptimismNetworkReconciler{Client: c, Scheme: c.Scheme()}
+		}
+
+		It("Should materialize inline content into the managed ConfigMaps", func() {
+			network := newNetwork(
+				&optimismv1alpha1.ConfigSource{Inline: sepoliaRollup},
+				&optimismv1alpha1.ConfigSource{Inline: `{"config": {"chainId": 11155420}}`},
+			)
+			reconciler := newReconciler()
+			Expect(reconciler.reconcileConfigMaps(ctx, network, &optimismv1alpha1.NetworkContractAddresses{})).To(Succeed())
+
+			var rollupConfigMap, genesisConfigMap corev1.ConfigMap
+			Expect(reconciler.Get(ctx, client.ObjectKey{Name: "op-sepolia-rollup-config", Namespace: "default"}, &rollupConfigMap)).To(Succeed())
+			Expect(rollupConfigMap.Data[resources.RollupConfigKey]).To(Equal(sepoliaRollup))
+			Expect(reconciler.Get(ctx, client.ObjectKey{Name: "op-sepolia-genesis", Namespace: "default"}, &genesisConfigMap)).To(Succeed())
+			Expect(genesisConfigMap.Data[resources.GenesisKey]).To(ContainSubstring("11155420"))
+		})
+
+		It("Should reference the genesis op-geth bundles for a registered chain", func() {
+			network := newNetwork(nil, &optimismv1alpha1.ConfigSource{AutoDiscover: true})
+			reconciler := newReconciler()
+			Expect(reconciler.reconcileConfigMaps(ctx, network, &optimismv1alpha1.NetworkContractAddresses{})).To(Succeed())
+			Expect(network.Status.NetworkInfo.OpGethNetwork).To(Equal("op-sepolia"))
+
+			// No genesis is written; OpNodes run op-geth with --op-network instead
+			var genesisConfigMap corev1.ConfigMap
+			err := reconciler.Get(ctx, client.ObjectKey{Name: "op-sepolia-genesis", Namespace: "default"}, &genesisConfigMap)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			_, ok := resources.GenesisSelector(network)
+			Expect(ok).To(BeFalse())
+
+			// Switching to an explicit genesis clears the reference
+			network.Spec.L2Genesis = &optimismv1alpha1.ConfigSource{Inline: `{"config": {"chainId": 11155420}}`}
+			Expect(reconciler.reconcileConfigMaps(ctx, network, &optimismv1alpha1.NetworkContractAddresses{})).To(Succeed())
+			Expect(network.Status.NetworkInfo.OpGethNetwork).To(BeEmpty())
+		})
+
+		It("Should fail auto-discovery of the genesis of a registered chain without a reference", func() {
+			dir := GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(dir, superchain.ChainListFile), []byte(`[
+				{"name": "Devnet", "identifier": "sepolia/devnet", "chainId": 901, "parent": {"type": "L2", "chain": "sepolia"}}
+			]`), 0o600)).To(Succeed())
+			registry, err := superchain.NewRegistry(dir)
+			Expect(err).NotTo(HaveOccurred())
+
+			network := newNetwork(nil, &optimismv1alpha1.ConfigSource{AutoDiscover: true})
+			network.Spec.ChainID = 901
+			reconciler := newReconciler()
+			reconciler.SuperchainRegistry = registry
+			err = reconciler.reconcileConfigMaps(ctx, network, &optimismv1alpha1.NetworkContractAddresses{})
+			Expect(err).To(MatchError(ContainSubstring("registered chain 901 (sepolia/devnet) has no genesis or genesis reference")))
+		})
+
+		It("Should fail auto-discovery of the genesis of an unregistered chain", func() {
+			network := newNetwork(nil, &optimismv1alpha1.ConfigSource{AutoDiscover: true})
+			network.Spec.ChainID = 901
+			reconciler := newReconciler()
+			err := reconciler.reconcileConfigMaps(ctx, network, &optimismv1alpha1.NetworkContractAddresses{})
+			Expect(err).To(MatchError(ContainSubstring("L2 genesis of unregistered chain 901 cannot be auto-discovered")))
+
+			var genesisConfigMap corev1.ConfigMap
+			err = reconciler.Get(ctx, client.ObjectKey{Name: "op-sepolia-genesis", Namespace: "default"}, &genesisConfigMap)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("Should reject an inline rollup config op-node would refuse", func() {
+			network := newNetwork(&optimismv1alpha1.ConfigSource{Inline: `{"block_time": 0}`}, nil)
+			err := newReconciler().reconcileConfigMaps(ctx, network, &optimismv1alpha1.NetworkContractAddresses{})
+			Expect(err).To(MatchError(ContainSubstring("block_time cannot be 0")))
+		})
+
+		It("Should validate a referenced ConfigMap without copying it", func() {
+			userConfigMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "chain-config", Namespace: "default"},
+				Data:       map[string]string{"rollup.json": sepoliaRollup},
+			}
+			source := &optimismv1alpha1.ConfigSource{ConfigMapRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "chain-config"},
+				Key:                  "rollup.json",
+			}}
+			reconciler := newReconciler(userConfigMap)
+			Expect(reconciler.reconcileConfigMaps(ctx, newNetwork(source, nil), &optimismv1alpha1.NetworkContractAddresses{})).To(Succeed())
+
+			var managed corev1.ConfigMap
+			err := reconciler.Get(ctx, client.ObjectKey{Name: "op-sepolia-rollup-config", Namespace: "default"}, &managed)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			source.ConfigMapRef.Key = "missing.json"
+			err = reconciler.reconcileConfigMaps(ctx, newNetwork(source, nil), &optimismv1alpha1.NetworkContractAddresses{})
+			Expect(err).To(MatchError(ContainSubstring(`has no key "missing.json"`)))
+		})
+
+		It("Should accept a referenced rollup config with legacy op-node fields", func() {
+			userConfigMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "chain-config", Namespace: "default"},
+				Data: map[string]string{
+					"rollup.json": strings.Replace(sepoliaRollup, "{", `{"use_plasma": false, "da_challenge_contract_address": null,`, 1),
+					"empty.json":  "",
+				},
+			}
+			source := &optimismv1alpha1.ConfigSource{ConfigMapRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "chain-config"},
+				Key:                  "rollup.json",
+			}}
+			reconciler := newReconciler(userConfigMap)
+			Expect(reconciler.reconcileConfigMaps(ctx, newNetwork(source, nil), &optimismv1alpha1.NetworkContractAddresses{})).To(Succeed())
+
+			source.ConfigMapRef.Key = "empty.json"
+			err := reconciler.reconcileConfigMaps(ctx, newNetwork(source, nil), &optimismv1alpha1.NetworkContractAddresses{})
+			Expect(err).To(MatchError(ContainSubstring("empty rollup config")))
+		})
+	})
+
+	Context("L1 Endpoint Failover", func() {
+		// newL1Stub serves an L1 RPC endpoint with the given chain ID and head and returns its URL
+		newL1Stub := func(chainID, head int64) string {
+			server := newJSONRPCStub(map[string]interface{}{
+				"eth_chainId":     hexutil.EncodeBig(big.NewInt(chainID)),
+				"eth_blockNumber": hexutil.EncodeUint64(uint64(head)),
+			})
+			DeferCleanup(server.Close)
+			return server.URL
+		}
+
+		newNetwork := func(endpoints ...optimismv1alpha1.L1RpcEndpoint) *optimismv1alpha1.OptimismNetwork {
+			return &optimismv1alpha1.OptimismNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+				Spec: optimismv1alpha1.OptimismNetworkSpec{
+					ChainID:        901,
+					L1ChainID:      900,
+					L1RpcTimeout:   2 * time.Second,
+					L1RpcEndpoints: endpoints,
+				},
+			}
+		}
+
+		It("Should record the health of every endpoint and select the preferred healthy one", func() {
+			network := newNetwork(
+				optimismv1alpha1.L1RpcEndpoint{Name: "primary", URL: newL1Stub(900, 100), Priority: 0},
+				optimismv1alpha1.L1RpcEndpoint{Name: "stale", URL: newL1Stub(900, 90), Priority: 0},
+				optimismv1alpha1.L1RpcEndpoint{Name: "wrong-chain", URL: newL1Stub(1, 100), Priority: 0},
+				optimismv1alpha1.L1RpcEndpoint{Name: "backup", URL: newL1Stub(900, 99), Priority: 10},
+			)
+
+			reconciler := &OptimismNetworkReconciler{}
+			Expect(reconciler.testL1Connectivity(context.Background(), network)).To(Succeed())
+
+			statuses := map[string]optimismv1alpha1.L1EndpointStatus{}
+			for _, status := range network.Status.L1Endpoints {
+				statuses[status.Name] = status
+			}
+			Expect(statuses).To(HaveLen(4))
+			Expect(statuses["primary"].Healthy).To(BeTrue())
+			Expect(statuses["backup"].Healthy).To(BeTrue())
+			Expect(statuses["stale"].Healthy).To(BeFalse())
+			Expect(statuses["stale"].Message).To(ContainSubstring("trails the highest L1 head 100"))
+			Expect(statuses["wrong-chain"].Healthy).To(BeFalse())
+			Expect(statuses["wrong-chain"].Message).To(ContainSubstring("L1 chain ID mismatch"))
+
+			Expect(network.Status.ActiveL1Endpoint).To(Equal("primary"))
+			Expect(network.Status.L1.Reachable).To(BeTrue())
+			Expect(network.Status.L1.HeadBlock).To(Equal(int64(100)))
+			Expect(optimismv1alpha1.ActiveL1RpcEndpoint(network).URL).To(Equal(network.Spec.L1RpcEndpoints[0].URL))
+		})
+
+		It("Should switch endpoints only after the hysteresis window", func() {
+			network := newNetwork(
+				optimismv1alpha1.L1RpcEndpoint{Name: "primary", URL: newL1Stub(900, 100), Priority: 0},
+				optimismv1alpha1.L1RpcEndpoint{Name: "backup", URL: newL1Stub(900, 100), Priority: 10},
+			)
+			network.Status.ActiveL1Endpoint = "backup"
+
+			reconciler := &OptimismNetworkReconciler{}
+			Expect(reconciler.testL1Connectivity(context.Background(), network)).To(Succeed())
+			Expect(network.Status.ActiveL1Endpoint).To(Equal("backup"))
+			Expect(network.Status.PendingL1Endpoint).To(Equal("primary"))
+			Expect(network.Status.PendingL1EndpointSince).NotTo(BeNil())
+			Expect(l1RequeueInterval(network)).To(BeNumerically("<=", 30*time.Second))
+			Expect(l1ConnectedMessage(network)).To(ContainSubstring("switching to primary"))
+
+			// Still the best once the window has passed
+			network.Status.PendingL1EndpointSince = &metav1.Time{Time: time.Now().Add(-3 * time.Minute)}
+			Expect(reconciler.testL1Connectivity(context.Background(), network)).To(Succeed())
+			Expect(network.Status.ActiveL1Endpoint).To(Equal("primary"))
+			Expect(network.Status.PendingL1Endpoint).To(BeEmpty())
+			Expect(network.Status.PendingL1EndpointSince).To(BeNil())
+		})
+
+		It("Should keep the active endpoint and fail when no endpoint is healthy", func() {
+			network := newNetwork(
+				optimismv1alpha1.L1RpcEndpoint{Name: "primary", URL: newL1Stub(1, 100)},
+				optimismv1alpha1.L1RpcEndpoint{Name: "backup", URL: "http://127.0.0.1:1"},
+			)
+			network.Status.ActiveL1Endpoint = "backup"
+
+			reconciler := &OptimismNetworkReconciler{}
+			err := reconciler.testL1Connectivity(context.Background(), network)
+			Expect(err).To(MatchError(SatisfyAll(
+				ContainSubstring("no healthy L1 RPC endpoint"),
+				ContainSubstring("primary: L1 chain ID mismatch"),
+				ContainSubstring("backup: failed to get L1 chain ID"),
+			)))
+			Expect(network.Status.ActiveL1Endpoint).To(Equal("backup"))
+			Expect(network.Status.L1.Reachable).To(BeFalse())
+		})
+
+		It("Should render the active endpoint into the component arguments", func() {
+			network := newNetwork(
+				optimismv1alpha1.L1RpcEndpoint{Name: "primary", URL: "https://primary.example.com"},
+				optimismv1alpha1.L1RpcEndpoint{Name: "backup", URL: "https://backup.example.com", Priority: 10},
+			)
+			network.Spec.L1RpcUrl = "https://default.example.com"
+			opNode := &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-replica", Namespace: "default"},
+				Spec:       optimismv1alpha1.OpNodeSpec{NodeType: "replica"},
+			}
+			l1Arg := func() string {
+				for _, arg := range containerArgs(&resources.CreateOpNodeStatefulSet(opNode, network).Spec.Template.Spec, "op-node") {
+					if strings.HasPrefix(arg, "--l1=") {
+						return arg
+					}
+				}
+				return ""
+			}
+
+			// Before the first probe the preferred endpoint applies, l1RpcUrl winning ties
+			Expect(l1Arg()).To(Equal("--l1=https://default.example.com"))
+
+			network.Status.ActiveL1Endpoint = "backup"
+			Expect(l1Arg()).To(Equal("--l1=https://backup.example.com"))
+
+			// An endpoint that was removed from the spec falls back to the preferred one
+			network.Status.ActiveL1Endpoint = "retired"
+			Expect(l1Arg()).To(Equal("--l1=https://default.example.com"))
+		})
+
+		It("Should reject invalid endpoints and failover settings", func() {
+			network := newNetwork(
+				optimismv1alpha1.L1RpcEndpoint{Name: "default", URL: "https://a.example.com"},
+				optimismv1alpha1.L1RpcEndpoint{Name: "backup", URL: "ftp://b.example.com"},
+				optimismv1alpha1.L1RpcEndpoint{Name: "backup", URL: "https://c.example.com"},
+			)
+			network.Spec.L1Failover = &optimismv1alpha1.L1FailoverConfig{ProbeInterval: "often", HysteresisWindow: "-1m"}
+			err := (&OptimismNetworkReconciler{}).validateConfiguration(network)
+			Expect(err).To(MatchError(SatisfyAll(
+				ContainSubstring("spec.l1RpcEndpoints[0].name"),
+				ContainSubstring("spec.l1RpcEndpoints[1].url"),
+				ContainSubstring(`spec.l1RpcEndpoints[2].name: Duplicate value: "backup"`),
+				ContainSubstring("spec.l1Failover.probeInterval"),
+				ContainSubstring("spec.l1Failover.hysteresisWindow"),
+			)))
+
+			network.Spec.L1RpcEndpoints = nil
+			network.Spec.L1Failover = nil
+			Expect((&OptimismNetworkReconciler{}).validateConfiguration(network)).To(MatchError(ContainSubstring("l1RpcUrl, l1RpcUrlSecretRef or l1RpcEndpoints is required")))
+		})
+	})
+
+	Context("L1 URLs from Secrets", func() {
+		newSecret := func(data map[string]string) *corev1.Secret {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "l1-urls", Namespace: "default"},
+				Data:       map[string][]byte{},
+			}
+			for key, value := range data {
+				secret.Data[key] = []byte(value)
+			}
+			return secret
+		}
+		secretRef := func(key string) *corev1.SecretKeySelector {
+			return &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "l1-urls"}, Key: key}
+		}
+
+		newNetwork := func() *optimismv1alpha1.OptimismNetwork {
+			return &optimismv1alpha1.OptimismNetwork{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet", Namespace: "default"},
+				Spec: optimismv1alpha1.OptimismNetworkSpec{
+					ChainID:              901,
+					L1ChainID:            900,
+					L1RpcTimeout:         2 * time.Second,
+					L1RpcUrlSecretRef:    secretRef("rpc"),
+					L1BeaconUrlSecretRef: secretRef("beacon"),
+				},
+			}
+		}
+
+		It("Should probe endpoints at the URLs read from their Secrets", func() {
+			server := newJSONRPCStub(map[string]interface{}{
+				"eth_chainId":     hexutil.EncodeBig(big.NewInt(900)),
+				"eth_blockNumber": hexutil.EncodeUint64(100),
+			})
+			DeferCleanup(server.Close)
+
+			network := newNetwork()
+			network.Spec.L1RpcEndpoints = []optimismv1alpha1.L1RpcEndpoint{
+				{Name: "missing-key", URLSecretRef: secretRef("absent"), Priority: 10},
+			}
+			reconciler := &OptimismNetworkReconciler{Client: newFakeClient(newSecret(map[string]string{"rpc": server.URL + "\n"}))}
+			Expect(reconciler.testL1Connectivity(context.Background(), network)).To(Succeed())
+
+			statuses := map[string]optimismv1alpha1.L1EndpointStatus{}
+			for _, status := range network.Status.L1Endpoints {
+				statuses[status.Name] = status
+			}
+			Expect(statuses[optimismv1alpha1.DefaultL1EndpointName].Healthy).To(BeTrue())
+			Expect(statuses["missing-key"].Healthy).To(BeFalse())
+			Expect(statuses["missing-key"].Message).To(Equal(`key "absent" not found in secret l1-urls`))
+			Expect(network.Status.ActiveL1Endpoint).To(Equal(optimismv1alpha1.DefaultL1EndpointName))
+			Expect(network.Status.L1.HeadBlock).To(Equal(int64(100)))
+
+			url, err := activeL1RpcURL(context.Background(), reconciler.Client, network)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(url).To(Equal(server.URL))
+		})
+
+		It("Should keep URLs read from Secrets out of probe and discovery errors", func() {
+			const url = "http://127.0.0.1:1/v2/provider-api-key"
+			network := newNetwork()
+			network.Spec.ContractAddresses = &optimismv1alpha1.ContractAddressConfig{
+				SystemConfigAddr: "0x034edD2A225f7f429A63E0f1D2084B9E0A93b538",
+				DiscoveryMethod:  "system-config",
+			}
+			reconciler := &OptimismNetworkReconciler{
+				Client:           newFakeClient(newSecret(map[string]string{"rpc": url})),
+				DiscoveryService: discovery.NewContractDiscoveryService(time.Hour),
+			}
+
+			err := reconciler.testL1Connectivity(context.Background(), network)
+			Expect(err).To(MatchError(ContainSubstring("failed to get L1 chain ID")))
+			Expect(err.Error()).NotTo(ContainSubstring("provider-api-key"))
+
+			_, err = reconciler.discoverContractAddresses(context.Background(), network)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).NotTo(ContainSubstring("provider-api-key"))
+		})
+
+		It("Should pass the URLs to op-node through the environment", func() {
+			network := newNetwork()
+			opNode := &optimismv1alpha1.OpNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "devnet-replica", Namespace: "default"},
+				Spec:       optimismv1alpha1.OpNodeSpec{NodeType: "replica"},
+			}
+			podSpec := resources.CreateOpNodeStatefulSet(opNode, network).Spec.Template.Spec
+
+			for _, arg := range containerArgs(&podSpec, "op-node") {
+				Expect(arg).NotTo(HavePrefix("--l1="))
+				Expect(arg).NotTo(HavePrefix("--l1.beacon="))
+			}
+			var env []corev1.EnvVar
+			for _, container := range podSpec.Containers {
+				if container.Name == "op-node" {
+					env = container.Env
+				}
+			}
+			Expect(env).To(ContainElements(
+				corev1.EnvVar{Name: "OP_NODE_L1_ETH_RPC", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretRef("rpc")}},
+				corev1.EnvVar{Name: "OP_NODE_L1_BEACON", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretRef("beacon")}},
+			))
+		})
+
+		It("Should reject URLs given both literally and through a Secret", func() {
+			network := newNetwork()
+			network.Spec.L1RpcUrl = "https://sepolia.example.com"
+			network.Spec.L1BeaconUrlSecretRef.Key = ""
+			network.Spec.L1RpcEndpoints = []optimismv1alpha1.L1RpcEndpoint{{Name: "backup"}}
+			err := (&OptimismNetworkReconciler{}).validateConfiguration(network)
+			Expect(err).To(MatchError(SatisfyAll(
+				ContainSubstring("l1RpcUrl and l1RpcUrlSecretRef are mutually exclusive"),
+				ContainSubstring("spec.l1BeaconUrlSecretRef.key: Required value"),
+				ContainSubstring("spec.l1RpcEndpoints[0].url: Required value"),
+			)))
+		})
+	})
 })
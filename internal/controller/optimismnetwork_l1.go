@@ -0,0 +1,244 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+// resolveL1URL returns a URL given either literally or through a Secret in the namespace
+func resolveL1URL(ctx context.Context, c client.Reader, namespace, url string, secretRef *corev1.SecretKeySelector) (string, error) {
+	if secretRef == nil {
+		return url, nil
+	}
+
+	var secret corev1.Secret
+	key := k8stypes.NamespacedName{Name: secretRef.Name, Namespace: namespace}
+	if err := c.Get(ctx, key, &secret); err != nil {
+		return "", fmt.Errorf("failed to read URL from secret %s: %w", secretRef.Name, err)
+	}
+
+	raw, ok := secret.Data[secretRef.Key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in secret %s", secretRef.Key, secretRef.Name)
+	}
+	return strings.TrimSpace(string(raw)), nil
+}
+
+// activeL1RpcURL returns the URL of the L1 RPC endpoint the components of a network use
+func activeL1RpcURL(ctx context.Context, c client.Reader, network *optimismv1alpha1.OptimismNetwork) (string, error) {
+	endpoint := optimismv1alpha1.ActiveL1RpcEndpoint(network)
+	return resolveL1URL(ctx, c, network.Namespace, endpoint.URL, endpoint.URLSecretRef)
+}
+
+// redactL1URL hides a URL read from a Secret in an error quoting it, as those of the HTTP
+// transport do, before the error ends up in status conditions and logs
+func redactL1URL(err error, endpoint optimismv1alpha1.L1RpcEndpoint, url string) error {
+	if err == nil || endpoint.URLSecretRef == nil || url == "" || !strings.Contains(err.Error(), url) {
+		return err
+	}
+	return errors.New(strings.ReplaceAll(err.Error(), url, "<"+endpoint.Name+" L1 RPC URL>"))
+}
+
+// probeL1Endpoints probes every L1 RPC endpoint concurrently. An endpoint whose head trails
+// the highest head among the healthy endpoints by more than the allowed lag is stale.
+func probeL1Endpoints(ctx context.Context, c client.Reader, network *optimismv1alpha1.OptimismNetwork, endpoints []optimismv1alpha1.L1RpcEndpoint) []optimismv1alpha1.L1EndpointStatus {
+	timeout := 10 * time.Second
+	if network.Spec.L1RpcTimeout != 0 {
+		timeout = network.Spec.L1RpcTimeout
+	}
+
+	statuses := make([]optimismv1alpha1.L1EndpointStatus, len(endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			url, err := resolveL1URL(ctx, c, network.Namespace, endpoint.URL, endpoint.URLSecretRef)
+			if err != nil {
+				statuses[i] = optimismv1alpha1.L1EndpointStatus{Name: endpoint.Name, Message: err.Error(), LastChecked: metav1.Now()}
+				return
+			}
+			statuses[i] = probeL1Endpoint(ctx, endpoint, url, network.Spec.L1ChainID, timeout)
+		}()
+	}
+	wg.Wait()
+
+	var highestHead int64
+	for _, status := range statuses {
+		if status.Healthy && status.HeadBlock > highestHead {
+			highestHead = status.HeadBlock
+		}
+	}
+	maxLag := resources.L1MaxHeadLag(network)
+	for i := range statuses {
+		if statuses[i].Healthy && highestHead-statuses[i].HeadBlock > maxLag {
+			statuses[i].Healthy = false
+			statuses[i].Message = fmt.Sprintf("head %d trails the highest L1 head %d by more than %d blocks",
+				statuses[i].HeadBlock, highestHead, maxLag)
+		}
+	}
+	return statuses
+}
+
+// probeL1Endpoint measures the chain ID round trip of an L1 RPC endpoint at its resolved URL
+// and reads its head
+func probeL1Endpoint(ctx context.Context, endpoint optimismv1alpha1.L1RpcEndpoint, url string, l1ChainID int64, timeout time.Duration) optimismv1alpha1.L1EndpointStatus {
+	status := optimismv1alpha1.L1EndpointStatus{Name: endpoint.Name, LastChecked: metav1.Now()}
+	fail := func(format string, err error) optimismv1alpha1.L1EndpointStatus {
+		status.Message = fmt.Sprintf(format, redactL1URL(err, endpoint, url))
+		return status
+	}
+
+	connectCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, err := ethclient.DialContext(connectCtx, url)
+	if err != nil {
+		return fail("failed to connect to L1 RPC: %v", err)
+	}
+	defer client.Close()
+
+	// Use a shorter timeout for the actual RPC calls
+	callCtx, callCancel := context.WithTimeout(ctx, timeout/2)
+	defer callCancel()
+
+	start := time.Now()
+	chainID, err := client.ChainID(callCtx)
+	if err != nil {
+		return fail("failed to get L1 chain ID: %v", err)
+	}
+	status.LatencyMilliseconds = time.Since(start).Milliseconds()
+
+	if chainID.Int64() != l1ChainID {
+		status.Message = fmt.Sprintf("L1 chain ID mismatch: expected %d, got %d", l1ChainID, chainID.Int64())
+		return status
+	}
+
+	head, err := client.BlockNumber(callCtx)
+	if err != nil {
+		return fail("failed to get L1 head block: %v", err)
+	}
+	status.HeadBlock = int64(head)
+	status.Healthy = true
+	return status
+}
+
+// bestL1Endpoint returns the name of the healthy endpoint with the lowest priority value,
+// preferring lower latency among equal priorities, or "" when no endpoint is healthy
+func bestL1Endpoint(endpoints []optimismv1alpha1.L1RpcEndpoint, statuses []optimismv1alpha1.L1EndpointStatus) string {
+	best := -1
+	for i := range endpoints {
+		if !statuses[i].Healthy {
+			continue
+		}
+		if best < 0 || endpoints[i].Priority < endpoints[best].Priority ||
+			endpoints[i].Priority == endpoints[best].Priority && statuses[i].LatencyMilliseconds < statuses[best].LatencyMilliseconds {
+			best = i
+		}
+	}
+	if best < 0 {
+		return ""
+	}
+	return endpoints[best].Name
+}
+
+// selectL1Endpoint moves the active L1 endpoint towards the best one. Another endpoint only
+// becomes active after it has stayed the best one for the hysteresis window, so latency
+// jitter and short outages do not roll the component pods back and forth.
+func selectL1Endpoint(network *optimismv1alpha1.OptimismNetwork, endpoints []optimismv1alpha1.L1RpcEndpoint, best string, now time.Time) {
+	status := &network.Status
+	clearPending := func() {
+		status.PendingL1Endpoint = ""
+		status.PendingL1EndpointSince = nil
+	}
+
+	// Without a current selection, e.g. after the active endpoint was removed from the spec,
+	// the best endpoint applies straight away
+	if !slices.ContainsFunc(endpoints, func(e optimismv1alpha1.L1RpcEndpoint) bool { return e.Name == status.ActiveL1Endpoint }) {
+		status.ActiveL1Endpoint = optimismv1alpha1.ActiveL1RpcEndpoint(network).Name
+		if best != "" {
+			status.ActiveL1Endpoint = best
+		}
+		clearPending()
+		return
+	}
+
+	if best == "" || best == status.ActiveL1Endpoint {
+		clearPending()
+		return
+	}
+	if status.PendingL1Endpoint != best || status.PendingL1EndpointSince == nil {
+		status.PendingL1Endpoint = best
+		status.PendingL1EndpointSince = &metav1.Time{Time: now}
+	}
+	if now.Sub(status.PendingL1EndpointSince.Time) >= resources.L1HysteresisWindow(network) {
+		status.ActiveL1Endpoint = best
+		clearPending()
+	}
+}
+
+// l1RequeueInterval returns when the L1 endpoints of a network are probed next. A single
+// endpoint has nothing to fail over to and is re-checked along with the contract addresses.
+func l1RequeueInterval(network *optimismv1alpha1.OptimismNetwork) time.Duration {
+	if len(optimismv1alpha1.L1RpcEndpoints(network)) < 2 {
+		return time.Hour
+	}
+	interval := resources.L1ProbeInterval(network)
+	if since := network.Status.PendingL1EndpointSince; since != nil {
+		if remaining := time.Until(since.Add(resources.L1HysteresisWindow(network))); remaining > 0 && remaining < interval {
+			interval = remaining
+		}
+	}
+	return interval
+}
+
+// l1EndpointsError summarizes why no L1 RPC endpoint is healthy
+func l1EndpointsError(statuses []optimismv1alpha1.L1EndpointStatus) error {
+	if len(statuses) == 1 {
+		return fmt.Errorf("%s", statuses[0].Message)
+	}
+	reasons := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		reasons = append(reasons, status.Name+": "+status.Message)
+	}
+	return fmt.Errorf("no healthy L1 RPC endpoint: %s", strings.Join(reasons, "; "))
+}
+
+// l1ConnectedMessage describes the active L1 endpoint and a pending switch
+func l1ConnectedMessage(network *optimismv1alpha1.OptimismNetwork) string {
+	message := fmt.Sprintf("Using L1 RPC endpoint %s", network.Status.ActiveL1Endpoint)
+	if pending := network.Status.PendingL1Endpoint; pending != "" {
+		message += fmt.Sprintf("; switching to %s once it stays the best endpoint for %s", pending, resources.L1HysteresisWindow(network))
+	}
+	return message
+}
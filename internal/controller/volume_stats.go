@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+)
+
+// VolumeUsage is the used and total space of a mounted volume, in bytes
+type VolumeUsage struct {
+	UsedBytes     uint64
+	CapacityBytes uint64
+}
+
+// VolumeStatsProvider reports the usage of the PVC-backed volumes mounted on a node, by PVC
+type VolumeStatsProvider interface {
+	VolumeUsage(ctx context.Context, nodeName string) (map[types.NamespacedName]VolumeUsage, error)
+}
+
+// kubeletVolumeStats reads volume usage from the kubelet summary API through the API server node proxy
+type kubeletVolumeStats struct {
+	client rest.Interface
+}
+
+// NewKubeletVolumeStats returns a VolumeStatsProvider backed by the kubelet summary API.
+// client is a core/v1 REST client, e.g. from kubernetes.Clientset.CoreV1().RESTClient().
+func NewKubeletVolumeStats(client rest.Interface) VolumeStatsProvider {
+	return &kubeletVolumeStats{client: client}
+}
+
+// statsSummary is the subset of the kubelet /stats/summary response describing pod volumes
+type statsSummary struct {
+	Pods []struct {
+		Volumes []struct {
+			UsedBytes     *uint64 `json:"usedBytes"`
+			CapacityBytes *uint64 `json:"capacityBytes"`
+			PVCRef        *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+// VolumeUsage implements VolumeStatsProvider
+func (k *kubeletVolumeStats) VolumeUsage(ctx context.Context, nodeName string) (map[types.NamespacedName]VolumeUsage, error) {
+	raw, err := k.client.Get().Resource("nodes").Name(nodeName).SubResource("proxy", "stats", "summary").DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kubelet stats of node %s: %w", nodeName, err)
+	}
+	return parseVolumeStats(raw)
+}
+
+// parseVolumeStats extracts the usage of PVC-backed volumes from a kubelet stats summary
+func parseVolumeStats(raw []byte) (map[types.NamespacedName]VolumeUsage, error) {
+	var summary statsSummary
+	if err := json.Unmarshal(raw, &summary); err != nil {
+		return nil, fmt.Errorf("failed to decode kubelet stats summary: %w", err)
+	}
+
+	usage := map[types.NamespacedName]VolumeUsage{}
+	for _, pod := range summary.Pods {
+		for _, volume := range pod.Volumes {
+			if volume.PVCRef == nil || volume.UsedBytes == nil || volume.CapacityBytes == nil {
+				continue
+			}
+			usage[types.NamespacedName{Namespace: volume.PVCRef.Namespace, Name: volume.PVCRef.Name}] = VolumeUsage{
+				UsedBytes:     *volume.UsedBytes,
+				CapacityBytes: *volume.CapacityBytes,
+			}
+		}
+	}
+	return usage, nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var opbatcherlog = logf.Log.WithName("opbatcher-resource")
+
+// SetupOpBatcherWebhookWithManager registers the webhook for OpBatcher in the manager.
+func SetupOpBatcherWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&optimismv1alpha1.OpBatcher{}).
+		WithValidator(&OpBatcherCustomValidator{}).
+		WithDefaulter(&OpBatcherCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-optimism-optimism-io-v1alpha1-opbatcher,mutating=true,failurePolicy=fail,sideEffects=None,groups=optimism.optimism.io,resources=opbatchers,verbs=create;update,versions=v1alpha1,name=mopbatcher-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// OpBatcherCustomDefaulter sets default values on OpBatcher resources when they
+// are created or updated.
+type OpBatcherCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &OpBatcherCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind OpBatcher.
+func (d *OpBatcherCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	opBatcher, ok := obj.(*optimismv1alpha1.OpBatcher)
+	if !ok {
+		return fmt.Errorf("expected an OpBatcher object but got %T", obj)
+	}
+	opbatcherlog.Info("Defaulting for OpBatcher", "name", opBatcher.GetName())
+
+	if rpc := opBatcher.Spec.RPC; rpc != nil {
+		rpc.Host = defaultString(rpc.Host, resources.DefaultListenHost)
+		rpc.Port = defaultInt32(rpc.Port, resources.DefaultOpBatcherRPCPort)
+	}
+	if metrics := opBatcher.Spec.Metrics; metrics != nil {
+		metrics.Port = defaultInt32(metrics.Port, resources.DefaultMetricsPort)
+	}
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-optimism-optimism-io-v1alpha1-opbatcher,mutating=false,failurePolicy=fail,sideEffects=None,groups=optimism.optimism.io,resources=opbatchers,verbs=create;update,versions=v1alpha1,name=vopbatcher-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// OpBatcherCustomValidator validates OpBatcher resources when they are created or updated.
+type OpBatcherCustomValidator struct{}
+
+var _ webhook.CustomValidator = &OpBatcherCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type OpBatcher.
+func (v *OpBatcherCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	opBatcher, ok := obj.(*optimismv1alpha1.OpBatcher)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpBatcher object but got %T", obj)
+	}
+	opbatcherlog.Info("Validation for OpBatcher upon creation", "name", opBatcher.GetName())
+
+	return nil, invalid("OpBatcher", opBatcher.Name, validateOpBatcherSpec(opBatcher))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type OpBatcher.
+func (v *OpBatcherCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	opBatcher, ok := newObj.(*optimismv1alpha1.OpBatcher)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpBatcher object for the newObj but got %T", newObj)
+	}
+	oldOpBatcher, ok := oldObj.(*optimismv1alpha1.OpBatcher)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpBatcher object for the oldObj but got %T", oldObj)
+	}
+	opbatcherlog.Info("Validation for OpBatcher upon update", "name", opBatcher.GetName())
+
+	var warnings admission.Warnings
+	allErrs := validateOpBatcherSpec(opBatcher)
+	allErrs = appendError(allErrs, validateImmutable(opBatcher, field.NewPath("spec", "optimismNetworkRef"),
+		oldOpBatcher.Spec.OptimismNetworkRef, opBatcher.Spec.OptimismNetworkRef, &warnings))
+
+	return warnings, invalid("OpBatcher", opBatcher.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type OpBatcher.
+func (v *OpBatcherCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateOpBatcherSpec mirrors the controller's configuration checks
+func validateOpBatcherSpec(opBatcher *optimismv1alpha1.OpBatcher) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if opBatcher.Spec.OptimismNetworkRef.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("optimismNetworkRef", "name"), ""))
+	}
+	if opBatcher.Spec.SequencerRef.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("sequencerRef", "name"), ""))
+	}
+	allErrs = append(allErrs, validateFundedKey(specPath.Child("privateKey"), opBatcher.Spec.PrivateKey)...)
+
+	if da := opBatcher.Spec.DataAvailability; da != nil && da.Type != "" {
+		switch da.Type {
+		case "calldata", "blobs", "auto":
+		default:
+			allErrs = append(allErrs, field.NotSupported(specPath.Child("dataAvailability", "type"), da.Type, []string{"calldata", "blobs", "auto"}))
+		}
+	}
+
+	if batching := opBatcher.Spec.Batching; batching != nil {
+		batchingPath := specPath.Child("batching")
+		if batching.MaxChannelDuration < 0 {
+			allErrs = append(allErrs, field.Invalid(batchingPath.Child("maxChannelDuration"), batching.MaxChannelDuration, "must not be negative"))
+		}
+		if batching.SubSafetyMargin < 0 {
+			allErrs = append(allErrs, field.Invalid(batchingPath.Child("subSafetyMargin"), batching.SubSafetyMargin, "must not be negative"))
+		}
+		allErrs = appendError(allErrs, validateDuration(batchingPath.Child("pollInterval"), batching.PollInterval))
+	}
+
+	if throttling := opBatcher.Spec.Throttling; throttling != nil {
+		throttlingPath := specPath.Child("throttling")
+		for _, limit := range []struct {
+			name  string
+			value int64
+		}{
+			{"threshold", throttling.Threshold},
+			{"txSize", throttling.TxSize},
+			{"blockSize", throttling.BlockSize},
+		} {
+			if limit.value < 0 {
+				allErrs = append(allErrs, field.Invalid(throttlingPath.Child(limit.name), limit.value, "must not be negative"))
+			}
+		}
+	}
+
+	if rpc := opBatcher.Spec.RPC; rpc != nil {
+		allErrs = appendError(allErrs, validatePort(specPath.Child("rpc", "port"), rpc.Port))
+	}
+	if metrics := opBatcher.Spec.Metrics; metrics != nil {
+		allErrs = appendError(allErrs, validatePort(specPath.Child("metrics", "port"), metrics.Port))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+var _ = Describe("OpBatcher Webhook", func() {
+	var (
+		obj       *optimismv1alpha1.OpBatcher
+		oldObj    *optimismv1alpha1.OpBatcher
+		validator OpBatcherCustomValidator
+		defaulter OpBatcherCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &optimismv1alpha1.OpBatcher{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-batcher", Namespace: "default"},
+			Spec: optimismv1alpha1.OpBatcherSpec{
+				OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "test-network"},
+				SequencerRef:       optimismv1alpha1.SequencerReference{Name: "test-sequencer"},
+				PrivateKey: optimismv1alpha1.SecretKeyRef{
+					SecretRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "batcher-key"},
+						Key:                  "private-key",
+					},
+				},
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = OpBatcherCustomValidator{}
+		defaulter = OpBatcherCustomDefaulter{}
+	})
+
+	Context("When creating OpBatcher under Defaulting Webhook", func() {
+		It("Should default the RPC and metrics ports", func() {
+			obj.Spec.RPC = &optimismv1alpha1.RPCConfig{Enabled: true}
+			obj.Spec.Metrics = &optimismv1alpha1.MetricsConfig{Enabled: true}
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.RPC.Host).To(Equal(resources.DefaultListenHost))
+			Expect(obj.Spec.RPC.Port).To(Equal(resources.DefaultOpBatcherRPCPort))
+			Expect(obj.Spec.Metrics.Port).To(Equal(resources.DefaultMetricsPort))
+		})
+	})
+
+	Context("When creating or updating OpBatcher under Validating Webhook", func() {
+		It("Should admit a valid batcher", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny a generated private key", func() {
+			obj.Spec.PrivateKey = optimismv1alpha1.SecretKeyRef{Generate: true}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.privateKey.secretRef")))
+			Expect(err).To(MatchError(ContainSubstring("spec.privateKey.generate")))
+		})
+
+		It("Should deny an unknown data availability type", func() {
+			obj.Spec.DataAvailability = &optimismv1alpha1.DataAvailabilityConfig{Type: "plasma"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.dataAvailability.type")))
+		})
+
+		It("Should deny an invalid poll interval", func() {
+			obj.Spec.Batching = &optimismv1alpha1.BatchingConfig{PollInterval: "often"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.batching.pollInterval")))
+		})
+
+		It("Should deny moving the batcher to another network", func() {
+			obj.Spec.OptimismNetworkRef.Name = "other-network"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.optimismNetworkRef: Forbidden")))
+		})
+	})
+})
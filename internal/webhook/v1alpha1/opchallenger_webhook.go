@@ -0,0 +1,199 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var opchallengerlog = logf.Log.WithName("opchallenger-resource")
+
+// SetupOpChallengerWebhookWithManager registers the webhook for OpChallenger in the manager.
+func SetupOpChallengerWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&optimismv1alpha1.OpChallenger{}).
+		WithValidator(&OpChallengerCustomValidator{}).
+		WithDefaulter(&OpChallengerCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-optimism-optimism-io-v1alpha1-opchallenger,mutating=true,failurePolicy=fail,sideEffects=None,groups=optimism.optimism.io,resources=opchallengers,verbs=create;update,versions=v1alpha1,name=mopchallenger-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// OpChallengerCustomDefaulter sets default values on OpChallenger resources
+// when they are created or updated.
+type OpChallengerCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &OpChallengerCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind OpChallenger.
+func (d *OpChallengerCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	opChallenger, ok := obj.(*optimismv1alpha1.OpChallenger)
+	if !ok {
+		return fmt.Errorf("expected an OpChallenger object but got %T", obj)
+	}
+	opchallengerlog.Info("Defaulting for OpChallenger", "name", opChallenger.GetName())
+
+	// Only cannon ships in the op-challenger image; asterisc paths must be explicit
+	if cannon := opChallenger.Spec.Cannon; cannon != nil {
+		cannon.BinaryPath = defaultString(cannon.BinaryPath, resources.DefaultCannonBinaryPath)
+		cannon.ServerPath = defaultString(cannon.ServerPath, resources.DefaultCannonServerPath)
+	}
+	if metrics := opChallenger.Spec.Metrics; metrics != nil {
+		metrics.Port = defaultInt32(metrics.Port, resources.DefaultMetricsPort)
+	}
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-optimism-optimism-io-v1alpha1-opchallenger,mutating=false,failurePolicy=fail,sideEffects=None,groups=optimism.optimism.io,resources=opchallengers,verbs=create;update,versions=v1alpha1,name=vopchallenger-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// OpChallengerCustomValidator validates OpChallenger resources when they are created or updated.
+type OpChallengerCustomValidator struct{}
+
+var _ webhook.CustomValidator = &OpChallengerCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type OpChallenger.
+func (v *OpChallengerCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	opChallenger, ok := obj.(*optimismv1alpha1.OpChallenger)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpChallenger object but got %T", obj)
+	}
+	opchallengerlog.Info("Validation for OpChallenger upon creation", "name", opChallenger.GetName())
+
+	return nil, invalid("OpChallenger", opChallenger.Name, validateOpChallengerSpec(opChallenger))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type OpChallenger.
+func (v *OpChallengerCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	opChallenger, ok := newObj.(*optimismv1alpha1.OpChallenger)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpChallenger object for the newObj but got %T", newObj)
+	}
+	oldOpChallenger, ok := oldObj.(*optimismv1alpha1.OpChallenger)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpChallenger object for the oldObj but got %T", oldObj)
+	}
+	opchallengerlog.Info("Validation for OpChallenger upon update", "name", opChallenger.GetName())
+
+	var warnings admission.Warnings
+	allErrs := validateOpChallengerSpec(opChallenger)
+	allErrs = appendError(allErrs, validateImmutable(opChallenger, field.NewPath("spec", "optimismNetworkRef"),
+		oldOpChallenger.Spec.OptimismNetworkRef, opChallenger.Spec.OptimismNetworkRef, &warnings))
+
+	return warnings, invalid("OpChallenger", opChallenger.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type OpChallenger.
+func (v *OpChallengerCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateOpChallengerSpec mirrors the controller's configuration checks
+func validateOpChallengerSpec(opChallenger *optimismv1alpha1.OpChallenger) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if opChallenger.Spec.OptimismNetworkRef.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("optimismNetworkRef", "name"), ""))
+	}
+	if opChallenger.Spec.RollupRPCRef.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("rollupRpcRef", "name"), ""))
+	}
+	allErrs = append(allErrs, validateFundedKey(specPath.Child("privateKey"), opChallenger.Spec.PrivateKey)...)
+
+	traceTypesPath := specPath.Child("traceTypes")
+	if len(opChallenger.Spec.TraceTypes) == 0 {
+		allErrs = append(allErrs, field.Required(traceTypesPath, "at least one trace type is required"))
+	}
+	seen := make(map[optimismv1alpha1.TraceType]bool)
+	for i, traceType := range opChallenger.Spec.TraceTypes {
+		switch traceType {
+		case optimismv1alpha1.TraceTypeCannon, optimismv1alpha1.TraceTypeAsterisc, optimismv1alpha1.TraceTypePermissioned:
+		default:
+			allErrs = append(allErrs, field.NotSupported(traceTypesPath.Index(i), traceType, []optimismv1alpha1.TraceType{
+				optimismv1alpha1.TraceTypeCannon, optimismv1alpha1.TraceTypeAsterisc, optimismv1alpha1.TraceTypePermissioned,
+			}))
+		}
+		if seen[traceType] {
+			allErrs = append(allErrs, field.Duplicate(traceTypesPath.Index(i), traceType))
+		}
+		seen[traceType] = true
+	}
+
+	if resources.OpChallengerUsesCannon(opChallenger) {
+		allErrs = append(allErrs, validateVMTraceConfig(specPath.Child("cannon"), opChallenger.Spec.Cannon, false)...)
+	}
+	if resources.OpChallengerUsesAsterisc(opChallenger) {
+		allErrs = append(allErrs, validateVMTraceConfig(specPath.Child("asterisc"), opChallenger.Spec.Asterisc, true)...)
+	}
+
+	if opChallenger.Spec.MaxConcurrency < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("maxConcurrency"), opChallenger.Spec.MaxConcurrency, "must not be negative"))
+	}
+	if storage := opChallenger.Spec.Storage; storage != nil && storage.Size.IsZero() {
+		allErrs = append(allErrs, field.Required(specPath.Child("storage", "size"), "storage size must be specified"))
+	}
+	if metrics := opChallenger.Spec.Metrics; metrics != nil {
+		allErrs = appendError(allErrs, validatePort(specPath.Child("metrics", "port"), metrics.Port))
+	}
+
+	return allErrs
+}
+
+// validateVMTraceConfig checks the prestate source and binaries of a fault proof VM
+func validateVMTraceConfig(path *field.Path, vmConfig *optimismv1alpha1.VMTraceConfig, requirePaths bool) field.ErrorList {
+	if vmConfig == nil || vmConfig.Prestate == nil {
+		return field.ErrorList{field.Required(path.Child("prestate"), "required by the configured trace types")}
+	}
+
+	var allErrs field.ErrorList
+	prestatePath := path.Child("prestate")
+	prestate := vmConfig.Prestate
+	switch {
+	case prestate.ConfigMapRef != nil && prestate.URL != "":
+		allErrs = append(allErrs, field.Invalid(prestatePath, "", "only one of configMapRef or url can be specified"))
+	case prestate.ConfigMapRef == nil && prestate.URL == "":
+		allErrs = append(allErrs, field.Required(prestatePath, "one of configMapRef or url must be specified"))
+	case prestate.ConfigMapRef != nil && (prestate.ConfigMapRef.Name == "" || prestate.ConfigMapRef.Key == ""):
+		allErrs = append(allErrs, field.Required(prestatePath.Child("configMapRef"), "name and key are required"))
+	case prestate.URL != "":
+		allErrs = appendError(allErrs, validateURL(prestatePath.Child("url"), prestate.URL, "http", "https"))
+	}
+
+	if requirePaths {
+		if vmConfig.BinaryPath == "" {
+			allErrs = append(allErrs, field.Required(path.Child("binaryPath"), "the VM is not bundled in the op-challenger image"))
+		}
+		if vmConfig.ServerPath == "" {
+			allErrs = append(allErrs, field.Required(path.Child("serverPath"), "the VM is not bundled in the op-challenger image"))
+		}
+	}
+
+	return allErrs
+}
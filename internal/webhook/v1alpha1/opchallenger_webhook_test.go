@@ -0,0 +1,104 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+var _ = Describe("OpChallenger Webhook", func() {
+	var (
+		obj       *optimismv1alpha1.OpChallenger
+		oldObj    *optimismv1alpha1.OpChallenger
+		validator OpChallengerCustomValidator
+		defaulter OpChallengerCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &optimismv1alpha1.OpChallenger{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-challenger", Namespace: "default"},
+			Spec: optimismv1alpha1.OpChallengerSpec{
+				OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "test-network"},
+				RollupRPCRef:       optimismv1alpha1.OpNodeReference{Name: "test-replica"},
+				PrivateKey: optimismv1alpha1.SecretKeyRef{
+					SecretRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "challenger-key"},
+						Key:                  "private-key",
+					},
+				},
+				TraceTypes: []optimismv1alpha1.TraceType{optimismv1alpha1.TraceTypeCannon},
+				Cannon: &optimismv1alpha1.VMTraceConfig{
+					Prestate: &optimismv1alpha1.PrestateSource{URL: "https://prestates.example.com"},
+				},
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = OpChallengerCustomValidator{}
+		defaulter = OpChallengerCustomDefaulter{}
+	})
+
+	Context("When creating OpChallenger under Defaulting Webhook", func() {
+		It("Should default the bundled cannon paths", func() {
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Cannon.BinaryPath).To(Equal(resources.DefaultCannonBinaryPath))
+			Expect(obj.Spec.Cannon.ServerPath).To(Equal(resources.DefaultCannonServerPath))
+		})
+	})
+
+	Context("When creating or updating OpChallenger under Validating Webhook", func() {
+		It("Should admit a valid challenger", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny duplicate trace types", func() {
+			obj.Spec.TraceTypes = append(obj.Spec.TraceTypes, optimismv1alpha1.TraceTypeCannon)
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.traceTypes[1]: Duplicate")))
+		})
+
+		It("Should deny asterisc without explicit binary paths", func() {
+			obj.Spec.TraceTypes = []optimismv1alpha1.TraceType{optimismv1alpha1.TraceTypeAsterisc}
+			obj.Spec.Asterisc = &optimismv1alpha1.VMTraceConfig{
+				Prestate: &optimismv1alpha1.PrestateSource{URL: "https://prestates.example.com"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.asterisc.binaryPath")))
+		})
+
+		It("Should deny a prestate with two sources", func() {
+			obj.Spec.Cannon.Prestate.ConfigMapRef = &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "prestate"},
+				Key:                  "prestate.bin.gz",
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.cannon.prestate")))
+		})
+
+		It("Should deny moving the challenger to another network", func() {
+			obj.Spec.OptimismNetworkRef.Name = "other-network"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.optimismNetworkRef: Forbidden")))
+		})
+	})
+})
@@ -0,0 +1,333 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var opnodelog = logf.Log.WithName("opnode-resource")
+
+// SetupOpNodeWebhookWithManager registers the webhook for OpNode in the manager.
+func SetupOpNodeWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&optimismv1alpha1.OpNode{}).
+		WithValidator(&OpNodeCustomValidator{}).
+		WithDefaulter(&OpNodeCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-optimism-optimism-io-v1alpha1-opnode,mutating=true,failurePolicy=fail,sideEffects=None,groups=optimism.optimism.io,resources=opnodes,verbs=create;update,versions=v1alpha1,name=mopnode-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// OpNodeCustomDefaulter sets default values on OpNode resources when they are
+// created or updated. Only sections the user opted into are filled in.
+type OpNodeCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &OpNodeCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind OpNode.
+func (d *OpNodeCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	opNode, ok := obj.(*optimismv1alpha1.OpNode)
+	if !ok {
+		return fmt.Errorf("expected an OpNode object but got %T", obj)
+	}
+	opnodelog.Info("Defaulting for OpNode", "name", opNode.GetName())
+
+	geth := &opNode.Spec.OpGeth
+	geth.DataDir = defaultString(geth.DataDir, resources.DefaultOpGethDataDir)
+	geth.SyncMode = defaultString(geth.SyncMode, resources.DefaultOpGethSyncMode)
+
+	if networking := geth.Networking; networking != nil {
+		if http := networking.HTTP; http != nil {
+			http.Host = defaultString(http.Host, resources.DefaultListenHost)
+			http.Port = defaultInt32(http.Port, resources.DefaultOpGethHTTPPort)
+		}
+		if ws := networking.WS; ws != nil {
+			ws.Host = defaultString(ws.Host, resources.DefaultListenHost)
+			ws.Port = defaultInt32(ws.Port, resources.DefaultOpGethWSPort)
+		}
+		if authRPC := networking.AuthRPC; authRPC != nil {
+			authRPC.Host = defaultString(authRPC.Host, resources.DefaultAuthRPCHost)
+			authRPC.Port = defaultInt32(authRPC.Port, resources.DefaultOpGethAuthRPCPort)
+		}
+		if p2p := networking.P2P; p2p != nil {
+			p2p.Port = defaultInt32(p2p.Port, resources.DefaultOpGethP2PPort)
+		}
+	}
+
+	if storage := geth.Storage; storage != nil && storage.AutoGrow != nil && storage.AutoGrow.Enabled {
+		storage.AutoGrow.ThresholdPercent = defaultInt32(storage.AutoGrow.ThresholdPercent, resources.DefaultAutoGrowThresholdPercent)
+		storage.AutoGrow.IncreasePercent = defaultInt32(storage.AutoGrow.IncreasePercent, resources.DefaultAutoGrowIncreasePercent)
+	}
+
+	if rpc := opNode.Spec.OpNode.RPC; rpc != nil {
+		rpc.Host = defaultString(rpc.Host, resources.DefaultListenHost)
+		rpc.Port = defaultInt32(rpc.Port, resources.DefaultOpNodeRPCPort)
+	}
+	if p2p := opNode.Spec.OpNode.P2P; p2p != nil {
+		p2p.ListenPort = defaultInt32(p2p.ListenPort, resources.DefaultOpNodeP2PPort)
+	}
+	if strategy := opNode.Spec.UpgradeStrategy; strategy != nil {
+		strategy.HealthTimeout = defaultString(strategy.HealthTimeout, resources.DefaultUpgradeHealthTimeout)
+	}
+	if backup := opNode.Spec.Backup; backup != nil && backup.Enabled {
+		backup.Interval = defaultString(backup.Interval, resources.DefaultBackupInterval)
+		backup.Retention = defaultInt32(backup.Retention, resources.DefaultBackupRetention)
+	}
+	if ha := opNode.Spec.HA; ha != nil && ha.Enabled {
+		ha.Members = defaultInt32(ha.Members, resources.DefaultConductorMembers)
+		ha.RaftPort = defaultInt32(ha.RaftPort, resources.DefaultConductorRaftPort)
+		ha.RPCPort = defaultInt32(ha.RPCPort, resources.DefaultConductorRPCPort)
+		if ha.HealthCheck == nil {
+			ha.HealthCheck = &optimismv1alpha1.ConductorHealthCheck{}
+		}
+		ha.HealthCheck.Interval = defaultInt32(ha.HealthCheck.Interval, resources.DefaultConductorHealthInterval)
+		ha.HealthCheck.UnsafeInterval = defaultInt32(ha.HealthCheck.UnsafeInterval, resources.DefaultConductorUnsafeInterval)
+		ha.HealthCheck.SafeInterval = defaultInt32(ha.HealthCheck.SafeInterval, resources.DefaultConductorSafeInterval)
+		ha.HealthCheck.MinPeerCount = defaultInt32(ha.HealthCheck.MinPeerCount, resources.DefaultConductorMinPeerCount)
+	}
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-optimism-optimism-io-v1alpha1-opnode,mutating=false,failurePolicy=fail,sideEffects=None,groups=optimism.optimism.io,resources=opnodes,verbs=create;update,versions=v1alpha1,name=vopnode-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// OpNodeCustomValidator validates OpNode resources when they are created or updated.
+type OpNodeCustomValidator struct{}
+
+var _ webhook.CustomValidator = &OpNodeCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type OpNode.
+func (v *OpNodeCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	opNode, ok := obj.(*optimismv1alpha1.OpNode)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpNode object but got %T", obj)
+	}
+	opnodelog.Info("Validation for OpNode upon creation", "name", opNode.GetName())
+
+	return opNodeWarnings(opNode), invalid("OpNode", opNode.Name, validateOpNodeSpec(opNode))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type OpNode.
+func (v *OpNodeCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	opNode, ok := newObj.(*optimismv1alpha1.OpNode)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpNode object for the newObj but got %T", newObj)
+	}
+	oldOpNode, ok := oldObj.(*optimismv1alpha1.OpNode)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpNode object for the oldObj but got %T", oldObj)
+	}
+	opnodelog.Info("Validation for OpNode upon update", "name", opNode.GetName())
+
+	specPath := field.NewPath("spec")
+	warnings := opNodeWarnings(opNode)
+	allErrs := validateOpNodeSpec(opNode)
+	allErrs = appendError(allErrs, validateImmutable(opNode, specPath.Child("nodeType"),
+		oldOpNode.Spec.NodeType, opNode.Spec.NodeType, &warnings))
+	allErrs = appendError(allErrs, validateImmutable(opNode, specPath.Child("optimismNetworkRef"),
+		oldOpNode.Spec.OptimismNetworkRef, opNode.Spec.OptimismNetworkRef, &warnings))
+
+	return warnings, invalid("OpNode", opNode.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type OpNode.
+func (v *OpNodeCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// opNodeWarnings flags settings that are accepted but have no effect
+func opNodeWarnings(opNode *optimismv1alpha1.OpNode) admission.Warnings {
+	var warnings admission.Warnings
+	if sequencer := opNode.Spec.OpNode.Sequencer; sequencer != nil && sequencer.MaxTxPerBlock != 0 {
+		warnings = append(warnings, "spec.opNode.sequencer.maxTxPerBlock has no op-node or op-geth setting and is ignored")
+	}
+	return warnings
+}
+
+// validateOpNodeSpec mirrors the controller's configuration checks so invalid
+// specs are rejected at admission instead of surfacing as an Error phase
+func validateOpNodeSpec(opNode *optimismv1alpha1.OpNode) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+	opNodePath := specPath.Child("opNode")
+	sequencer := opNode.Spec.OpNode.Sequencer
+
+	if opNode.Spec.OptimismNetworkRef.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("optimismNetworkRef", "name"), ""))
+	}
+
+	switch opNode.Spec.NodeType {
+	case "sequencer":
+		if sequencer == nil || !sequencer.Enabled {
+			allErrs = append(allErrs, field.Required(opNodePath.Child("sequencer", "enabled"), "sequencer nodes must enable the sequencer"))
+		}
+		if p2p := opNode.Spec.OpNode.P2P; p2p != nil && p2p.Discovery != nil && p2p.Discovery.Enabled {
+			allErrs = append(allErrs, field.Forbidden(opNodePath.Child("p2p", "discovery", "enabled"), "sequencer nodes must have P2P discovery disabled"))
+		}
+		if replicas := opNode.Spec.Replicas; replicas != nil && *replicas != 1 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("replicas"), *replicas, "sequencer nodes run a single replica"))
+		}
+	case "replica":
+		if sequencer != nil && sequencer.Enabled {
+			allErrs = append(allErrs, field.Forbidden(opNodePath.Child("sequencer", "enabled"), "replica nodes cannot run the sequencer"))
+		}
+	case "":
+		allErrs = append(allErrs, field.Required(specPath.Child("nodeType"), ""))
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("nodeType"), opNode.Spec.NodeType, []string{"sequencer", "replica"}))
+	}
+
+	if opNode.Spec.L2RpcUrl != "" {
+		allErrs = appendError(allErrs, validateURL(specPath.Child("l2RpcUrl"), opNode.Spec.L2RpcUrl, "http", "https"))
+	}
+	if sequencer != nil {
+		allErrs = appendError(allErrs, validateDuration(opNodePath.Child("sequencer", "blockTime"), sequencer.BlockTime))
+	}
+	if rpc := opNode.Spec.OpNode.RPC; rpc != nil {
+		allErrs = appendError(allErrs, validatePort(opNodePath.Child("rpc", "port"), rpc.Port))
+	}
+	if p2p := opNode.Spec.OpNode.P2P; p2p != nil {
+		allErrs = appendError(allErrs, validatePort(opNodePath.Child("p2p", "listenPort"), p2p.ListenPort))
+		if replicas := opNode.Spec.Replicas; replicas != nil && *replicas > 1 && p2p.PrivateKey != nil && p2p.PrivateKey.SecretRef != nil {
+			allErrs = append(allErrs, field.Forbidden(opNodePath.Child("p2p", "privateKey", "secretRef"),
+				"a user-provided P2P key cannot be shared by multiple replicas; use generate for per-pod keys"))
+		}
+	}
+
+	gethPath := specPath.Child("opGeth")
+	if storage := opNode.Spec.OpGeth.Storage; storage != nil && storage.Size.IsZero() {
+		allErrs = append(allErrs, field.Required(gethPath.Child("storage", "size"), "storage size must be specified"))
+	}
+	if storage := opNode.Spec.OpGeth.Storage; storage != nil && storage.Snapshot != nil {
+		allErrs = append(allErrs, validateSnapshotSource(gethPath.Child("storage", "snapshot"), storage.Snapshot)...)
+	}
+	if storage := opNode.Spec.OpGeth.Storage; storage != nil && storage.AutoGrow != nil && storage.AutoGrow.Enabled {
+		maxSizePath := gethPath.Child("storage", "autoGrow", "maxSize")
+		if storage.AutoGrow.MaxSize.IsZero() {
+			allErrs = append(allErrs, field.Required(maxSizePath, "autoGrow requires a maximum size"))
+		} else if storage.AutoGrow.MaxSize.Cmp(storage.Size) < 0 {
+			allErrs = append(allErrs, field.Invalid(maxSizePath, storage.AutoGrow.MaxSize.String(), "must not be smaller than the storage size"))
+		}
+	}
+	allErrs = append(allErrs, resources.ValidateOpGethConfig(gethPath, &opNode.Spec.OpGeth)...)
+	allErrs = append(allErrs, resources.ValidateOpNodeConfig(opNodePath, &opNode.Spec.OpNode)...)
+	allErrs = append(allErrs, resources.ValidatePodBandwidthLimit(specPath.Child("podBandwidthLimit"), opNode.Spec.PodBandwidthLimit)...)
+	allErrs = append(allErrs, resources.ValidateOpNodeOverrides(specPath, &opNode.Spec)...)
+	if networking := opNode.Spec.OpGeth.Networking; networking != nil {
+		networkingPath := gethPath.Child("networking")
+		if networking.HTTP != nil {
+			allErrs = appendError(allErrs, validatePort(networkingPath.Child("http", "port"), networking.HTTP.Port))
+		}
+		if networking.WS != nil {
+			allErrs = appendError(allErrs, validatePort(networkingPath.Child("ws", "port"), networking.WS.Port))
+		}
+		if networking.AuthRPC != nil {
+			allErrs = appendError(allErrs, validatePort(networkingPath.Child("authrpc", "port"), networking.AuthRPC.Port))
+		}
+		if networking.P2P != nil {
+			allErrs = appendError(allErrs, validatePort(networkingPath.Child("p2p", "port"), networking.P2P.Port))
+		}
+	}
+
+	if strategy := opNode.Spec.UpgradeStrategy; strategy != nil {
+		allErrs = appendError(allErrs, validateDuration(specPath.Child("upgradeStrategy", "healthTimeout"), strategy.HealthTimeout))
+	}
+	if backup := opNode.Spec.Backup; backup != nil {
+		intervalPath := specPath.Child("backup", "interval")
+		if err := validateDuration(intervalPath, backup.Interval); err != nil {
+			allErrs = append(allErrs, err)
+		} else if interval, _ := time.ParseDuration(backup.Interval); backup.Interval != "" && interval <= 0 {
+			allErrs = append(allErrs, field.Invalid(intervalPath, backup.Interval, "must be a positive duration"))
+		}
+	}
+	if ha := opNode.Spec.HA; ha != nil && ha.Enabled {
+		allErrs = append(allErrs, validateSequencerHA(opNode, specPath.Child("ha"))...)
+	}
+
+	return allErrs
+}
+
+// validateSequencerHA checks that op-conductor can reach the sequencer it drives
+// and that every member gets its own P2P identity
+func validateSequencerHA(opNode *optimismv1alpha1.OpNode, haPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	ha := opNode.Spec.HA
+
+	if opNode.Spec.NodeType != "sequencer" {
+		allErrs = append(allErrs, field.Forbidden(haPath.Child("enabled"), "ha is only supported for sequencer nodes"))
+	}
+	allErrs = appendError(allErrs, validatePort(haPath.Child("raftPort"), ha.RaftPort))
+	allErrs = appendError(allErrs, validatePort(haPath.Child("rpcPort"), ha.RPCPort))
+
+	if rpc := opNode.Spec.OpNode.RPC; rpc == nil || !rpc.Enabled {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "opNode", "rpc", "enabled"), "op-conductor requires the op-node RPC"))
+	}
+	if resources.ExternalEngine(opNode) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "opNode", "engine", "endpoint"), "op-conductor requires a local op-geth"))
+	}
+	if networking := opNode.Spec.OpGeth.Networking; networking == nil || networking.HTTP == nil || !networking.HTTP.Enabled {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "opGeth", "networking", "http", "enabled"), "op-conductor requires the op-geth HTTP RPC"))
+	}
+	if p2p := opNode.Spec.OpNode.P2P; p2p != nil && p2p.PrivateKey != nil && p2p.PrivateKey.SecretRef != nil && ha.Members != 1 {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "opNode", "p2p", "privateKey", "secretRef"),
+			"a user-provided P2P key cannot be shared by multiple ha members; use generate for per-pod keys"))
+	}
+	return allErrs
+}
+
+// validateSnapshotSource checks that exactly one datadir snapshot source is set
+// and that a downloaded archive can be verified
+func validateSnapshotSource(path *field.Path, snapshot *optimismv1alpha1.SnapshotSource) field.ErrorList {
+	var allErrs field.ErrorList
+
+	sources := 0
+	for _, source := range []string{snapshot.URL, snapshot.VolumeSnapshotName, snapshot.PersistentVolumeClaimName} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		allErrs = append(allErrs, field.Invalid(path, sources, "exactly one of url, volumeSnapshotName or persistentVolumeClaimName must be set"))
+	}
+	if snapshot.URL != "" {
+		allErrs = appendError(allErrs, validateURL(path.Child("url"), snapshot.URL, "http", "https"))
+	}
+	if snapshot.SHA256 != "" {
+		if snapshot.URL == "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("sha256"), "sha256 only applies to url sources"))
+		} else if decoded, err := hex.DecodeString(snapshot.SHA256); err != nil || len(decoded) != sha256.Size {
+			allErrs = append(allErrs, field.Invalid(path.Child("sha256"), snapshot.SHA256, "must be a hex-encoded SHA-256 checksum"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,340 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+var _ = Describe("OpNode Webhook", func() {
+	var (
+		obj       *optimismv1alpha1.OpNode
+		oldObj    *optimismv1alpha1.OpNode
+		validator OpNodeCustomValidator
+		defaulter OpNodeCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &optimismv1alpha1.OpNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-sequencer", Namespace: "default"},
+			Spec: optimismv1alpha1.OpNodeSpec{
+				OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "test-network"},
+				NodeType:           "sequencer",
+				OpNode: optimismv1alpha1.OpNodeConfig{
+					Sequencer: &optimismv1alpha1.SequencerConfig{Enabled: true},
+				},
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = OpNodeCustomValidator{}
+		defaulter = OpNodeCustomDefaulter{}
+	})
+
+	Context("When creating OpNode under Defaulting Webhook", func() {
+		It("Should apply the same defaults the resource builders use", func() {
+			obj.Spec.OpNode.RPC = &optimismv1alpha1.RPCConfig{Enabled: true}
+			obj.Spec.OpGeth.Networking = &optimismv1alpha1.GethNetworkingConfig{
+				HTTP:    &optimismv1alpha1.HTTPConfig{Enabled: true},
+				AuthRPC: &optimismv1alpha1.AuthRPCConfig{},
+			}
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.OpGeth.DataDir).To(Equal(resources.DefaultOpGethDataDir))
+			Expect(obj.Spec.OpGeth.SyncMode).To(Equal(resources.DefaultOpGethSyncMode))
+			Expect(obj.Spec.OpNode.RPC.Host).To(Equal(resources.DefaultListenHost))
+			Expect(obj.Spec.OpNode.RPC.Port).To(Equal(resources.DefaultOpNodeRPCPort))
+			Expect(obj.Spec.OpGeth.Networking.HTTP.Port).To(Equal(resources.DefaultOpGethHTTPPort))
+			Expect(obj.Spec.OpGeth.Networking.AuthRPC.Host).To(Equal(resources.DefaultAuthRPCHost))
+			Expect(obj.Spec.OpGeth.Networking.AuthRPC.Port).To(Equal(resources.DefaultOpGethAuthRPCPort))
+			Expect(obj.Spec.OpGeth.Networking.WS).To(BeNil())
+		})
+
+		It("Should default the upgrade health timeout", func() {
+			obj.Spec.UpgradeStrategy = &optimismv1alpha1.UpgradeStrategy{AutoRollback: true}
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.UpgradeStrategy.HealthTimeout).To(Equal(resources.DefaultUpgradeHealthTimeout))
+		})
+
+		It("Should default the op-conductor cluster of an HA sequencer", func() {
+			obj.Spec.HA = &optimismv1alpha1.SequencerHAConfig{Enabled: true}
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.HA.Members).To(Equal(resources.DefaultConductorMembers))
+			Expect(obj.Spec.HA.RaftPort).To(Equal(resources.DefaultConductorRaftPort))
+			Expect(obj.Spec.HA.RPCPort).To(Equal(resources.DefaultConductorRPCPort))
+			Expect(obj.Spec.HA.HealthCheck.SafeInterval).To(Equal(resources.DefaultConductorSafeInterval))
+		})
+
+		It("Should default the backup schedule", func() {
+			obj.Spec.Backup = &optimismv1alpha1.BackupConfig{Enabled: true}
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Backup.Interval).To(Equal(resources.DefaultBackupInterval))
+			Expect(obj.Spec.Backup.Retention).To(Equal(resources.DefaultBackupRetention))
+		})
+
+		It("Should default the storage auto-grow policy", func() {
+			obj.Spec.OpGeth.Storage = &optimismv1alpha1.GethStorageConfig{
+				StorageConfig: optimismv1alpha1.StorageConfig{Size: resource.MustParse("100Gi")},
+				AutoGrow:      &optimismv1alpha1.StorageAutoGrowConfig{Enabled: true, MaxSize: resource.MustParse("1Ti")},
+			}
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.OpGeth.Storage.AutoGrow.ThresholdPercent).To(Equal(resources.DefaultAutoGrowThresholdPercent))
+			Expect(obj.Spec.OpGeth.Storage.AutoGrow.IncreasePercent).To(Equal(resources.DefaultAutoGrowIncreasePercent))
+		})
+
+		It("Should keep explicitly configured values", func() {
+			obj.Spec.OpGeth.SyncMode = "full"
+			obj.Spec.OpNode.RPC = &optimismv1alpha1.RPCConfig{Port: 7545}
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.OpGeth.SyncMode).To(Equal("full"))
+			Expect(obj.Spec.OpNode.RPC.Port).To(Equal(int32(7545)))
+		})
+	})
+
+	Context("When creating or updating OpNode under Validating Webhook", func() {
+		It("Should admit a valid sequencer", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny a sequencer without the sequencer enabled", func() {
+			obj.Spec.OpNode.Sequencer = nil
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.opNode.sequencer.enabled")))
+		})
+
+		It("Should deny a sequencer with P2P discovery enabled", func() {
+			obj.Spec.OpNode.P2P = &optimismv1alpha1.P2PConfig{
+				Discovery: &optimismv1alpha1.P2PDiscoveryConfig{Enabled: true},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.opNode.p2p.discovery.enabled")))
+		})
+
+		It("Should deny a replica that enables the sequencer", func() {
+			obj.Spec.NodeType = "replica"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("replica nodes cannot run the sequencer")))
+		})
+
+		It("Should deny an L2 RPC URL that is not HTTP", func() {
+			obj.Spec.L2RpcUrl = "ws://sequencer:8546"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.l2RpcUrl")))
+		})
+
+		It("Should deny out-of-range ports", func() {
+			obj.Spec.OpGeth.Networking = &optimismv1alpha1.GethNetworkingConfig{
+				HTTP: &optimismv1alpha1.HTTPConfig{Port: 70000},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.opGeth.networking.http.port")))
+		})
+
+		It("Should deny scaling a sequencer", func() {
+			replicas := int32(2)
+			obj.Spec.Replicas = &replicas
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.replicas")))
+		})
+
+		It("Should deny sharing a user-provided P2P key across replicas", func() {
+			replicas := int32(3)
+			obj.Spec.NodeType = "replica"
+			obj.Spec.OpNode.Sequencer = nil
+			obj.Spec.Replicas = &replicas
+			obj.Spec.OpNode.P2P = &optimismv1alpha1.P2PConfig{
+				PrivateKey: &optimismv1alpha1.SecretKeyRef{SecretRef: &corev1.SecretKeySelector{Key: "key"}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.opNode.p2p.privateKey.secretRef")))
+
+			obj.Spec.OpNode.P2P.PrivateKey = &optimismv1alpha1.SecretKeyRef{Generate: true}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should admit an HA sequencer that exposes the RPCs op-conductor drives", func() {
+			obj.Spec.HA = &optimismv1alpha1.SequencerHAConfig{Enabled: true, Members: 3}
+			obj.Spec.OpNode.RPC = &optimismv1alpha1.RPCConfig{Enabled: true}
+			obj.Spec.OpGeth.Networking = &optimismv1alpha1.GethNetworkingConfig{
+				HTTP: &optimismv1alpha1.HTTPConfig{Enabled: true},
+			}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+
+			obj.Spec.OpGeth.Networking = nil
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.opGeth.networking.http.enabled")))
+		})
+
+		It("Should deny HA on a replica", func() {
+			obj.Spec.NodeType = "replica"
+			obj.Spec.OpNode.Sequencer = nil
+			obj.Spec.HA = &optimismv1alpha1.SequencerHAConfig{Enabled: true}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.ha.enabled")))
+		})
+
+		It("Should deny a snapshot with more than one source", func() {
+			obj.Spec.OpGeth.Storage = &optimismv1alpha1.GethStorageConfig{
+				StorageConfig: optimismv1alpha1.StorageConfig{Size: resource.MustParse("500Gi")},
+				Snapshot: &optimismv1alpha1.SnapshotSource{
+					URL:                "https://snapshots.example.com/geth.tar.gz",
+					VolumeSnapshotName: "geth-daily",
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.opGeth.storage.snapshot")))
+		})
+
+		It("Should deny a snapshot checksum that is not a SHA-256 digest", func() {
+			obj.Spec.OpGeth.Storage = &optimismv1alpha1.GethStorageConfig{
+				StorageConfig: optimismv1alpha1.StorageConfig{Size: resource.MustParse("500Gi")},
+				Snapshot: &optimismv1alpha1.SnapshotSource{
+					URL:    "https://snapshots.example.com/geth.tar.gz",
+					SHA256: "not-a-digest",
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.opGeth.storage.snapshot.sha256")))
+		})
+
+		It("Should deny a backup interval that is not a positive duration", func() {
+			obj.Spec.Backup = &optimismv1alpha1.BackupConfig{Enabled: true, Interval: "daily"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.backup.interval")))
+
+			obj.Spec.Backup.Interval = "-1h"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("must be a positive duration")))
+		})
+
+		It("Should deny storage auto-grow without a maximum size above the storage size", func() {
+			obj.Spec.OpGeth.Storage = &optimismv1alpha1.GethStorageConfig{
+				StorageConfig: optimismv1alpha1.StorageConfig{Size: resource.MustParse("100Gi")},
+				AutoGrow:      &optimismv1alpha1.StorageAutoGrowConfig{Enabled: true},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.opGeth.storage.autoGrow.maxSize: Required")))
+
+			obj.Spec.OpGeth.Storage.AutoGrow.MaxSize = resource.MustParse("50Gi")
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("must not be smaller than the storage size")))
+		})
+
+		It("Should deny archive nodes on the path state scheme", func() {
+			obj.Spec.OpGeth.GCMode = "archive"
+			obj.Spec.OpGeth.StateScheme = "path"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.opGeth.stateScheme")))
+
+			obj.Spec.OpGeth.StateScheme = ""
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny bootnodes and RPC kinds op-node does not accept", func() {
+			obj.Spec.OpNode.P2P = &optimismv1alpha1.P2PConfig{
+				Discovery: &optimismv1alpha1.P2PDiscoveryConfig{Bootnodes: []string{"10.0.0.1:30305"}},
+			}
+			obj.Spec.OpNode.L1 = &optimismv1alpha1.L1ClientConfig{RPCKind: "geth"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(SatisfyAll(
+				ContainSubstring("spec.opNode.p2p.discovery.bootnodes[0]"),
+				ContainSubstring("spec.opNode.l1.rpcKind"),
+			)))
+		})
+
+		It("Should warn that maxTxPerBlock is ignored", func() {
+			obj.Spec.OpNode.Sequencer.MaxTxPerBlock = 100
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("spec.opNode.sequencer.maxTxPerBlock")))
+		})
+
+		It("Should deny a pod bandwidth limit that is not a quantity", func() {
+			obj.Spec.PodBandwidthLimit = "10MB"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.podBandwidthLimit")))
+
+			obj.Spec.PodBandwidthLimit = "10M"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny HA with an external engine", func() {
+			obj.Spec.HA = &optimismv1alpha1.SequencerHAConfig{Enabled: true, Members: 3}
+			obj.Spec.OpNode.RPC = &optimismv1alpha1.RPCConfig{Enabled: true}
+			obj.Spec.OpNode.Engine = &optimismv1alpha1.EngineConfig{Endpoint: "http://reth.execution:8551"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.opNode.engine.endpoint")))
+		})
+
+		It("Should deny duplicate sidecars", func() {
+			obj.Spec.Sidecars = []corev1.Container{{Name: "exporter", Image: "exporter:v1"}, {Name: "exporter", Image: "exporter:v2"}}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.sidecars[1].name")))
+		})
+
+		It("Should deny an upgrade health timeout that is not a duration", func() {
+			obj.Spec.UpgradeStrategy = &optimismv1alpha1.UpgradeStrategy{HealthTimeout: "soon"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.upgradeStrategy.healthTimeout")))
+		})
+
+		It("Should deny changing the node type", func() {
+			obj.Spec.NodeType = "replica"
+			obj.Spec.OpNode.Sequencer = nil
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.nodeType: Forbidden")))
+		})
+
+		It("Should admit a node type change forced through the annotation with a warning", func() {
+			obj.Spec.NodeType = "replica"
+			obj.Spec.OpNode.Sequencer = nil
+			obj.Annotations = map[string]string{optimismv1alpha1.ForceUpdateAnnotation: "spec.nodeType"}
+			warnings, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("spec.nodeType")))
+		})
+
+		It("Should only force the fields named in the annotation", func() {
+			obj.Spec.NodeType = "replica"
+			obj.Spec.OpNode.Sequencer = nil
+			obj.Spec.OptimismNetworkRef.Name = "other-network"
+			obj.Annotations = map[string]string{optimismv1alpha1.ForceUpdateAnnotation: "spec.nodeType"}
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.optimismNetworkRef: Forbidden")))
+			Expect(err).NotTo(MatchError(ContainSubstring("spec.nodeType")))
+		})
+
+		It("Should deny moving the node to another network", func() {
+			obj.Spec.OptimismNetworkRef.Name = "other-network"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.optimismNetworkRef: Forbidden")))
+		})
+	})
+})
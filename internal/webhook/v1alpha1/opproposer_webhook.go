@@ -0,0 +1,144 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var opproposerlog = logf.Log.WithName("opproposer-resource")
+
+// SetupOpProposerWebhookWithManager registers the webhook for OpProposer in the manager.
+func SetupOpProposerWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&optimismv1alpha1.OpProposer{}).
+		WithValidator(&OpProposerCustomValidator{}).
+		WithDefaulter(&OpProposerCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-optimism-optimism-io-v1alpha1-opproposer,mutating=true,failurePolicy=fail,sideEffects=None,groups=optimism.optimism.io,resources=opproposers,verbs=create;update,versions=v1alpha1,name=mopproposer-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// OpProposerCustomDefaulter sets default values on OpProposer resources when
+// they are created or updated.
+type OpProposerCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &OpProposerCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind OpProposer.
+func (d *OpProposerCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	opProposer, ok := obj.(*optimismv1alpha1.OpProposer)
+	if !ok {
+		return fmt.Errorf("expected an OpProposer object but got %T", obj)
+	}
+	opproposerlog.Info("Defaulting for OpProposer", "name", opProposer.GetName())
+
+	opProposer.Spec.ProposalInterval = defaultString(opProposer.Spec.ProposalInterval, resources.DefaultProposalInterval)
+	if rpc := opProposer.Spec.RPC; rpc != nil {
+		rpc.Host = defaultString(rpc.Host, resources.DefaultListenHost)
+		rpc.Port = defaultInt32(rpc.Port, resources.DefaultOpProposerRPCPort)
+	}
+	if metrics := opProposer.Spec.Metrics; metrics != nil {
+		metrics.Port = defaultInt32(metrics.Port, resources.DefaultMetricsPort)
+	}
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-optimism-optimism-io-v1alpha1-opproposer,mutating=false,failurePolicy=fail,sideEffects=None,groups=optimism.optimism.io,resources=opproposers,verbs=create;update,versions=v1alpha1,name=vopproposer-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// OpProposerCustomValidator validates OpProposer resources when they are created or updated.
+type OpProposerCustomValidator struct{}
+
+var _ webhook.CustomValidator = &OpProposerCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type OpProposer.
+func (v *OpProposerCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	opProposer, ok := obj.(*optimismv1alpha1.OpProposer)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpProposer object but got %T", obj)
+	}
+	opproposerlog.Info("Validation for OpProposer upon creation", "name", opProposer.GetName())
+
+	return nil, invalid("OpProposer", opProposer.Name, validateOpProposerSpec(opProposer))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type OpProposer.
+func (v *OpProposerCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	opProposer, ok := newObj.(*optimismv1alpha1.OpProposer)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpProposer object for the newObj but got %T", newObj)
+	}
+	oldOpProposer, ok := oldObj.(*optimismv1alpha1.OpProposer)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpProposer object for the oldObj but got %T", oldObj)
+	}
+	opproposerlog.Info("Validation for OpProposer upon update", "name", opProposer.GetName())
+
+	var warnings admission.Warnings
+	allErrs := validateOpProposerSpec(opProposer)
+	allErrs = appendError(allErrs, validateImmutable(opProposer, field.NewPath("spec", "optimismNetworkRef"),
+		oldOpProposer.Spec.OptimismNetworkRef, opProposer.Spec.OptimismNetworkRef, &warnings))
+
+	return warnings, invalid("OpProposer", opProposer.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type OpProposer.
+func (v *OpProposerCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateOpProposerSpec mirrors the controller's configuration checks
+func validateOpProposerSpec(opProposer *optimismv1alpha1.OpProposer) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if opProposer.Spec.OptimismNetworkRef.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("optimismNetworkRef", "name"), ""))
+	}
+	if opProposer.Spec.RollupRPCRef.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("rollupRpcRef", "name"), ""))
+	}
+	allErrs = append(allErrs, validateFundedKey(specPath.Child("privateKey"), opProposer.Spec.PrivateKey)...)
+
+	if opProposer.Spec.GameType < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("gameType"), opProposer.Spec.GameType, "must not be negative"))
+	}
+	allErrs = appendError(allErrs, validateDuration(specPath.Child("proposalInterval"), opProposer.Spec.ProposalInterval))
+	allErrs = appendError(allErrs, validateDuration(specPath.Child("pollInterval"), opProposer.Spec.PollInterval))
+
+	if rpc := opProposer.Spec.RPC; rpc != nil {
+		allErrs = appendError(allErrs, validatePort(specPath.Child("rpc", "port"), rpc.Port))
+	}
+	if metrics := opProposer.Spec.Metrics; metrics != nil {
+		allErrs = appendError(allErrs, validatePort(specPath.Child("metrics", "port"), metrics.Port))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+var _ = Describe("OpProposer Webhook", func() {
+	var (
+		obj       *optimismv1alpha1.OpProposer
+		oldObj    *optimismv1alpha1.OpProposer
+		validator OpProposerCustomValidator
+		defaulter OpProposerCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &optimismv1alpha1.OpProposer{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-proposer", Namespace: "default"},
+			Spec: optimismv1alpha1.OpProposerSpec{
+				OptimismNetworkRef: optimismv1alpha1.OptimismNetworkRef{Name: "test-network"},
+				RollupRPCRef:       optimismv1alpha1.OpNodeReference{Name: "test-replica"},
+				PrivateKey: optimismv1alpha1.SecretKeyRef{
+					SecretRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "proposer-key"},
+						Key:                  "private-key",
+					},
+				},
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = OpProposerCustomValidator{}
+		defaulter = OpProposerCustomDefaulter{}
+	})
+
+	Context("When creating OpProposer under Defaulting Webhook", func() {
+		It("Should default the proposal interval and RPC port", func() {
+			obj.Spec.RPC = &optimismv1alpha1.RPCConfig{Enabled: true}
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.ProposalInterval).To(Equal(resources.DefaultProposalInterval))
+			Expect(obj.Spec.RPC.Port).To(Equal(resources.DefaultOpProposerRPCPort))
+		})
+	})
+
+	Context("When creating or updating OpProposer under Validating Webhook", func() {
+		It("Should admit a valid proposer", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny a missing rollup RPC reference", func() {
+			obj.Spec.RollupRPCRef.Name = ""
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.rollupRpcRef.name")))
+		})
+
+		It("Should deny an invalid proposal interval", func() {
+			obj.Spec.ProposalInterval = "hourly"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.proposalInterval")))
+		})
+
+		It("Should deny moving the proposer to another network", func() {
+			obj.Spec.OptimismNetworkRef.Name = "other-network"
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.optimismNetworkRef: Forbidden")))
+		})
+	})
+})
@@ -0,0 +1,236 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/rollup"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/superchain"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var optimismnetworklog = logf.Log.WithName("optimismnetwork-resource")
+
+// SetupOptimismNetworkWebhookWithManager registers the webhook for OptimismNetwork in the manager.
+// Registered chains are checked against registry; the embedded snapshot is used when nil.
+func SetupOptimismNetworkWebhookWithManager(mgr ctrl.Manager, registry *superchain.Registry) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&optimismv1alpha1.OptimismNetwork{}).
+		WithValidator(&OptimismNetworkCustomValidator{Registry: registry}).
+		WithDefaulter(&OptimismNetworkCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-optimism-optimism-io-v1alpha1-optimismnetwork,mutating=true,failurePolicy=fail,sideEffects=None,groups=optimism.optimism.io,resources=optimismnetworks,verbs=create;update,versions=v1alpha1,name=moptimismnetwork-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// OptimismNetworkCustomDefaulter sets default values on OptimismNetwork resources
+// when they are created or updated.
+type OptimismNetworkCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &OptimismNetworkCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind OptimismNetwork.
+func (d *OptimismNetworkCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	network, ok := obj.(*optimismv1alpha1.OptimismNetwork)
+	if !ok {
+		return fmt.Errorf("expected an OptimismNetwork object but got %T", obj)
+	}
+	optimismnetworklog.Info("Defaulting for OptimismNetwork", "name", network.GetName())
+
+	if network.Spec.ContractAddresses != nil && network.Spec.ContractAddresses.DiscoveryMethod == "" {
+		network.Spec.ContractAddresses.DiscoveryMethod = "auto"
+	}
+
+	if shared := network.Spec.SharedConfig; shared != nil && shared.Metrics != nil && shared.Metrics.Enabled {
+		if shared.Metrics.Port == 0 {
+			shared.Metrics.Port = resources.DefaultMetricsPort
+		}
+	}
+
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-optimism-optimism-io-v1alpha1-optimismnetwork,mutating=false,failurePolicy=fail,sideEffects=None,groups=optimism.optimism.io,resources=optimismnetworks,verbs=create;update,versions=v1alpha1,name=voptimismnetwork-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// OptimismNetworkCustomValidator validates OptimismNetwork resources when they
+// are created or updated.
+type OptimismNetworkCustomValidator struct {
+	// Registry resolves registered chains; the embedded snapshot is used when nil
+	Registry *superchain.Registry
+}
+
+var _ webhook.CustomValidator = &OptimismNetworkCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type OptimismNetwork.
+func (v *OptimismNetworkCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	network, ok := obj.(*optimismv1alpha1.OptimismNetwork)
+	if !ok {
+		return nil, fmt.Errorf("expected an OptimismNetwork object but got %T", obj)
+	}
+	optimismnetworklog.Info("Validation for OptimismNetwork upon creation", "name", network.GetName())
+
+	return nil, invalid("OptimismNetwork", network.Name, v.validateSpec(network))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type OptimismNetwork.
+func (v *OptimismNetworkCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	network, ok := newObj.(*optimismv1alpha1.OptimismNetwork)
+	if !ok {
+		return nil, fmt.Errorf("expected an OptimismNetwork object for the newObj but got %T", newObj)
+	}
+	oldNetwork, ok := oldObj.(*optimismv1alpha1.OptimismNetwork)
+	if !ok {
+		return nil, fmt.Errorf("expected an OptimismNetwork object for the oldObj but got %T", oldObj)
+	}
+	optimismnetworklog.Info("Validation for OptimismNetwork upon update", "name", network.GetName())
+
+	specPath := field.NewPath("spec")
+	var warnings admission.Warnings
+	allErrs := v.validateSpec(network)
+	allErrs = appendError(allErrs, validateImmutable(network, specPath.Child("chainID"),
+		oldNetwork.Spec.ChainID, network.Spec.ChainID, &warnings))
+	allErrs = appendError(allErrs, validateImmutable(network, specPath.Child("l1ChainID"),
+		oldNetwork.Spec.L1ChainID, network.Spec.L1ChainID, &warnings))
+
+	return warnings, invalid("OptimismNetwork", network.Name, allErrs)
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type OptimismNetwork.
+func (v *OptimismNetworkCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateSpec checks the fields the controller would otherwise reject after persisting
+func (v *OptimismNetworkCustomValidator) validateSpec(network *optimismv1alpha1.OptimismNetwork) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if network.Spec.ChainID <= 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("chainID"), network.Spec.ChainID, "must be a positive chain ID"))
+	}
+	if network.Spec.L1ChainID <= 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("l1ChainID"), network.Spec.L1ChainID, "must be a positive chain ID"))
+	}
+	if network.Spec.ChainID > 0 && network.Spec.ChainID == network.Spec.L1ChainID {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("chainID"), network.Spec.ChainID, "must differ from l1ChainID"))
+	}
+
+	if network.Spec.L1RpcUrl != "" {
+		allErrs = appendError(allErrs, validateURL(specPath.Child("l1RpcUrl"), network.Spec.L1RpcUrl, "http", "https", "ws", "wss"))
+	}
+	allErrs = append(allErrs, resources.ValidateL1Endpoints(specPath, &network.Spec)...)
+	if network.Spec.L1BeaconUrl != "" {
+		allErrs = appendError(allErrs, validateURL(specPath.Child("l1BeaconUrl"), network.Spec.L1BeaconUrl, "http", "https"))
+	}
+
+	allErrs = append(allErrs, validateConfigSource(specPath.Child("rollupConfig"), network.Spec.RollupConfig)...)
+	allErrs = append(allErrs, validateConfigSource(specPath.Child("l2Genesis"), network.Spec.L2Genesis)...)
+
+	if addresses := network.Spec.ContractAddresses; addresses != nil {
+		addressesPath := specPath.Child("contractAddresses")
+		allErrs = appendError(allErrs, validateAddress(addressesPath.Child("systemConfigAddr"), addresses.SystemConfigAddr))
+		allErrs = appendError(allErrs, validateAddress(addressesPath.Child("l2OutputOracleAddr"), addresses.L2OutputOracleAddr))
+		allErrs = appendError(allErrs, validateAddress(addressesPath.Child("disputeGameFactoryAddr"), addresses.DisputeGameFactoryAddr))
+		allErrs = appendError(allErrs, validateAddress(addressesPath.Child("optimismPortalAddr"), addresses.OptimismPortalAddr))
+
+		switch addresses.DiscoveryMethod {
+		case "", "auto", "system-config", "superchain-registry", "well-known", "manual":
+		default:
+			allErrs = append(allErrs, field.NotSupported(addressesPath.Child("discoveryMethod"), addresses.DiscoveryMethod,
+				[]string{"auto", "system-config", "superchain-registry", "well-known", "manual"}))
+		}
+		if addresses.DiscoveryMethod == "system-config" && addresses.SystemConfigAddr == "" {
+			allErrs = append(allErrs, field.Required(addressesPath.Child("systemConfigAddr"), "required by the system-config discovery method"))
+		}
+	}
+
+	if params := network.Spec.ChainParameters; params != nil {
+		allErrs = appendError(allErrs, validateAddress(specPath.Child("chainParameters", "protocolVersionsAddr"), params.ProtocolVersionsAddr))
+	}
+
+	// A registered chain settles on a known L1
+	if err := v.validateRegisteredChain(specPath, network); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	return allErrs
+}
+
+// validateRegisteredChain checks l1ChainID against the superchain registry entry for chainID
+func (v *OptimismNetworkCustomValidator) validateRegisteredChain(specPath *field.Path, network *optimismv1alpha1.OptimismNetwork) *field.Error {
+	if v.Registry == nil {
+		registry, err := superchain.Embedded()
+		if err != nil {
+			return field.InternalError(specPath.Child("chainID"), err)
+		}
+		v.Registry = registry
+	}
+
+	chain, ok := v.Registry.ChainByID(network.Spec.ChainID)
+	if !ok || len(chain.RollupConfig) == 0 {
+		return nil
+	}
+	cfg, err := rollup.Parse(chain.RollupConfig)
+	if err != nil {
+		return field.InternalError(specPath.Child("chainID"), err)
+	}
+	if cfg.L1ChainID != uint64(network.Spec.L1ChainID) {
+		return field.Invalid(specPath.Child("l1ChainID"), network.Spec.L1ChainID,
+			fmt.Sprintf("chain %d (%s) is registered on L1 chain %d", network.Spec.ChainID, chain.Name, cfg.L1ChainID))
+	}
+	return nil
+}
+
+// validateConfigSource checks that at most one source is selected and that references are complete
+func validateConfigSource(path *field.Path, source *optimismv1alpha1.ConfigSource) field.ErrorList {
+	if source == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	sources := 0
+	if source.Inline != "" {
+		sources++
+	}
+	if source.ConfigMapRef != nil {
+		sources++
+		if source.ConfigMapRef.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("configMapRef", "name"), ""))
+		}
+		if source.ConfigMapRef.Key == "" {
+			allErrs = append(allErrs, field.Required(path.Child("configMapRef", "key"), ""))
+		}
+	}
+	if source.AutoDiscover {
+		sources++
+	}
+	if sources > 1 {
+		allErrs = append(allErrs, field.Invalid(path, "", "only one of inline, configMapRef, or autoDiscover can be specified"))
+	}
+	return allErrs
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/resources"
+)
+
+var _ = Describe("OptimismNetwork Webhook", func() {
+	var (
+		obj       *optimismv1alpha1.OptimismNetwork
+		oldObj    *optimismv1alpha1.OptimismNetwork
+		validator OptimismNetworkCustomValidator
+		defaulter OptimismNetworkCustomDefaulter
+	)
+
+	BeforeEach(func() {
+		obj = &optimismv1alpha1.OptimismNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-network", Namespace: "default"},
+			Spec: optimismv1alpha1.OptimismNetworkSpec{
+				ChainID:   11155420,
+				L1ChainID: 11155111,
+				L1RpcUrl:  "https://sepolia.example.com",
+			},
+		}
+		oldObj = obj.DeepCopy()
+		validator = OptimismNetworkCustomValidator{}
+		defaulter = OptimismNetworkCustomDefaulter{}
+	})
+
+	Context("When creating OptimismNetwork under Defaulting Webhook", func() {
+		It("Should default the discovery method and metrics port", func() {
+			obj.Spec.ContractAddresses = &optimismv1alpha1.ContractAddressConfig{}
+			obj.Spec.SharedConfig = &optimismv1alpha1.SharedConfig{
+				Metrics: &optimismv1alpha1.MetricsConfig{Enabled: true},
+			}
+
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.ContractAddresses.DiscoveryMethod).To(Equal("auto"))
+			Expect(obj.Spec.SharedConfig.Metrics.Port).To(Equal(resources.DefaultMetricsPort))
+		})
+
+		It("Should not add sections the user left out", func() {
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.ContractAddresses).To(BeNil())
+			Expect(obj.Spec.SharedConfig).To(BeNil())
+		})
+	})
+
+	Context("When creating or updating OptimismNetwork under Validating Webhook", func() {
+		It("Should admit a valid network", func() {
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+		})
+
+		It("Should deny identical L1 and L2 chain IDs", func() {
+			obj.Spec.L1ChainID = obj.Spec.ChainID
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.chainID")))
+		})
+
+		It("Should deny an L1 RPC URL with an unsupported scheme", func() {
+			obj.Spec.L1RpcUrl = "ftp://sepolia.example.com"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.l1RpcUrl")))
+		})
+
+		It("Should admit L1 RPC endpoints in place of the L1 RPC URL", func() {
+			obj.Spec.L1RpcUrl = ""
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("l1RpcUrl, l1RpcUrlSecretRef or l1RpcEndpoints is required")))
+
+			obj.Spec.L1RpcEndpoints = []optimismv1alpha1.L1RpcEndpoint{
+				{Name: "primary", URL: "https://primary.example.com"},
+				{Name: "backup", URL: "wss://backup.example.com", Priority: 10},
+			}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+
+			obj.Spec.L1RpcEndpoints[1].Name = "primary"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.l1RpcEndpoints[1].name")))
+		})
+
+		It("Should admit L1 URLs read from Secrets in place of literal URLs", func() {
+			secretRef := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "l1-urls"}, Key: "rpc"}
+			obj.Spec.L1RpcUrl = ""
+			obj.Spec.L1RpcUrlSecretRef = secretRef
+			obj.Spec.L1RpcEndpoints = []optimismv1alpha1.L1RpcEndpoint{{Name: "backup", URLSecretRef: secretRef}}
+			Expect(validator.ValidateCreate(ctx, obj)).Error().NotTo(HaveOccurred())
+
+			obj.Spec.L1RpcEndpoints[0].URL = "https://backup.example.com"
+			obj.Spec.L1BeaconUrl = "https://beacon.example.com"
+			obj.Spec.L1BeaconUrlSecretRef = &corev1.SecretKeySelector{Key: "beacon"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(SatisfyAll(
+				ContainSubstring("url and urlSecretRef are mutually exclusive"),
+				ContainSubstring("l1BeaconUrl and l1BeaconUrlSecretRef are mutually exclusive"),
+				ContainSubstring("spec.l1BeaconUrlSecretRef.name"),
+			)))
+		})
+
+		It("Should deny a relative beacon URL", func() {
+			obj.Spec.L1BeaconUrl = "beacon:5052"
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.l1BeaconUrl")))
+		})
+
+		It("Should deny a registered chain paired with the wrong L1", func() {
+			obj.Spec.L1ChainID = 1
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("registered on L1 chain 11155111")))
+		})
+
+		It("Should deny multiple rollup config sources", func() {
+			obj.Spec.RollupConfig = &optimismv1alpha1.ConfigSource{Inline: "{}", AutoDiscover: true}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("only one of inline, configMapRef, or autoDiscover")))
+		})
+
+		It("Should deny malformed contract addresses", func() {
+			obj.Spec.ContractAddresses = &optimismv1alpha1.ContractAddressConfig{SystemConfigAddr: "0x1234"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.contractAddresses.systemConfigAddr")))
+		})
+
+		It("Should deny changing the chain ID", func() {
+			obj.Spec.ChainID = 8453
+			obj.Spec.L1ChainID = 1
+			_, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).To(MatchError(ContainSubstring("spec.chainID: Forbidden")))
+		})
+
+		It("Should admit chain ID changes forced through the annotation", func() {
+			obj.Spec.ChainID = 8453
+			obj.Spec.L1ChainID = 1
+			obj.Annotations = map[string]string{optimismv1alpha1.ForceUpdateAnnotation: "spec.chainID, spec.l1ChainID"}
+			warnings, err := validator.ValidateUpdate(ctx, oldObj, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(2))
+		})
+
+		It("Should admit mutable changes", func() {
+			obj.Spec.L1RpcUrl = "wss://sepolia.example.com"
+			Expect(validator.ValidateUpdate(ctx, oldObj, obj)).Error().NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+// validateURL checks that value is an absolute URL using one of the allowed schemes
+func validateURL(path *field.Path, value string, schemes ...string) *field.Error {
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" {
+		return field.Invalid(path, value, "must be an absolute URL")
+	}
+	if !slices.Contains(schemes, parsed.Scheme) {
+		return field.NotSupported(path, parsed.Scheme, schemes)
+	}
+	return nil
+}
+
+// validateDuration checks that value, when set, parses as a Go duration
+func validateDuration(path *field.Path, value string) *field.Error {
+	if value == "" {
+		return nil
+	}
+	if _, err := time.ParseDuration(value); err != nil {
+		return field.Invalid(path, value, "must be a duration such as 30s or 1h")
+	}
+	return nil
+}
+
+// validateAddress checks that value, when set, is a hex-encoded Ethereum address
+func validateAddress(path *field.Path, value string) *field.Error {
+	if value != "" && !common.IsHexAddress(value) {
+		return field.Invalid(path, value, "must be a 0x-prefixed 20-byte hex address")
+	}
+	return nil
+}
+
+// validatePort checks that port, when set, is a valid TCP port
+func validatePort(path *field.Path, port int32) *field.Error {
+	if port != 0 && (port < 1 || port > 65535) {
+		return field.Invalid(path, port, "must be between 1 and 65535")
+	}
+	return nil
+}
+
+// validateFundedKey checks a signing key that must hold L1 funds and so cannot be generated
+func validateFundedKey(path *field.Path, key optimismv1alpha1.SecretKeyRef) field.ErrorList {
+	var allErrs field.ErrorList
+	if key.SecretRef == nil {
+		allErrs = append(allErrs, field.Required(path.Child("secretRef"), "a funded key must be provided"))
+	}
+	if key.Generate {
+		allErrs = append(allErrs, field.Forbidden(path.Child("generate"), "a generated key would hold no L1 funds"))
+	}
+	return allErrs
+}
+
+// validateImmutable rejects changes to a field that cannot be altered after creation,
+// unless the force-update annotation of obj names the field. Forced changes are
+// admitted with a warning appended to warnings.
+func validateImmutable[T comparable](obj metav1.Object, path *field.Path, oldValue, newValue T, warnings *admission.Warnings) *field.Error {
+	if oldValue == newValue {
+		return nil
+	}
+	if optimismv1alpha1.ForceUpdateAllowed(obj, path.String()) {
+		*warnings = append(*warnings, fmt.Sprintf("%s changed under the %s annotation; existing data directories may no longer match",
+			path, optimismv1alpha1.ForceUpdateAnnotation))
+		return nil
+	}
+	return field.Forbidden(path, fmt.Sprintf("field is immutable; to change it anyway set the %s annotation to %q",
+		optimismv1alpha1.ForceUpdateAnnotation, path.String()))
+}
+
+// invalid converts a field error list to the API error returned by the webhooks
+func invalid(kind, name string, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(schema.GroupKind{Group: optimismv1alpha1.GroupVersion.Group, Kind: kind}, name, allErrs)
+}
+
+// appendError appends err to allErrs when it is set
+func appendError(allErrs field.ErrorList, err *field.Error) field.ErrorList {
+	if err != nil {
+		return append(allErrs, err)
+	}
+	return allErrs
+}
+
+// defaultString returns def when value is unset
+func defaultString(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
+
+// defaultInt32 returns def when value is unset
+func defaultInt32(value, def int32) int32 {
+	if value == 0 {
+		return def
+	}
+	return value
+}
@@ -0,0 +1,163 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultCatalogReloadInterval is how often a version catalog file is checked for changes
+const DefaultCatalogReloadInterval = 30 * time.Second
+
+// VersionCatalog is the operator-level list of approved releases. It extends the
+// compiled-in VersionCompatibilityMatrix so new releases can be approved without
+// rebuilding the operator; loaded version sets replace built-in ones of the same name.
+type VersionCatalog struct {
+	// VersionSets are named sets of compatible component versions
+	VersionSets map[string]VersionSet `json:"versionSets,omitempty"`
+
+	// CompatiblePairs approve op-node/op-geth combinations outside any version set
+	CompatiblePairs []VersionPair `json:"compatiblePairs,omitempty"`
+}
+
+// VersionPair is an approved op-node release and the op-geth releases it may run with
+type VersionPair struct {
+	OpNode string   `json:"opNode"`
+	OpGeth []string `json:"opGeth"`
+}
+
+var (
+	catalogMu sync.RWMutex
+	catalog   VersionCatalog
+)
+
+// SetVersionCatalog replaces the loaded version catalog
+func SetVersionCatalog(c VersionCatalog) {
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	catalog = c
+}
+
+// versionSets returns the built-in version sets overlaid with the loaded catalog
+func versionSets() map[string]VersionSet {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+
+	sets := make(map[string]VersionSet, len(VersionCompatibilityMatrix)+len(catalog.VersionSets))
+	for name, set := range VersionCompatibilityMatrix {
+		sets[name] = set
+	}
+	for name, set := range catalog.VersionSets {
+		sets[name] = set
+	}
+	return sets
+}
+
+// compatiblePairs returns the loaded op-node/op-geth pairings
+func compatiblePairs() []VersionPair {
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	return catalog.CompatiblePairs
+}
+
+// ParseVersionCatalog decodes a YAML or JSON version catalog and checks its entries
+func ParseVersionCatalog(data []byte) (VersionCatalog, error) {
+	var c VersionCatalog
+	if err := yaml.UnmarshalStrict(data, &c); err != nil {
+		return c, fmt.Errorf("invalid version catalog: %w", err)
+	}
+
+	for name, set := range c.VersionSets {
+		if set.OpNode == "" || set.OpGeth == "" {
+			return c, fmt.Errorf("invalid version catalog: version set %s must pin opNode and opGeth", name)
+		}
+	}
+	for i, pair := range c.CompatiblePairs {
+		if pair.OpNode == "" || len(pair.OpGeth) == 0 || slices.Contains(pair.OpGeth, "") {
+			return c, fmt.Errorf("invalid version catalog: compatiblePairs[%d] must name opNode and at least one opGeth", i)
+		}
+	}
+	return c, nil
+}
+
+// LoadVersionCatalog reads a version catalog file, e.g. a key of a mounted ConfigMap
+func LoadVersionCatalog(path string) (VersionCatalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return VersionCatalog{}, err
+	}
+	return ParseVersionCatalog(data)
+}
+
+// VersionCatalogWatcher reloads a version catalog file when its content changes.
+// Polling is used because mounted ConfigMaps are updated through symlink swaps.
+type VersionCatalogWatcher struct {
+	Path     string
+	Interval time.Duration
+
+	current []byte
+}
+
+// NewVersionCatalogWatcher loads the catalog at path and returns a watcher that keeps it current
+func NewVersionCatalogWatcher(path string) (*VersionCatalogWatcher, error) {
+	w := &VersionCatalogWatcher{Path: path, Interval: DefaultCatalogReloadInterval}
+	if _, err := w.reload(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Start polls the catalog file until ctx is cancelled. Invalid content is
+// logged and the previously loaded catalog stays in effect.
+func (w *VersionCatalogWatcher) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("version-catalog")
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			changed, err := w.reload()
+			if err != nil {
+				logger.Error(err, "failed to reload version catalog, keeping the previous one", "path", w.Path)
+				continue
+			}
+			if changed {
+				logger.Info("reloaded version catalog", "path", w.Path)
+			}
+		}
+	}
+}
+
+// NeedLeaderElection returns false so every replica, including its webhooks, sees catalog updates
+func (w *VersionCatalogWatcher) NeedLeaderElection() bool {
+	return false
+}
+
+// reload applies the catalog file if its content differs from the last applied one
+func (w *VersionCatalogWatcher) reload() (bool, error) {
+	data, err := os.ReadFile(w.Path)
+	if err != nil {
+		return false, err
+	}
+	if w.current != nil && bytes.Equal(data, w.current) {
+		return false, nil
+	}
+
+	c, err := ParseVersionCatalog(data)
+	if err != nil {
+		return false, err
+	}
+	SetVersionCatalog(c)
+	w.current = data
+	return true, nil
+}
@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 )
@@ -13,6 +15,12 @@ const (
 	// Current stable versions (January 2025)
 	DefaultOpStackVersion = "v1.13.3"
 	DefaultOpGethVersion  = "v1.101511.0" // Based on geth v1.15.11
+
+	// op-conductor is released independently of the OP Stack version
+	DefaultOpConductorVersion = "v0.6.0"
+
+	// Init container image that downloads datadir snapshots; needs wget, sha256sum and tar
+	DefaultSnapshotImage = "docker.io/library/alpine:3.20"
 )
 
 // Container images with default versions - verified January 2025
@@ -22,6 +30,7 @@ var DefaultImages = ImageConfig{
 	OpBatcher:    fmt.Sprintf("%s/op-batcher:v1.12.0", OptimismRegistry),                // v1.12.0 compatible
 	OpProposer:   fmt.Sprintf("%s/op-proposer:v1.10.0", OptimismRegistry),               // v1.10.0 (latest stable)
 	OpChallenger: fmt.Sprintf("%s/op-challenger:v1.5.1", OptimismRegistry),              // v1.5.1 (latest)
+	OpConductor:  fmt.Sprintf("%s/op-conductor:%s", OptimismRegistry, DefaultOpConductorVersion),
 }
 
 // ImageConfig allows overriding default images and supports version compatibility
@@ -31,6 +40,7 @@ type ImageConfig struct {
 	OpBatcher    string `json:"opBatcher,omitempty"`
 	OpProposer   string `json:"opProposer,omitempty"`
 	OpChallenger string `json:"opChallenger,omitempty"`
+	OpConductor  string `json:"opConductor,omitempty"`
 }
 
 // VersionSet represents a compatible set of component versions
@@ -40,6 +50,7 @@ type VersionSet struct {
 	OpBatcher    string `json:"opBatcher"`
 	OpProposer   string `json:"opProposer"`
 	OpChallenger string `json:"opChallenger"`
+	OpConductor  string `json:"opConductor,omitempty"`
 }
 
 // VersionCompatibilityMatrix defines known compatible version combinations
@@ -52,6 +63,7 @@ var VersionCompatibilityMatrix = map[string]VersionSet{
 		OpBatcher:    "v1.12.0",     // Compatible with Isthmus
 		OpProposer:   "v1.10.0",     // Latest stable op-proposer
 		OpChallenger: "v1.5.1",      // Latest challenger version
+		OpConductor:  DefaultOpConductorVersion,
 	},
 	// Latest development versions
 	"latest": {
@@ -60,42 +72,67 @@ var VersionCompatibilityMatrix = map[string]VersionSet{
 		OpBatcher:    "latest",
 		OpProposer:   "latest",
 		OpChallenger: "latest",
+		OpConductor:  "latest",
 	},
 }
 
-// ValidateImageCompatibility checks if the provided image versions are compatible
+// ValidateImageCompatibility checks the configured images against the compatibility
+// matrix and the loaded version catalog. op-node drives op-geth over the Engine API, so
+// an op-node release pinned by a version set must run with that set's op-geth, or with
+// an op-geth the catalog pairs it with. Combinations neither covers, such as newer
+// releases or digest references, are allowed.
 func (ic *ImageConfig) ValidateImageCompatibility() error {
-	// Extract versions from image tags and validate compatibility
+	for _, component := range []struct{ name, image string }{
+		{"op-node", ic.OpNode},
+		{"op-geth", ic.OpGeth},
+		{"op-batcher", ic.OpBatcher},
+		{"op-proposer", ic.OpProposer},
+		{"op-challenger", ic.OpChallenger},
+		{"op-conductor", ic.OpConductor},
+	} {
+		if component.image == "" {
+			return fmt.Errorf("no image configured for %s", component.name)
+		}
+	}
+
 	opNodeVersion := extractVersionFromImage(ic.OpNode)
 	opGethVersion := extractVersionFromImage(ic.OpGeth)
-	opBatcherVersion := extractVersionFromImage(ic.OpBatcher)
-	opProposerVersion := extractVersionFromImage(ic.OpProposer)
-
-	// Check if all OP Stack components use the same version
-	if opNodeVersion != "" && opBatcherVersion != "" && opProposerVersion != "" {
-		if opNodeVersion != opBatcherVersion || opNodeVersion != opProposerVersion {
-			return fmt.Errorf("OP Stack component versions must match: op-node=%s, op-batcher=%s, op-proposer=%s",
-				opNodeVersion, opBatcherVersion, opProposerVersion)
-		}
+	if opNodeVersion == "" || opNodeVersion == "latest" || opGethVersion == "" {
+		return nil
+	}
+
+	sets := versionSets()
+	names := make([]string, 0, len(sets))
+	for name := range sets {
+		names = append(names, name)
 	}
+	sort.Strings(names)
 
-	// Check known compatibility matrix
-	for _, versionSet := range VersionCompatibilityMatrix {
-		if opNodeVersion == versionSet.OpNode && opGethVersion == versionSet.OpGeth {
-			// Found compatible version set
+	var required []string
+	for _, name := range names {
+		versionSet := sets[name]
+		if versionSet.OpNode != opNodeVersion {
+			continue
+		}
+		if versionSet.OpGeth == opGethVersion {
 			return nil
 		}
+		required = append(required, fmt.Sprintf("%s (%s)", versionSet.OpGeth, name))
 	}
-
-	// If not in matrix but versions look reasonable, allow with warning
-	// This allows for newer versions not yet in the matrix
-	if opNodeVersion != "" && opGethVersion != "" {
-		// Log warning about untested version combination
-		// This would be logged in the operator logs
-		return nil
+	for _, pair := range compatiblePairs() {
+		if pair.OpNode != opNodeVersion {
+			continue
+		}
+		if slices.Contains(pair.OpGeth, opGethVersion) {
+			return nil
+		}
+		required = append(required, pair.OpGeth...)
 	}
-
-	return fmt.Errorf("unable to validate version compatibility")
+	if len(required) > 0 {
+		return fmt.Errorf("op-node %s is not compatible with op-geth %s; use op-geth %s",
+			opNodeVersion, opGethVersion, strings.Join(required, " or "))
+	}
+	return nil
 }
 
 // GetCompatibleOpGethVersion returns the compatible op-geth version for a given OP Stack version
@@ -114,8 +151,8 @@ func GetCompatibleOpGethVersion(opStackVersion string) (string, error) {
 	if len(parts) >= 2 {
 		majorMinor := strings.Join(parts[:2], ".")
 
-		// Look up in VersionCompatibilityMatrix
-		for matrixName, versionSet := range VersionCompatibilityMatrix {
+		// Look up in the compatibility matrix and the loaded version catalog
+		for matrixName, versionSet := range versionSets() {
 			if strings.Contains(matrixName, majorMinor) || versionSet.OpNode == opStackVersion {
 				return versionSet.OpGeth, nil
 			}
@@ -126,9 +163,10 @@ func GetCompatibleOpGethVersion(opStackVersion string) (string, error) {
 	return DefaultOpGethVersion, nil
 }
 
-// GetVersionSet returns a complete version set for a given version name
+// GetVersionSet returns a complete version set for a given version name, including
+// sets loaded from the operator's version catalog
 func GetVersionSet(versionName string) (VersionSet, error) {
-	if versionSet, exists := VersionCompatibilityMatrix[versionName]; exists {
+	if versionSet, exists := versionSets()[versionName]; exists {
 		return versionSet, nil
 	}
 
@@ -152,18 +190,22 @@ func (ic *ImageConfig) ApplyVersionSet(versionSet VersionSet) {
 	if versionSet.OpChallenger != "" {
 		ic.OpChallenger = fmt.Sprintf("%s/op-challenger:%s", OptimismRegistry, versionSet.OpChallenger)
 	}
+	if versionSet.OpConductor != "" {
+		ic.OpConductor = fmt.Sprintf("%s/op-conductor:%s", OptimismRegistry, versionSet.OpConductor)
+	}
 }
 
-// extractVersionFromImage extracts the version tag from a container image
+// extractVersionFromImage extracts the version tag from a container image.
+// Digest references and untagged images have no version.
 func extractVersionFromImage(image string) string {
-	if image == "" {
+	if image == "" || strings.Contains(image, "@") {
 		return ""
 	}
 
-	// Split by ':' to get tag
-	parts := strings.Split(image, ":")
-	if len(parts) >= 2 {
-		return parts[len(parts)-1] // Return the last part (tag)
+	// A colon before the last slash belongs to a registry port, not a tag
+	name := image[strings.LastIndex(image, "/")+1:]
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		return name[i+1:]
 	}
 
 	return ""
@@ -180,6 +222,7 @@ type ImageOverrides struct {
 	OpBatcherImage    string `json:"opBatcherImage,omitempty"`
 	OpProposerImage   string `json:"opProposerImage,omitempty"`
 	OpChallengerImage string `json:"opChallengerImage,omitempty"`
+	OpConductorImage  string `json:"opConductorImage,omitempty"`
 
 	// Version set name from compatibility matrix
 	VersionSet string `json:"versionSet,omitempty"`
@@ -187,7 +230,23 @@ type ImageOverrides struct {
 
 // BuildImageConfig creates an ImageConfig from overrides
 func BuildImageConfig(overrides ImageOverrides) (ImageConfig, error) {
-	config := DefaultImages
+	config, err := DefaultImages.WithOverrides(overrides)
+	if err != nil {
+		return config, err
+	}
+
+	// Validate final configuration
+	if err := config.ValidateImageCompatibility(); err != nil {
+		return config, fmt.Errorf("image compatibility validation failed: %w", err)
+	}
+
+	return config, nil
+}
+
+// WithOverrides returns a copy of the config with overrides applied, so
+// overrides can be layered (e.g. network-level, then per-resource)
+func (ic ImageConfig) WithOverrides(overrides ImageOverrides) (ImageConfig, error) {
+	config := ic
 
 	// Apply version set if specified
 	if overrides.VersionSet != "" {
@@ -222,10 +281,8 @@ func BuildImageConfig(overrides ImageOverrides) (ImageConfig, error) {
 	if overrides.OpChallengerImage != "" {
 		config.OpChallenger = overrides.OpChallengerImage
 	}
-
-	// Validate final configuration
-	if err := config.ValidateImageCompatibility(); err != nil {
-		return config, fmt.Errorf("image compatibility validation failed: %w", err)
+	if overrides.OpConductorImage != "" {
+		config.OpConductor = overrides.OpConductorImage
 	}
 
 	return config, nil
@@ -2,19 +2,32 @@ package discovery
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/superchain"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/utils"
 )
 
-// ContractDiscoveryService handles automatic discovery of OP Stack contract addresses
+// ContractDiscoveryService handles automatic discovery of OP Stack contract addresses.
+// It is safe for concurrent use once its registry is set.
 type ContractDiscoveryService struct {
+	mu           sync.Mutex
 	cache        map[string]*CachedNetworkAddresses
 	cacheTimeout time.Duration
+	registry     *superchain.Registry
 }
 
 // CachedNetworkAddresses contains cached contract addresses with expiration
@@ -31,14 +44,25 @@ func NewContractDiscoveryService(cacheTimeout time.Duration) *ContractDiscoveryS
 	}
 }
 
-// DiscoverContracts discovers contract addresses for the given OptimismNetwork
+// SetRegistry sets the superchain registry used for offline discovery. It must be
+// called before the service is shared; without one, the embedded snapshot is used.
+func (c *ContractDiscoveryService) SetRegistry(registry *superchain.Registry) {
+	c.registry = registry
+}
+
+// DiscoverContracts discovers contract addresses for the given OptimismNetwork, reading
+// L1 state through l1RpcURL, the resolved URL of its active L1 RPC endpoint
 func (c *ContractDiscoveryService) DiscoverContracts(
 	ctx context.Context,
 	network *optimismv1alpha1.OptimismNetwork,
+	l1RpcURL string,
 ) (*optimismv1alpha1.NetworkContractAddresses, error) {
 	// Check cache first
 	cacheKey := fmt.Sprintf("%s-%d", network.Spec.NetworkName, network.Spec.ChainID)
-	if cached, exists := c.cache[cacheKey]; exists && !c.isCacheExpired(cached) {
+	c.mu.Lock()
+	cached, exists := c.cache[cacheKey]
+	c.mu.Unlock()
+	if exists && !c.isCacheExpired(cached) {
 		return cached.Addresses, nil
 	}
 
@@ -53,7 +77,15 @@ func (c *ContractDiscoveryService) DiscoverContracts(
 
 	switch discoveryMethod {
 	case "auto":
-		addresses, err = c.autoDiscoverContracts(ctx, network)
+		addresses, err = c.autoDiscoverContracts(ctx, network, l1RpcURL)
+	case "system-config":
+		if network.Spec.ContractAddresses == nil || network.Spec.ContractAddresses.SystemConfigAddr == "" {
+			return nil, fmt.Errorf("system-config discovery requires contractAddresses.systemConfigAddr")
+		}
+		addresses, err = c.discoverFromSystemConfig(ctx, l1RpcURL, network.Spec.ContractAddresses.SystemConfigAddr)
+		if addresses != nil {
+			addresses.DiscoveryMethod = "system-config"
+		}
 	case "superchain-registry":
 		addresses, err = c.discoverFromSuperchainRegistry(network.Spec.ChainID)
 	case "well-known":
@@ -82,10 +114,12 @@ func (c *ContractDiscoveryService) DiscoverContracts(
 		cacheTimeout = network.Spec.ContractAddresses.CacheTimeout
 	}
 
+	c.mu.Lock()
 	c.cache[cacheKey] = &CachedNetworkAddresses{
 		Addresses: addresses,
 		ExpiresAt: time.Now().Add(cacheTimeout),
 	}
+	c.mu.Unlock()
 
 	return addresses, nil
 }
@@ -94,19 +128,25 @@ func (c *ContractDiscoveryService) DiscoverContracts(
 func (c *ContractDiscoveryService) autoDiscoverContracts(
 	ctx context.Context,
 	network *optimismv1alpha1.OptimismNetwork,
+	l1RpcURL string,
 ) (*optimismv1alpha1.NetworkContractAddresses, error) {
 	// Strategy 1: Query SystemConfig contract if provided
 	if network.Spec.ContractAddresses != nil && network.Spec.ContractAddresses.SystemConfigAddr != "" {
 		addresses, err := c.discoverFromSystemConfig(
 			ctx,
-			network.Spec.L1RpcUrl,
+			l1RpcURL,
 			network.Spec.ContractAddresses.SystemConfigAddr,
 		)
 		if err == nil {
 			addresses.DiscoveryMethod = "system-config"
 			return addresses, nil
 		}
-		// Log warning but continue with other methods
+		// Log warning but continue with other methods; the URL may carry a provider API key
+		message := err.Error()
+		if l1RpcURL != "" {
+			message = strings.ReplaceAll(message, l1RpcURL, "<L1 RPC URL>")
+		}
+		log.FromContext(ctx).Info("SystemConfig discovery failed, falling back", "error", message)
 	}
 
 	// Strategy 2: Query Superchain Registry as fallback
@@ -126,40 +166,188 @@ func (c *ContractDiscoveryService) autoDiscoverContracts(
 	return nil, fmt.Errorf("unable to discover contract addresses using any method")
 }
 
+// systemConfigABI covers the SystemConfig getters used to resolve the other L1 contracts
+const systemConfigABI = `[
+	{"type":"function","name":"optimismPortal","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"l1CrossDomainMessenger","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"l1StandardBridge","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"disputeGameFactory","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"l2OutputOracle","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"batchInbox","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"startBlock","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+// systemConfigQueryTimeout bounds the total time spent querying SystemConfig getters
+const systemConfigQueryTimeout = 30 * time.Second
+
+// L2 predeploy addresses, identical on every OP Stack chain
+const (
+	L2CrossDomainMessengerPredeploy = "0x4200000000000000000000000000000000000007"
+	L2StandardBridgePredeploy       = "0x4200000000000000000000000000000000000010"
+	L2ToL1MessagePasserPredeploy    = "0x4200000000000000000000000000000000000016"
+)
+
 // discoverFromSystemConfig queries the SystemConfig contract for other contract addresses
 func (c *ContractDiscoveryService) discoverFromSystemConfig(
-	_ context.Context,
+	ctx context.Context,
 	l1RpcUrl,
 	systemConfigAddr string,
 ) (*optimismv1alpha1.NetworkContractAddresses, error) {
-	client, err := ethclient.Dial(l1RpcUrl)
+	if !common.IsHexAddress(systemConfigAddr) {
+		return nil, fmt.Errorf("invalid SystemConfig address: %s", systemConfigAddr)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, systemConfigQueryTimeout)
+	defer cancel()
+
+	client, err := ethclient.DialContext(ctx, l1RpcUrl)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to L1 RPC: %w", err)
 	}
 	defer client.Close()
 
-	// For now, return a placeholder implementation
-	// In a full implementation, this would query the SystemConfig contract
-	// using the contract ABI to get the other contract addresses
+	contractABI, err := abi.JSON(strings.NewReader(systemConfigABI))
+	if err != nil {
+		return nil, err
+	}
+	systemConfig := common.HexToAddress(systemConfigAddr)
 
 	addresses := &optimismv1alpha1.NetworkContractAddresses{
-		SystemConfigAddr: systemConfigAddr,
-		// TODO: Query actual contract for these addresses
-		// L2OutputOracleAddr: systemConfig.L2OutputOracle().Hex(),
-		// DisputeGameFactoryAddr: systemConfig.DisputeGameFactory().Hex(),
-		// OptimismPortalAddr: systemConfig.OptimismPortal().Hex(),
+		SystemConfigAddr:           systemConfig.Hex(),
+		L2CrossDomainMessengerAddr: L2CrossDomainMessengerPredeploy,
+		L2StandardBridgeAddr:       L2StandardBridgePredeploy,
+		L2ToL1MessagePasserAddr:    L2ToL1MessagePasserPredeploy,
+	}
+
+	// Getters present on every SystemConfig version
+	required := []struct {
+		method string
+		target *string
+	}{
+		{"optimismPortal", &addresses.OptimismPortalAddr},
+		{"l1CrossDomainMessenger", &addresses.L1CrossDomainMessengerAddr},
+		{"l1StandardBridge", &addresses.L1StandardBridgeAddr},
+		{"batchInbox", &addresses.BatchInboxAddr},
+	}
+	for _, getter := range required {
+		out, err := callSystemConfig(ctx, client, contractABI, systemConfig, getter.method)
+		if err != nil {
+			return nil, err
+		}
+		*getter.target = addressOrEmpty(out[0].(common.Address))
+	}
+
+	// A chain uses either the DisputeGameFactory or the legacy L2OutputOracle,
+	// and older or newer SystemConfig versions may not expose both getters. A
+	// missing getter reverts; any other failure aborts discovery so a flaky L1
+	// RPC does not leave the address empty
+	optional := []struct {
+		method string
+		target *string
+	}{
+		{"disputeGameFactory", &addresses.DisputeGameFactoryAddr},
+		{"l2OutputOracle", &addresses.L2OutputOracleAddr},
+	}
+	for _, getter := range optional {
+		out, err := callSystemConfig(ctx, client, contractABI, systemConfig, getter.method)
+		if utils.IsExecutionReverted(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		*getter.target = addressOrEmpty(out[0].(common.Address))
+	}
+
+	out, err := callSystemConfig(ctx, client, contractABI, systemConfig, "startBlock")
+	if err != nil {
+		return nil, err
+	}
+	addresses.SystemConfigStartBlock = out[0].(*big.Int).Int64()
+
+	if addresses.OptimismPortalAddr == "" {
+		return nil, fmt.Errorf("SystemConfig at %s returned no OptimismPortal address", systemConfig.Hex())
 	}
 
 	return addresses, nil
 }
 
-// discoverFromSuperchainRegistry discovers addresses from the Superchain Registry
+// callSystemConfig performs an eth_call against a SystemConfig getter and unpacks the result
+func callSystemConfig(
+	ctx context.Context,
+	caller ethereum.ContractCaller,
+	contractABI abi.ABI,
+	systemConfig common.Address,
+	method string,
+) ([]interface{}, error) {
+	data, err := contractABI.Pack(method)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s call: %w", method, err)
+	}
+
+	result, err := caller.CallContract(ctx, ethereum.CallMsg{To: &systemConfig, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("SystemConfig.%s() call failed: %w", method, err)
+	}
+
+	out, err := contractABI.Unpack(method, result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack SystemConfig.%s() result: %w", method, err)
+	}
+
+	return out, nil
+}
+
+// addressOrEmpty returns the checksummed address, or an empty string for the zero address
+func addressOrEmpty(address common.Address) string {
+	if address == (common.Address{}) {
+		return ""
+	}
+	return address.Hex()
+}
+
+// discoverFromSuperchainRegistry resolves addresses from the superchain registry
 func (c *ContractDiscoveryService) discoverFromSuperchainRegistry(
 	chainID int64,
 ) (*optimismv1alpha1.NetworkContractAddresses, error) {
-	// Placeholder implementation - in a full implementation, this would query
-	// the Superchain Registry API or embedded registry data
-	return nil, fmt.Errorf("superchain registry discovery not yet implemented")
+	registry := c.registry
+	if registry == nil {
+		var err error
+		if registry, err = superchain.Embedded(); err != nil {
+			return nil, err
+		}
+	}
+
+	chain, ok := registry.ChainByID(chainID)
+	if !ok || chain.Address(superchain.OptimismPortalProxy) == "" {
+		return nil, fmt.Errorf("chain ID %d is not in the superchain registry", chainID)
+	}
+
+	addresses := &optimismv1alpha1.NetworkContractAddresses{
+		SystemConfigAddr:           chain.Address(superchain.SystemConfigProxy),
+		OptimismPortalAddr:         chain.Address(superchain.OptimismPortalProxy),
+		L1CrossDomainMessengerAddr: chain.Address(superchain.L1CrossDomainMessengerProxy),
+		L1StandardBridgeAddr:       chain.Address(superchain.L1StandardBridgeProxy),
+		L2OutputOracleAddr:         chain.Address(superchain.L2OutputOracleProxy),
+		DisputeGameFactoryAddr:     chain.Address(superchain.DisputeGameFactoryProxy),
+		L2CrossDomainMessengerAddr: L2CrossDomainMessengerPredeploy,
+		L2StandardBridgeAddr:       L2StandardBridgePredeploy,
+		L2ToL1MessagePasserAddr:    L2ToL1MessagePasserPredeploy,
+		DiscoveryMethod:            "superchain-registry",
+	}
+
+	// The batch inbox is only recorded in the rollup config
+	if len(chain.RollupConfig) > 0 {
+		var rollup struct {
+			BatchInboxAddress string `json:"batch_inbox_address"`
+		}
+		if err := json.Unmarshal(chain.RollupConfig, &rollup); err != nil {
+			return nil, fmt.Errorf("invalid rollup config for chain ID %d: %w", chainID, err)
+		}
+		addresses.BatchInboxAddr = rollup.BatchInboxAddress
+	}
+
+	return addresses, nil
 }
 
 // getWellKnownAddresses returns well-known contract addresses for official networks
@@ -177,9 +365,9 @@ func (c *ContractDiscoveryService) getWellKnownAddresses(
 			L1CrossDomainMessengerAddr: "0x25ace71c97B33Cc4729CF772ae268934F7ab5fA1",
 			L1StandardBridgeAddr:       "0x99C9fc46f92E8a1c0deC1b1747d010903E884bE1",
 			// L2 predeploys
-			L2CrossDomainMessengerAddr: "0x4200000000000000000000000000000000000007",
-			L2StandardBridgeAddr:       "0x4200000000000000000000000000000000000010",
-			L2ToL1MessagePasserAddr:    "0x4200000000000000000000000000000000000016",
+			L2CrossDomainMessengerAddr: L2CrossDomainMessengerPredeploy,
+			L2StandardBridgeAddr:       L2StandardBridgePredeploy,
+			L2ToL1MessagePasserAddr:    L2ToL1MessagePasserPredeploy,
 			DiscoveryMethod:            "well-known",
 		}
 	case networkName == "op-sepolia" || chainID == 11155420:
@@ -191,9 +379,9 @@ func (c *ContractDiscoveryService) getWellKnownAddresses(
 			L1CrossDomainMessengerAddr: "0x58Cc85b8D04EA49cC6DBd3CbFFd00B4B8D6cb3ef",
 			L1StandardBridgeAddr:       "0xFBb0621E0B23b5478B630BD55a5f21f67730B0F1",
 			// L2 predeploys (same across all OP Stack chains)
-			L2CrossDomainMessengerAddr: "0x4200000000000000000000000000000000000007",
-			L2StandardBridgeAddr:       "0x4200000000000000000000000000000000000010",
-			L2ToL1MessagePasserAddr:    "0x4200000000000000000000000000000000000016",
+			L2CrossDomainMessengerAddr: L2CrossDomainMessengerPredeploy,
+			L2StandardBridgeAddr:       L2StandardBridgePredeploy,
+			L2ToL1MessagePasserAddr:    L2ToL1MessagePasserPredeploy,
 			DiscoveryMethod:            "well-known",
 		}
 	case networkName == "base-mainnet" || chainID == 8453:
@@ -205,9 +393,9 @@ func (c *ContractDiscoveryService) getWellKnownAddresses(
 			L1CrossDomainMessengerAddr: "0x866E82a600A1414e583f7F13623F1aC5d58b0Afa",
 			L1StandardBridgeAddr:       "0x3154Cf16ccdb4C6d922629664174b904d80F2C35",
 			// L2 predeploys
-			L2CrossDomainMessengerAddr: "0x4200000000000000000000000000000000000007",
-			L2StandardBridgeAddr:       "0x4200000000000000000000000000000000000010",
-			L2ToL1MessagePasserAddr:    "0x4200000000000000000000000000000000000016",
+			L2CrossDomainMessengerAddr: L2CrossDomainMessengerPredeploy,
+			L2StandardBridgeAddr:       L2StandardBridgePredeploy,
+			L2ToL1MessagePasserAddr:    L2ToL1MessagePasserPredeploy,
 			DiscoveryMethod:            "well-known",
 		}
 	default:
@@ -239,5 +427,7 @@ func (c *ContractDiscoveryService) isCacheExpired(cached *CachedNetworkAddresses
 
 // ClearCache clears the contract address cache
 func (c *ContractDiscoveryService) ClearCache() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.cache = make(map[string]*CachedNetworkAddresses)
 }
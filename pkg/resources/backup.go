@@ -0,0 +1,70 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+// VolumeSnapshotGVK identifies CSI VolumeSnapshots. They are handled as unstructured
+// objects so the operator does not depend on the external-snapshotter client.
+var VolumeSnapshotGVK = schema.GroupVersionKind{Group: volumeSnapshotAPIGroup, Version: "v1", Kind: "VolumeSnapshot"}
+
+// Labels and annotations of backup VolumeSnapshots
+const (
+	// BackupOfLabel names the OpNode a VolumeSnapshot backs up
+	BackupOfLabel = "optimism.io/backup-of"
+
+	// MaxBlockNumberAnnotation and MaxSafeBlockNumberAnnotation record the L2 heads read right
+	// after the snapshot was cut, upper bounds of the heads a restore recovers
+	MaxBlockNumberAnnotation     = "optimism.io/max-block-number"
+	MaxSafeBlockNumberAnnotation = "optimism.io/max-safe-block-number"
+)
+
+// OpGethDataClaimName returns the name of the op-geth data PVC of a pod ordinal
+func OpGethDataClaimName(opNode *optimismv1alpha1.OpNode, ordinal int32) string {
+	return fmt.Sprintf("geth-data-%s-%d", opNode.Name, ordinal)
+}
+
+// CreateOpGethVolumeSnapshot creates a VolumeSnapshot of the op-geth data volume of the
+// first pod. Backups carry no owner reference so they outlive the OpNode.
+func CreateOpGethVolumeSnapshot(opNode *optimismv1alpha1.OpNode, name string) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": OpGethDataClaimName(opNode, 0),
+		},
+	}
+	if className := opNode.Spec.Backup.VolumeSnapshotClassName; className != "" {
+		spec["volumeSnapshotClassName"] = className
+	}
+
+	snapshot := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	snapshot.SetGroupVersionKind(VolumeSnapshotGVK)
+	snapshot.SetName(name)
+	snapshot.SetNamespace(opNode.Namespace)
+	snapshot.SetLabels(map[string]string{
+		"app.kubernetes.io/instance":   opNode.Name,
+		"app.kubernetes.io/managed-by": "op-stack-operator",
+		BackupOfLabel:                  opNode.Name,
+	})
+	return snapshot
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+// ConductorRaftDir is where op-conductor keeps its Raft state, on the geth-data volume
+const ConductorRaftDir = "/data/conductor"
+
+const (
+	// ConductorRaftAnnotation is set on HA sequencer pods by the OpNode controller. It tells a
+	// member that starts with empty Raft storage whether to bootstrap a new Raft cluster or to
+	// wait until the leader adds it to the existing one.
+	ConductorRaftAnnotation = "optimism.io/conductor-raft"
+	// ConductorRaftBootstrap lets a member bootstrap the Raft cluster
+	ConductorRaftBootstrap = "bootstrap"
+	// ConductorRaftJoin makes a member wait to be added by the leader
+	ConductorRaftJoin = "join"
+)
+
+// conductorPodInfoDir is where the annotations of an HA sequencer pod are projected
+const conductorPodInfoDir = "/etc/podinfo"
+
+// A member with Raft state restarts from it. A member without Raft state waits for the
+// OpNode controller to annotate its pod and bootstraps only when told to, so a member that
+// lost its volume cannot bootstrap a second cluster next to the running one. Every op-node
+// starts with its sequencer stopped; op-conductor starts the sequencer of the elected leader.
+const conductorCommand = `if [ -z "$(ls -A ` + ConductorRaftDir + ` 2>/dev/null)" ]; then ` +
+	`until grep -q '^` + ConductorRaftAnnotation + `=' ` + conductorPodInfoDir + `/annotations 2>/dev/null; do sleep 2; done; ` +
+	`if grep -q '^` + ConductorRaftAnnotation + `="` + ConductorRaftBootstrap + `"$' ` + conductorPodInfoDir + `/annotations; then set -- "$@" --raft.bootstrap; fi; ` +
+	`fi; exec op-conductor "$@"`
+
+// HAEnabled reports whether the OpNode is a sequencer run as an op-conductor cluster
+func HAEnabled(opNode *optimismv1alpha1.OpNode) bool {
+	return opNode.Spec.NodeType == "sequencer" && opNode.Spec.HA != nil && opNode.Spec.HA.Enabled
+}
+
+// ConductorMembers returns the number of members of the op-conductor cluster
+func ConductorMembers(opNode *optimismv1alpha1.OpNode) int32 {
+	return getDefaultInt32(opNode.Spec.HA.Members, DefaultConductorMembers)
+}
+
+// ConductorRaftPort returns the op-conductor consensus port
+func ConductorRaftPort(opNode *optimismv1alpha1.OpNode) int32 {
+	return getDefaultInt32(opNode.Spec.HA.RaftPort, DefaultConductorRaftPort)
+}
+
+// ConductorRPCPort returns the op-conductor RPC port
+func ConductorRPCPort(opNode *optimismv1alpha1.OpNode) int32 {
+	return getDefaultInt32(opNode.Spec.HA.RPCPort, DefaultConductorRPCPort)
+}
+
+// ConductorPodHost returns the stable DNS name of an OpNode pod behind the headless Service
+func ConductorPodHost(opNode *optimismv1alpha1.OpNode, pod string) string {
+	return fmt.Sprintf("%s.%s.%s.svc.cluster.local", pod, HeadlessServiceName(opNode), opNode.Namespace)
+}
+
+// ConductorRaftAddress returns the Raft address a member pod advertises
+func ConductorRaftAddress(opNode *optimismv1alpha1.OpNode, pod string) string {
+	return fmt.Sprintf("%s:%d", ConductorPodHost(opNode, pod), ConductorRaftPort(opNode))
+}
+
+// ConductorRPCEndpoint returns the op-conductor RPC endpoint of a member pod
+func ConductorRPCEndpoint(opNode *optimismv1alpha1.OpNode, pod string) string {
+	return fmt.Sprintf("http://%s:%d", ConductorPodHost(opNode, pod), ConductorRPCPort(opNode))
+}
+
+// podIndexEnv exposes the StatefulSet pod ordinal as $POD_INDEX
+func podIndexEnv() corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: "POD_INDEX",
+		ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.labels['" + podIndexLabel + "']"},
+		},
+	}
+}
+
+// createOpConductorContainer creates the op-conductor sidecar of an HA sequencer pod
+func createOpConductorContainer(
+	opNode *optimismv1alpha1.OpNode,
+	network *optimismv1alpha1.OptimismNetwork,
+) corev1.Container {
+	healthCheck := opNode.Spec.HA.HealthCheck
+	if healthCheck == nil {
+		healthCheck = &optimismv1alpha1.ConductorHealthCheck{}
+	}
+
+	raftPort := ConductorRaftPort(opNode)
+	rpcPort := ConductorRPCPort(opNode)
+	args := []string{
+		"--consensus.addr=" + DefaultListenHost,
+		fmt.Sprintf("--consensus.port=%d", raftPort),
+		"--consensus.advertised=" + ConductorRaftAddress(opNode, "$(POD_NAME)"),
+		"--raft.server.id=$(POD_NAME)",
+		"--raft.storage.dir=" + ConductorRaftDir,
+		fmt.Sprintf("--node.rpc=http://127.0.0.1:%d", getOpNodeRPCPort(opNode)),
+		fmt.Sprintf("--execution.rpc=http://127.0.0.1:%d", getOpGethHTTPPort(opNode)),
+		"--rollup.config=/config/" + RollupConfigKey,
+		fmt.Sprintf("--healthcheck.interval=%d", getDefaultInt32(healthCheck.Interval, DefaultConductorHealthInterval)),
+		fmt.Sprintf("--healthcheck.unsafe-interval=%d", getDefaultInt32(healthCheck.UnsafeInterval, DefaultConductorUnsafeInterval)),
+		fmt.Sprintf("--healthcheck.safe-interval=%d", getDefaultInt32(healthCheck.SafeInterval, DefaultConductorSafeInterval)),
+		fmt.Sprintf("--healthcheck.min-peer-count=%d", getDefaultInt32(healthCheck.MinPeerCount, DefaultConductorMinPeerCount)),
+		"--rpc.addr=" + DefaultListenHost,
+		fmt.Sprintf("--rpc.port=%d", rpcPort),
+	}
+
+	images := imagesFor(network, opNode)
+	return corev1.Container{
+		Name:            "op-conductor",
+		Image:           images.OpConductor,
+		ImagePullPolicy: images.PullPolicy,
+		Command:         []string{"/bin/sh", "-c", conductorCommand, "op-conductor"},
+		Args:            args,
+		Env: []corev1.EnvVar{
+			{
+				Name: "POD_NAME",
+				ValueFrom: &corev1.EnvVarSource{
+					FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.name"},
+				},
+			},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("128Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+			},
+		},
+		Ports: []corev1.ContainerPort{
+			{Name: "conductor-rpc", ContainerPort: rpcPort, Protocol: corev1.ProtocolTCP},
+			{Name: "raft", ContainerPort: raftPort, Protocol: corev1.ProtocolTCP},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "geth-data", MountPath: ConductorRaftDir, SubPath: "conductor"},
+			{Name: "rollup-config", MountPath: "/config", ReadOnly: true},
+			{Name: "pod-info", MountPath: conductorPodInfoDir, ReadOnly: true},
+		},
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(rpcPort)},
+			},
+			InitialDelaySeconds: 10,
+			PeriodSeconds:       10,
+		},
+	}
+}
+
+// buildConductorServicePorts returns the op-conductor ports of an HA sequencer
+func buildConductorServicePorts(opNode *optimismv1alpha1.OpNode) []corev1.ServicePort {
+	if !HAEnabled(opNode) {
+		return nil
+	}
+	rpcPort := ConductorRPCPort(opNode)
+	raftPort := ConductorRaftPort(opNode)
+	return []corev1.ServicePort{
+		{Name: "conductor-rpc", Port: rpcPort, TargetPort: intstr.FromInt32(rpcPort), Protocol: corev1.ProtocolTCP},
+		{Name: "raft", Port: raftPort, TargetPort: intstr.FromInt32(raftPort), Protocol: corev1.ProtocolTCP},
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/config"
+)
+
+// GethInitContainerName is the init container that runs geth init with the op-geth image
+const GethInitContainerName = "geth-init"
+
+// SnapshotRestoredMarker is created in the datadir once a snapshot archive has been extracted
+const SnapshotRestoredMarker = ".snapshot-restored"
+
+// SnapshotRestoringMarker is created in the datadir while a snapshot archive is extracted. A
+// datadir holding it is partial, e.g. after an OOM kill or eviction, and is wiped before use.
+const SnapshotRestoringMarker = ".snapshot-restoring"
+
+// volumeSnapshotAPIGroup is the API group of CSI VolumeSnapshots
+const volumeSnapshotAPIGroup = "snapshot.storage.k8s.io"
+
+// wipePartialRestore removes the datadir content left by an interrupted snapshot restore
+const wipePartialRestore = `find "$DATADIR" -mindepth 1 -maxdepth 1 ! -name lost+found ! -name ` + SnapshotRestoringMarker + ` -exec rm -rf {} +`
+
+// snapshotRestoreScript streams $SNAPSHOT_URL into $DATADIR, hashing it on the way so the
+// archive never has to fit on the volume next to its content. The URL suffix selects the
+// decompressor, since tar cannot detect compression on a stream. An interrupted or unverified
+// restore is wiped and retried; a datadir that holds chain data from elsewhere is left alone.
+const snapshotRestoreScript = `set -eu -o pipefail
+if [ -e "$DATADIR/` + SnapshotRestoringMarker + `" ]; then
+  echo "removing the partial datadir of an interrupted snapshot restore"
+  ` + wipePartialRestore + `
+elif [ -e "$DATADIR/` + SnapshotRestoredMarker + `" ] || [ -d "$DATADIR/geth/chaindata" ]; then
+  echo "datadir already initialized, skipping snapshot restore"
+  exit 0
+fi
+touch "$DATADIR/` + SnapshotRestoringMarker + `"
+case "${SNAPSHOT_URL%%\?*}" in
+  *.gz|*.tgz) decompress=zcat ;;
+  *.bz2|*.tbz2) decompress=bzcat ;;
+  *.xz|*.txz) decompress=xzcat ;;
+  *) decompress=cat ;;
+esac
+if [ -n "${SNAPSHOT_SHA256:-}" ]; then
+  rm -f /tmp/snapshot.fifo
+  mkfifo /tmp/snapshot.fifo
+  sha256sum </tmp/snapshot.fifo >/tmp/snapshot.sha256 &
+  wget -q -O - "$SNAPSHOT_URL" | tee /tmp/snapshot.fifo | $decompress | tar -xf - -C "$DATADIR"
+  wait $!
+  expected="$(echo "$SNAPSHOT_SHA256" | tr 'A-F' 'a-f')"
+  if [ "$(cut -d ' ' -f 1 /tmp/snapshot.sha256)" != "$expected" ]; then
+    echo "snapshot archive does not match sha256 $expected" >&2
+    exit 1
+  fi
+else
+  wget -q -O - "$SNAPSHOT_URL" | $decompress | tar -xf - -C "$DATADIR"
+fi
+touch "$DATADIR/` + SnapshotRestoredMarker + `"
+rm -f "$DATADIR/` + SnapshotRestoringMarker + `"`
+
+// gethInitScript runs geth init with the arguments passed to it unless the datadir already
+// holds chain data, e.g. from an earlier start or a restored snapshot. The partial datadir of
+// an interrupted restore, left when the snapshot source was removed, is wiped first.
+const gethInitScript = `set -eu
+if [ -e "$DATADIR/` + SnapshotRestoringMarker + `" ]; then
+  echo "removing the partial datadir of an interrupted snapshot restore"
+  ` + wipePartialRestore + `
+  rm -f "$DATADIR/` + SnapshotRestoringMarker + `"
+elif [ -d "$DATADIR/geth/chaindata" ]; then
+  echo "datadir already initialized, skipping geth init"
+  exit 0
+fi
+exec geth init "$@"`
+
+// opGethSnapshot returns the configured snapshot source of the op-geth datadir, if any
+func opGethSnapshot(opNode *optimismv1alpha1.OpNode) *optimismv1alpha1.SnapshotSource {
+	if opNode.Spec.OpGeth.Storage == nil {
+		return nil
+	}
+	return opNode.Spec.OpGeth.Storage.Snapshot
+}
+
+// opGethDataSource returns the data source new op-geth volumes are provisioned from
+func opGethDataSource(opNode *optimismv1alpha1.OpNode) *corev1.TypedLocalObjectReference {
+	snapshot := opGethSnapshot(opNode)
+	switch {
+	case snapshot == nil:
+		return nil
+	case snapshot.VolumeSnapshotName != "":
+		apiGroup := volumeSnapshotAPIGroup
+		return &corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VolumeSnapshot", Name: snapshot.VolumeSnapshotName}
+	case snapshot.PersistentVolumeClaimName != "":
+		return &corev1.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: snapshot.PersistentVolumeClaimName}
+	}
+	return nil
+}
+
+// createInitContainers creates the init containers that prepare the op-geth datadir. A snapshot
+// is restored first; geth init then only writes the genesis of an otherwise empty datadir.
+// Nodes of a named network load the genesis from the op-geth registry instead.
+func createInitContainers(opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.OptimismNetwork) []corev1.Container {
+	var initContainers []corev1.Container
+	if snapshot := opGethSnapshot(opNode); snapshot != nil && snapshot.URL != "" {
+		initContainers = append(initContainers, createSnapshotRestoreContainer(opNode, snapshot))
+	}
+	if hasInitGenesis(network) && opNode.Spec.OpGeth.Network == "" {
+		initContainers = append(initContainers, createGethInitContainer(opNode, network))
+	}
+	return initContainers
+}
+
+// hasInitGenesis reports whether the network provides a genesis geth init may write. geth init
+// is permanent for a datadir, so only inline, referenced or registry-sourced genesis qualifies;
+// the network controller fails auto-discovery for chains outside the superchain registry.
+// A genesis bundled with op-geth needs no init.
+func hasInitGenesis(network *optimismv1alpha1.OptimismNetwork) bool {
+	source := network.Spec.L2Genesis
+	if _, ok := GenesisSelector(network); !ok {
+		return false
+	}
+	return source.Inline != "" || source.ConfigMapRef != nil || source.AutoDiscover
+}
+
+// createGethInitContainer creates the init container that initializes an empty datadir from the L2 genesis
+func createGethInitContainer(opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.OptimismNetwork) corev1.Container {
+	dataDir := getDefaultString(opNode.Spec.OpGeth.DataDir, DefaultOpGethDataDir)
+
+	// The state scheme and database engine are fixed when the database is created
+	args := []string{"--datadir=" + dataDir}
+	if stateScheme := OpGethStateScheme(&opNode.Spec.OpGeth); stateScheme != "" {
+		args = append(args, "--state.scheme="+stateScheme)
+	}
+	if opNode.Spec.OpGeth.DBEngine != "" {
+		args = append(args, "--db.engine="+opNode.Spec.OpGeth.DBEngine)
+	}
+	args = append(args, "/genesis/"+GenesisKey)
+
+	images := imagesFor(network, opNode)
+	return corev1.Container{
+		Name:            GethInitContainerName,
+		Image:           images.OpGeth,
+		ImagePullPolicy: images.PullPolicy,
+		Command:         []string{"/bin/sh", "-c", gethInitScript, "geth-init"},
+		Args:            args,
+		Env:             []corev1.EnvVar{{Name: "DATADIR", Value: dataDir}},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2000m"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "geth-data", MountPath: dataDir},
+			{Name: "l2-genesis", MountPath: "/genesis", ReadOnly: true},
+		},
+	}
+}
+
+// createSnapshotRestoreContainer creates the init container that seeds an empty datadir from a snapshot archive
+func createSnapshotRestoreContainer(opNode *optimismv1alpha1.OpNode, snapshot *optimismv1alpha1.SnapshotSource) corev1.Container {
+	dataDir := getDefaultString(opNode.Spec.OpGeth.DataDir, DefaultOpGethDataDir)
+
+	return corev1.Container{
+		Name:            "snapshot-restore",
+		Image:           config.DefaultSnapshotImage,
+		ImagePullPolicy: corev1.PullPolicy(config.DefaultCacheConfig.PullPolicy),
+		Command:         []string{"/bin/sh", "-c", snapshotRestoreScript},
+		Env: []corev1.EnvVar{
+			{Name: "DATADIR", Value: dataDir},
+			{Name: "SNAPSHOT_URL", Value: snapshot.URL},
+			{Name: "SNAPSHOT_SHA256", Value: snapshot.SHA256},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2000m"),
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "geth-data", MountPath: dataDir},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+// Defaults applied when optional ports, addresses and settings are left unset.
+// The admission webhooks persist the same values so stored specs match what is deployed.
+const (
+	DefaultListenHost  = "0.0.0.0"
+	DefaultAuthRPCHost = "127.0.0.1"
+
+	DefaultOpGethHTTPPort    int32 = 8545
+	DefaultOpGethWSPort      int32 = 8546
+	DefaultOpGethAuthRPCPort int32 = 8551
+	DefaultOpGethP2PPort     int32 = 30303
+	DefaultOpGethDataDir           = "/data/geth"
+	DefaultOpGethStorageSize       = "1Ti"
+	DefaultOpGethSyncMode          = "snap"
+
+	DefaultOpNodeRPCPort int32 = 9545
+	DefaultOpNodeP2PPort int32 = 9003
+
+	// op-conductor sidecar of HA sequencers
+	DefaultConductorMembers        int32 = 3
+	DefaultConductorRaftPort       int32 = 50050
+	DefaultConductorRPCPort        int32 = 8547
+	DefaultConductorHealthInterval int32 = 1
+	DefaultConductorUnsafeInterval int32 = 5
+	DefaultConductorSafeInterval   int32 = 1200
+	DefaultConductorMinPeerCount   int32 = 1
+
+	DefaultOpBatcherRPCPort  int32 = 8548
+	DefaultOpProposerRPCPort int32 = 8560
+	DefaultProposalInterval        = "1h"
+
+	DefaultMetricsPort int32 = 7300
+
+	DefaultUpgradeHealthTimeout = "15m"
+
+	DefaultAutoGrowThresholdPercent int32 = 80
+	DefaultAutoGrowIncreasePercent  int32 = 20
+
+	DefaultBackupInterval        = "24h"
+	DefaultBackupRetention int32 = 7
+
+	DefaultL1ProbeInterval          = "30s"
+	DefaultL1HysteresisWindow       = "2m"
+	DefaultL1MaxHeadLag       int64 = 3
+
+	// Fault proof VM locations inside the op-challenger image
+	DefaultCannonBinaryPath = "/usr/local/bin/cannon"
+	DefaultCannonServerPath = "/usr/local/bin/op-program"
+)
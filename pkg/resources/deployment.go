@@ -0,0 +1,515 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+// CreateOpBatcherDeployment creates a Deployment for OpBatcher
+func CreateOpBatcherDeployment(
+	opBatcher *optimismv1alpha1.OpBatcher,
+	network *optimismv1alpha1.OptimismNetwork,
+	sequencer *optimismv1alpha1.OpNode,
+) *appsv1.Deployment {
+	labels := OpBatcherLabels(opBatcher, network)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opBatcher.Name,
+			Namespace: opBatcher.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			// Only a single batcher may submit for a chain at any time
+			Replicas: int32Ptr(1),
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RecreateDeploymentStrategyType,
+			},
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						createOpBatcherContainer(opBatcher, network, sequencer),
+					},
+					SecurityContext: createPodSecurityContext(network),
+				},
+			},
+		},
+	}
+
+	return deployment
+}
+
+// OpBatcherLabels returns the labels applied to all OpBatcher resources
+func OpBatcherLabels(opBatcher *optimismv1alpha1.OpBatcher, network *optimismv1alpha1.OptimismNetwork) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "opbatcher",
+		"app.kubernetes.io/instance":   opBatcher.Name,
+		"app.kubernetes.io/component":  "batcher",
+		"app.kubernetes.io/part-of":    "op-stack",
+		"app.kubernetes.io/managed-by": "op-stack-operator",
+		"optimism.io/network":          network.Spec.NetworkName,
+	}
+}
+
+// createOpBatcherContainer creates the op-batcher container
+func createOpBatcherContainer(
+	opBatcher *optimismv1alpha1.OpBatcher,
+	network *optimismv1alpha1.OptimismNetwork,
+	sequencer *optimismv1alpha1.OpNode,
+) corev1.Container {
+	// Default resource requirements for op-batcher
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("100m"),
+			corev1.ResourceMemory: resource.MustParse("256Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("1000m"),
+			corev1.ResourceMemory: resource.MustParse("2Gi"),
+		},
+	}
+
+	// Override with user-specified resources
+	if opBatcher.Spec.Resources != nil {
+		resources = *opBatcher.Spec.Resources
+	}
+
+	// Build command args; an L1 URL kept in a Secret is passed through the environment
+	args, env := l1RpcSettings(network, "--l1-eth-rpc", "OP_BATCHER_L1_ETH_RPC")
+	args = append(args,
+		"--l2-eth-rpc="+GetOpGethRPCEndpoint(sequencer),
+		"--rollup-rpc="+GetOpNodeRPCEndpoint(sequencer),
+	)
+
+	// Add batching configuration
+	if batching := opBatcher.Spec.Batching; batching != nil {
+		if batching.MaxChannelDuration > 0 {
+			args = append(args, fmt.Sprintf("--max-channel-duration=%d", batching.MaxChannelDuration))
+		}
+		if batching.SubSafetyMargin > 0 {
+			args = append(args, fmt.Sprintf("--sub-safety-margin=%d", batching.SubSafetyMargin))
+		}
+		if batching.TargetNumFrames > 0 {
+			args = append(args, fmt.Sprintf("--target-num-frames=%d", batching.TargetNumFrames))
+		}
+		if batching.PollInterval != "" {
+			args = append(args, "--poll-interval="+batching.PollInterval)
+		}
+	}
+
+	// Add data availability configuration
+	if opBatcher.Spec.DataAvailability != nil && opBatcher.Spec.DataAvailability.Type != "" {
+		args = append(args, "--data-availability-type="+opBatcher.Spec.DataAvailability.Type)
+	}
+
+	// Add throttling configuration
+	if throttling := opBatcher.Spec.Throttling; throttling != nil && throttling.Enabled {
+		if throttling.Threshold > 0 {
+			args = append(args, fmt.Sprintf("--throttle-threshold=%d", throttling.Threshold))
+		}
+		if throttling.TxSize > 0 {
+			args = append(args, fmt.Sprintf("--throttle-tx-size=%d", throttling.TxSize))
+		}
+		if throttling.BlockSize > 0 {
+			args = append(args, fmt.Sprintf("--throttle-block-size=%d", throttling.BlockSize))
+		}
+	}
+
+	// Add L1 transaction manager configuration
+	args = append(args, buildTxManagerArgs(opBatcher.Spec.L1Transaction)...)
+
+	// Add RPC configuration
+	rpcPort := GetOpBatcherRPCPort(opBatcher)
+	if opBatcher.Spec.RPC != nil && opBatcher.Spec.RPC.Enabled {
+		args = append(args, "--rpc.addr="+getDefaultString(opBatcher.Spec.RPC.Host, DefaultListenHost))
+		args = append(args, fmt.Sprintf("--rpc.port=%d", rpcPort))
+		if opBatcher.Spec.RPC.EnableAdmin {
+			args = append(args, "--rpc.enable-admin")
+		}
+	}
+
+	// Add metrics configuration
+	metricsPort := GetOpBatcherMetricsPort(opBatcher)
+	if opBatcher.Spec.Metrics == nil || opBatcher.Spec.Metrics.Enabled {
+		args = append(args, "--metrics.enabled")
+		args = append(args, "--metrics.addr=0.0.0.0")
+		args = append(args, fmt.Sprintf("--metrics.port=%d", metricsPort))
+	}
+
+	// Add logging configuration
+	args = append(args, buildLoggingArgs(network)...)
+
+	ports := []corev1.ContainerPort{
+		{Name: "metrics", ContainerPort: metricsPort, Protocol: corev1.ProtocolTCP},
+	}
+	if opBatcher.Spec.RPC != nil && opBatcher.Spec.RPC.Enabled {
+		ports = append(ports, corev1.ContainerPort{Name: "rpc", ContainerPort: rpcPort, Protocol: corev1.ProtocolTCP})
+	}
+
+	images := imagesFor(network, nil)
+	container := corev1.Container{
+		Name:            "op-batcher",
+		Image:           images.OpBatcher,
+		ImagePullPolicy: images.PullPolicy,
+		Command:         []string{"op-batcher"},
+		Args:            args,
+		Env: append([]corev1.EnvVar{
+			{
+				// The private key is never rendered into the args
+				Name: "OP_BATCHER_PRIVATE_KEY",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: opBatcher.Spec.PrivateKey.SecretRef,
+				},
+			},
+		}, env...),
+		Resources:       resources,
+		Ports:           ports,
+		SecurityContext: createContainerSecurityContext(),
+	}
+
+	// Probe the metrics endpoint since the RPC server is optional
+	if opBatcher.Spec.Metrics == nil || opBatcher.Spec.Metrics.Enabled {
+		container.LivenessProbe, container.ReadinessProbe = createMetricsProbes(metricsPort)
+	}
+
+	return container
+}
+
+// Output contract kinds op-proposer can submit to
+const (
+	OutputContractDisputeGameFactory = "DisputeGameFactory"
+	OutputContractL2OutputOracle     = "L2OutputOracle"
+)
+
+// CreateOpProposerDeployment creates a Deployment for OpProposer
+func CreateOpProposerDeployment(
+	opProposer *optimismv1alpha1.OpProposer,
+	network *optimismv1alpha1.OptimismNetwork,
+	rollupNode *optimismv1alpha1.OpNode,
+) *appsv1.Deployment {
+	labels := OpProposerLabels(opProposer, network)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opProposer.Name,
+			Namespace: opProposer.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			// Concurrent proposers would race on the same nonce
+			Replicas: int32Ptr(1),
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RecreateDeploymentStrategyType,
+			},
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						createOpProposerContainer(opProposer, network, rollupNode),
+					},
+					SecurityContext: createPodSecurityContext(network),
+				},
+			},
+		},
+	}
+
+	return deployment
+}
+
+// OpProposerLabels returns the labels applied to all OpProposer resources
+func OpProposerLabels(opProposer *optimismv1alpha1.OpProposer, network *optimismv1alpha1.OptimismNetwork) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "opproposer",
+		"app.kubernetes.io/instance":   opProposer.Name,
+		"app.kubernetes.io/component":  "proposer",
+		"app.kubernetes.io/part-of":    "op-stack",
+		"app.kubernetes.io/managed-by": "op-stack-operator",
+		"optimism.io/network":          network.Spec.NetworkName,
+	}
+}
+
+// ResolveProposerOutputContract returns the contract op-proposer submits outputs to.
+// The DisputeGameFactory is preferred; the L2OutputOracle is used for pre-fault-proof chains.
+func ResolveProposerOutputContract(network *optimismv1alpha1.OptimismNetwork) (string, string) {
+	if network.Status.NetworkInfo == nil || network.Status.NetworkInfo.DiscoveredContracts == nil {
+		return "", ""
+	}
+
+	contracts := network.Status.NetworkInfo.DiscoveredContracts
+	if contracts.DisputeGameFactoryAddr != "" {
+		return contracts.DisputeGameFactoryAddr, OutputContractDisputeGameFactory
+	}
+	if contracts.L2OutputOracleAddr != "" {
+		return contracts.L2OutputOracleAddr, OutputContractL2OutputOracle
+	}
+	return "", ""
+}
+
+// createOpProposerContainer creates the op-proposer container
+func createOpProposerContainer(
+	opProposer *optimismv1alpha1.OpProposer,
+	network *optimismv1alpha1.OptimismNetwork,
+	rollupNode *optimismv1alpha1.OpNode,
+) corev1.Container {
+	// Default resource requirements for op-proposer
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("100m"),
+			corev1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("500m"),
+			corev1.ResourceMemory: resource.MustParse("512Mi"),
+		},
+	}
+
+	// Override with user-specified resources
+	if opProposer.Spec.Resources != nil {
+		resources = *opProposer.Spec.Resources
+	}
+
+	// Build command args; an L1 URL kept in a Secret is passed through the environment
+	args, env := l1RpcSettings(network, "--l1-eth-rpc", "OP_PROPOSER_L1_ETH_RPC")
+	args = append(args,
+		"--rollup-rpc="+GetOpNodeRPCEndpoint(rollupNode),
+	)
+
+	// Add output contract configuration
+	contractAddr, contractType := ResolveProposerOutputContract(network)
+	switch contractType {
+	case OutputContractDisputeGameFactory:
+		args = append(args, "--game-factory-address="+contractAddr)
+		args = append(args, fmt.Sprintf("--game-type=%d", opProposer.Spec.GameType))
+		args = append(args, "--proposal-interval="+getDefaultString(opProposer.Spec.ProposalInterval, DefaultProposalInterval))
+	case OutputContractL2OutputOracle:
+		args = append(args, "--l2oo-address="+contractAddr)
+	}
+
+	if opProposer.Spec.PollInterval != "" {
+		args = append(args, "--poll-interval="+opProposer.Spec.PollInterval)
+	}
+	if opProposer.Spec.AllowNonFinalized {
+		args = append(args, "--allow-non-finalized")
+	}
+
+	// Add L1 transaction manager configuration
+	args = append(args, buildTxManagerArgs(opProposer.Spec.L1Transaction)...)
+
+	// Add RPC configuration
+	rpcPort := GetOpProposerRPCPort(opProposer)
+	if opProposer.Spec.RPC != nil && opProposer.Spec.RPC.Enabled {
+		args = append(args, "--rpc.addr="+getDefaultString(opProposer.Spec.RPC.Host, DefaultListenHost))
+		args = append(args, fmt.Sprintf("--rpc.port=%d", rpcPort))
+		if opProposer.Spec.RPC.EnableAdmin {
+			args = append(args, "--rpc.enable-admin")
+		}
+	}
+
+	// Add metrics configuration
+	metricsPort := GetOpProposerMetricsPort(opProposer)
+	metricsEnabled := opProposer.Spec.Metrics == nil || opProposer.Spec.Metrics.Enabled
+	if metricsEnabled {
+		args = append(args, "--metrics.enabled")
+		args = append(args, "--metrics.addr=0.0.0.0")
+		args = append(args, fmt.Sprintf("--metrics.port=%d", metricsPort))
+	}
+
+	// Add logging configuration
+	args = append(args, buildLoggingArgs(network)...)
+
+	ports := []corev1.ContainerPort{
+		{Name: "metrics", ContainerPort: metricsPort, Protocol: corev1.ProtocolTCP},
+	}
+	if opProposer.Spec.RPC != nil && opProposer.Spec.RPC.Enabled {
+		ports = append(ports, corev1.ContainerPort{Name: "rpc", ContainerPort: rpcPort, Protocol: corev1.ProtocolTCP})
+	}
+
+	images := imagesFor(network, nil)
+	container := corev1.Container{
+		Name:            "op-proposer",
+		Image:           images.OpProposer,
+		ImagePullPolicy: images.PullPolicy,
+		Command:         []string{"op-proposer"},
+		Args:            args,
+		Env: append([]corev1.EnvVar{
+			{
+				// The private key is never rendered into the args
+				Name: "OP_PROPOSER_PRIVATE_KEY",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: opProposer.Spec.PrivateKey.SecretRef,
+				},
+			},
+		}, env...),
+		Resources:       resources,
+		Ports:           ports,
+		SecurityContext: createContainerSecurityContext(),
+	}
+
+	if metricsEnabled {
+		container.LivenessProbe, container.ReadinessProbe = createMetricsProbes(metricsPort)
+	}
+
+	return container
+}
+
+// createContainerSecurityContext creates the hardened security context used by stateless components
+func createContainerSecurityContext() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		AllowPrivilegeEscalation: boolPtr(false),
+		ReadOnlyRootFilesystem:   boolPtr(true),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}
+}
+
+// createMetricsProbes creates liveness and readiness probes against the metrics port
+func createMetricsProbes(metricsPort int32) (*corev1.Probe, *corev1.Probe) {
+	liveness := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			TCPSocket: &corev1.TCPSocketAction{
+				Port: intstr.FromInt32(metricsPort),
+			},
+		},
+		InitialDelaySeconds: 30,
+		PeriodSeconds:       30,
+		FailureThreshold:    3,
+	}
+	readiness := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			TCPSocket: &corev1.TCPSocketAction{
+				Port: intstr.FromInt32(metricsPort),
+			},
+		},
+		InitialDelaySeconds: 10,
+		PeriodSeconds:       10,
+		FailureThreshold:    3,
+	}
+	return liveness, readiness
+}
+
+// buildTxManagerArgs builds the op-service txmgr flags shared by op-batcher, op-proposer and op-challenger
+func buildTxManagerArgs(txConfig *optimismv1alpha1.L1TransactionConfig) []string {
+	var args []string
+	if txConfig == nil {
+		return args
+	}
+
+	if txConfig.NumConfirmations > 0 {
+		args = append(args, fmt.Sprintf("--num-confirmations=%d", txConfig.NumConfirmations))
+	}
+	if txConfig.ResubmissionTimeout != "" {
+		args = append(args, "--resubmission-timeout="+txConfig.ResubmissionTimeout)
+	}
+	if txConfig.FeeLimitMultiplier > 0 {
+		args = append(args, fmt.Sprintf("--fee-limit-multiplier=%d", txConfig.FeeLimitMultiplier))
+	}
+	if txConfig.SafeAbortNonceTooLowCount > 0 {
+		args = append(args, fmt.Sprintf("--safe-abort-nonce-too-low-count=%d", txConfig.SafeAbortNonceTooLowCount))
+	}
+	if txConfig.MaxPendingTx > 0 {
+		args = append(args, fmt.Sprintf("--max-pending-tx=%d", txConfig.MaxPendingTx))
+	}
+
+	return args
+}
+
+// buildLoggingArgs builds the log flags from the network shared configuration
+func buildLoggingArgs(network *optimismv1alpha1.OptimismNetwork) []string {
+	var args []string
+	if network.Spec.SharedConfig != nil && network.Spec.SharedConfig.Logging != nil {
+		logging := network.Spec.SharedConfig.Logging
+		if logging.Level != "" {
+			args = append(args, "--log.level="+logging.Level)
+		}
+		if logging.Format != "" {
+			args = append(args, "--log.format="+logging.Format)
+		}
+	}
+	return args
+}
+
+// GetOpBatcherRPCPort returns the configured RPC port for op-batcher
+func GetOpBatcherRPCPort(opBatcher *optimismv1alpha1.OpBatcher) int32 {
+	if opBatcher.Spec.RPC != nil {
+		return getDefaultInt32(opBatcher.Spec.RPC.Port, DefaultOpBatcherRPCPort)
+	}
+	return 8548
+}
+
+// GetOpBatcherMetricsPort returns the configured metrics port for op-batcher
+func GetOpBatcherMetricsPort(opBatcher *optimismv1alpha1.OpBatcher) int32 {
+	if opBatcher.Spec.Metrics != nil {
+		return getDefaultInt32(opBatcher.Spec.Metrics.Port, DefaultMetricsPort)
+	}
+	return 7300
+}
+
+// GetOpProposerRPCPort returns the configured RPC port for op-proposer
+func GetOpProposerRPCPort(opProposer *optimismv1alpha1.OpProposer) int32 {
+	if opProposer.Spec.RPC != nil {
+		return getDefaultInt32(opProposer.Spec.RPC.Port, DefaultOpProposerRPCPort)
+	}
+	return 8560
+}
+
+// GetOpProposerMetricsPort returns the configured metrics port for op-proposer
+func GetOpProposerMetricsPort(opProposer *optimismv1alpha1.OpProposer) int32 {
+	if opProposer.Spec.Metrics != nil {
+		return getDefaultInt32(opProposer.Spec.Metrics.Port, DefaultMetricsPort)
+	}
+	return 7300
+}
+
+// GetOpGethRPCEndpoint returns the in-cluster op-geth HTTP endpoint of an OpNode
+func GetOpGethRPCEndpoint(opNode *optimismv1alpha1.OpNode) string {
+	return fmt.Sprintf("http://%s:%d", serviceHost(opNode.Name, opNode.Namespace), getOpGethHTTPPort(opNode))
+}
+
+// GetOpNodeRPCEndpoint returns the in-cluster op-node RPC endpoint of an OpNode
+func GetOpNodeRPCEndpoint(opNode *optimismv1alpha1.OpNode) string {
+	port := int32(9545)
+	if opNode.Spec.OpNode.RPC != nil {
+		port = getDefaultInt32(opNode.Spec.OpNode.RPC.Port, DefaultOpNodeRPCPort)
+	}
+	return fmt.Sprintf("http://%s:%d", serviceHost(opNode.Name, opNode.Namespace), port)
+}
+
+// serviceHost returns the fully qualified cluster DNS name for a Service
+func serviceHost(name, namespace string) string {
+	return fmt.Sprintf("%s.%s.svc.cluster.local", name, namespace)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+	"github.com/ethereum-optimism/op-stack-operator/pkg/config"
+)
+
+// ResolvedImages are the images and pull policy a component is deployed with
+type ResolvedImages struct {
+	config.ImageConfig
+	PullPolicy corev1.PullPolicy
+}
+
+// ResolveImages layers the network-level image overrides and, when opNode is
+// set, the node-level ones on top of the default images, then validates the
+// result against the version compatibility matrix
+func ResolveImages(network *optimismv1alpha1.OptimismNetwork, opNode *optimismv1alpha1.OpNode) (ResolvedImages, error) {
+	resolved := ResolvedImages{
+		ImageConfig: config.DefaultImages,
+		PullPolicy:  corev1.PullPolicy(config.DefaultCacheConfig.PullPolicy),
+	}
+
+	layers := []*optimismv1alpha1.ImageOverrides{network.Spec.Images}
+	if opNode != nil {
+		layers = append(layers, opNode.Spec.Images)
+	}
+	for _, overrides := range layers {
+		if overrides == nil {
+			continue
+		}
+		images, err := resolved.WithOverrides(config.ImageOverrides{
+			VersionSet:        overrides.VersionSet,
+			GlobalVersion:     overrides.GlobalVersion,
+			OpNodeImage:       overrides.OpNodeImage,
+			OpGethImage:       overrides.OpGethImage,
+			OpBatcherImage:    overrides.OpBatcherImage,
+			OpProposerImage:   overrides.OpProposerImage,
+			OpChallengerImage: overrides.OpChallengerImage,
+			OpConductorImage:  overrides.OpConductorImage,
+		})
+		if err != nil {
+			return resolved, err
+		}
+		resolved.ImageConfig = images
+		if overrides.PullPolicy != "" {
+			resolved.PullPolicy = overrides.PullPolicy
+		}
+	}
+
+	return resolved, resolved.ValidateImageCompatibility()
+}
+
+// imagesFor returns the images to deploy. Controllers reject invalid image
+// settings through ResolveImages before building resources, so an error here
+// only leaves the layers that could be applied.
+func imagesFor(network *optimismv1alpha1.OptimismNetwork, opNode *optimismv1alpha1.OpNode) ResolvedImages {
+	resolved, _ := ResolveImages(network, opNode)
+	return resolved
+}
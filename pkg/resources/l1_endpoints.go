@@ -0,0 +1,163 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+// l1RpcSchemes are the URL schemes the components accept for L1 RPC endpoints
+var l1RpcSchemes = []string{"http://", "https://", "ws://", "wss://"}
+
+// ValidateL1Endpoints checks that a network has at least one L1 RPC endpoint, that the listed
+// endpoints have unique names and usable URLs, that URLs are given either literally or through
+// a Secret, and that the failover settings are valid
+func ValidateL1Endpoints(path *field.Path, spec *optimismv1alpha1.OptimismNetworkSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.L1RpcUrl == "" && spec.L1RpcUrlSecretRef == nil && len(spec.L1RpcEndpoints) == 0 {
+		allErrs = append(allErrs, field.Required(path.Child("l1RpcUrl"), "l1RpcUrl, l1RpcUrlSecretRef or l1RpcEndpoints is required"))
+	}
+	allErrs = append(allErrs, validateURLSource(path, "l1RpcUrl", spec.L1RpcUrl, "l1RpcUrlSecretRef", spec.L1RpcUrlSecretRef)...)
+	allErrs = append(allErrs, validateURLSource(path, "l1BeaconUrl", spec.L1BeaconUrl, "l1BeaconUrlSecretRef", spec.L1BeaconUrlSecretRef)...)
+
+	names := map[string]bool{}
+	for i, endpoint := range spec.L1RpcEndpoints {
+		endpointPath := path.Child("l1RpcEndpoints").Index(i)
+		switch {
+		case endpoint.Name == "":
+			allErrs = append(allErrs, field.Required(endpointPath.Child("name"), ""))
+		case endpoint.Name == optimismv1alpha1.DefaultL1EndpointName:
+			allErrs = append(allErrs, field.Invalid(endpointPath.Child("name"), endpoint.Name, "is reserved for l1RpcUrl"))
+		case names[endpoint.Name]:
+			allErrs = append(allErrs, field.Duplicate(endpointPath.Child("name"), endpoint.Name))
+		}
+		names[endpoint.Name] = true
+
+		if endpoint.URL == "" && endpoint.URLSecretRef == nil {
+			allErrs = append(allErrs, field.Required(endpointPath.Child("url"), "url or urlSecretRef is required"))
+		} else if endpoint.URLSecretRef == nil && !slices.ContainsFunc(l1RpcSchemes, func(scheme string) bool { return strings.HasPrefix(endpoint.URL, scheme) }) {
+			allErrs = append(allErrs, field.Invalid(endpointPath.Child("url"), endpoint.URL, "must be an HTTP or WebSocket URL"))
+		}
+		allErrs = append(allErrs, validateURLSource(endpointPath, "url", endpoint.URL, "urlSecretRef", endpoint.URLSecretRef)...)
+		if endpoint.Priority < 0 {
+			allErrs = append(allErrs, field.Invalid(endpointPath.Child("priority"), endpoint.Priority, "must not be negative"))
+		}
+	}
+
+	if failover := spec.L1Failover; failover != nil {
+		failoverPath := path.Child("l1Failover")
+		if interval, err := time.ParseDuration(failover.ProbeInterval); failover.ProbeInterval != "" && (err != nil || interval <= 0) {
+			allErrs = append(allErrs, field.Invalid(failoverPath.Child("probeInterval"), failover.ProbeInterval, "must be a positive duration"))
+		}
+		if window, err := time.ParseDuration(failover.HysteresisWindow); failover.HysteresisWindow != "" && (err != nil || window < 0) {
+			allErrs = append(allErrs, field.Invalid(failoverPath.Child("hysteresisWindow"), failover.HysteresisWindow, "must be a duration"))
+		}
+		if failover.MaxHeadLag != nil && *failover.MaxHeadLag < 0 {
+			allErrs = append(allErrs, field.Invalid(failoverPath.Child("maxHeadLag"), *failover.MaxHeadLag, "must not be negative"))
+		}
+	}
+	return allErrs
+}
+
+// validateURLSource checks that a URL is not given both literally and through a Secret,
+// and that a Secret reference names the Secret and its key
+func validateURLSource(path *field.Path, urlField, url, refField string, ref *corev1.SecretKeySelector) field.ErrorList {
+	if ref == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if url != "" {
+		allErrs = append(allErrs, field.Invalid(path.Child(refField), ref.Name, urlField+" and "+refField+" are mutually exclusive"))
+	}
+	if ref.Name == "" {
+		allErrs = append(allErrs, field.Required(path.Child(refField, "name"), ""))
+	}
+	if ref.Key == "" {
+		allErrs = append(allErrs, field.Required(path.Child(refField, "key"), ""))
+	}
+	return allErrs
+}
+
+// l1RpcSettings renders how a component reaches the active L1 RPC endpoint of a network
+func l1RpcSettings(network *optimismv1alpha1.OptimismNetwork, flag, envVar string) ([]string, []corev1.EnvVar) {
+	endpoint := optimismv1alpha1.ActiveL1RpcEndpoint(network)
+	return urlSettings(endpoint.URL, endpoint.URLSecretRef, flag, envVar)
+}
+
+// l1BeaconSettings renders how a component reaches the L1 beacon endpoint of a network
+func l1BeaconSettings(network *optimismv1alpha1.OptimismNetwork, flag, envVar string) ([]string, []corev1.EnvVar) {
+	return urlSettings(network.Spec.L1BeaconUrl, network.Spec.L1BeaconUrlSecretRef, flag, envVar)
+}
+
+// urlSettings renders a URL as a flag, or, when it is kept in a Secret, as the environment
+// variable the component reads the same setting from. URLs of providers commonly embed API
+// keys, which must not show up in the pod spec or the process list.
+func urlSettings(url string, secretRef *corev1.SecretKeySelector, flag, envVar string) ([]string, []corev1.EnvVar) {
+	if secretRef != nil {
+		return nil, []corev1.EnvVar{{
+			Name:      envVar,
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretRef},
+		}}
+	}
+	if url == "" {
+		return nil, nil
+	}
+	return []string{flag + "=" + url}, nil
+}
+
+// L1ProbeInterval returns how often the L1 RPC endpoints of a network are probed
+func L1ProbeInterval(network *optimismv1alpha1.OptimismNetwork) time.Duration {
+	value := DefaultL1ProbeInterval
+	if failover := network.Spec.L1Failover; failover != nil && failover.ProbeInterval != "" {
+		value = failover.ProbeInterval
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		interval, _ = time.ParseDuration(DefaultL1ProbeInterval)
+	}
+	return interval
+}
+
+// L1HysteresisWindow returns how long another L1 RPC endpoint must stay the best one before
+// the components of a network switch to it
+func L1HysteresisWindow(network *optimismv1alpha1.OptimismNetwork) time.Duration {
+	value := DefaultL1HysteresisWindow
+	if failover := network.Spec.L1Failover; failover != nil && failover.HysteresisWindow != "" {
+		value = failover.HysteresisWindow
+	}
+	window, err := time.ParseDuration(value)
+	if err != nil || window < 0 {
+		window, _ = time.ParseDuration(DefaultL1HysteresisWindow)
+	}
+	return window
+}
+
+// L1MaxHeadLag returns how many blocks an L1 RPC endpoint may trail the highest head
+func L1MaxHeadLag(network *optimismv1alpha1.OptimismNetwork) int64 {
+	if failover := network.Spec.L1Failover; failover != nil && failover.MaxHeadLag != nil {
+		return *failover.MaxHeadLag
+	}
+	return DefaultL1MaxHeadLag
+}
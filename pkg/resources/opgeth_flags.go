@@ -0,0 +1,247 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+const (
+	// GethConfigAnnotation carries the op-geth TOML config, which the pods read through the downward API
+	GethConfigAnnotation = "optimism.io/geth-config"
+	// gethConfigDir is where the op-geth TOML config is mounted
+	gethConfigDir = "/etc/geth"
+	// gethConfigFile is the op-geth TOML config file name
+	gethConfigFile = "geth.toml"
+)
+
+// Accepted values of the op-geth enum settings
+var (
+	OpGethSyncModes    = []string{"snap", "full"}
+	OpGethGCModes      = []string{"full", "archive"}
+	OpGethStateSchemes = []string{"path", "hash"}
+	OpGethDBEngines    = []string{"pebble", "leveldb"}
+)
+
+// gethFlag renders one op-geth flag from the OpGethConfig; nil omits the flag
+type gethFlag func(geth *optimismv1alpha1.OpGethConfig) []string
+
+// stringFlag renders --name=value for a non-empty value
+func stringFlag(name string, value func(*optimismv1alpha1.OpGethConfig) string) gethFlag {
+	return func(geth *optimismv1alpha1.OpGethConfig) []string {
+		if v := value(geth); v != "" {
+			return []string{name + "=" + v}
+		}
+		return nil
+	}
+}
+
+// int32Flag renders --name=value for a non-zero value
+func int32Flag(name string, value func(*optimismv1alpha1.OpGethConfig) int32) gethFlag {
+	return func(geth *optimismv1alpha1.OpGethConfig) []string {
+		if v := value(geth); v != 0 {
+			return []string{name + "=" + strconv.Itoa(int(v))}
+		}
+		return nil
+	}
+}
+
+// boolFlag renders the switch --name when value is true
+func boolFlag(name string, value func(*optimismv1alpha1.OpGethConfig) bool) gethFlag {
+	return func(geth *optimismv1alpha1.OpGethConfig) []string {
+		if value(geth) {
+			return []string{name}
+		}
+		return nil
+	}
+}
+
+// listFlag renders --name=a,b for a non-empty list
+func listFlag(name string, value func(*optimismv1alpha1.OpGethConfig) []string) gethFlag {
+	return func(geth *optimismv1alpha1.OpGethConfig) []string {
+		if v := value(geth); len(v) > 0 {
+			return []string{name + "=" + strings.Join(v, ",")}
+		}
+		return nil
+	}
+}
+
+// opGethTuningFlags render the sync, database, txpool, rollup and P2P settings, in argument order
+var opGethTuningFlags = []gethFlag{
+	stringFlag("--op-network", func(g *optimismv1alpha1.OpGethConfig) string { return g.Network }),
+	stringFlag("--gcmode", func(g *optimismv1alpha1.OpGethConfig) string { return g.GCMode }),
+	stringFlag("--state.scheme", OpGethStateScheme),
+	int32Flag("--cache", func(g *optimismv1alpha1.OpGethConfig) int32 { return g.Cache }),
+	stringFlag("--db.engine", func(g *optimismv1alpha1.OpGethConfig) string { return g.DBEngine }),
+
+	listFlag("--txpool.locals", func(g *optimismv1alpha1.OpGethConfig) []string { return txPool(g).Locals }),
+	boolFlag("--txpool.nolocals", func(g *optimismv1alpha1.OpGethConfig) bool { return txPool(g).NoLocals }),
+	stringFlag("--txpool.journal", func(g *optimismv1alpha1.OpGethConfig) string { return txPool(g).Journal }),
+	boolFlag("--txpool.journalremotes", func(g *optimismv1alpha1.OpGethConfig) bool { return txPool(g).JournalRemotes }),
+	stringFlag("--txpool.lifetime", func(g *optimismv1alpha1.OpGethConfig) string { return txPool(g).Lifetime }),
+	int32Flag("--txpool.pricebump", func(g *optimismv1alpha1.OpGethConfig) int32 { return txPool(g).PriceBump }),
+	int32Flag("--txpool.accountslots", func(g *optimismv1alpha1.OpGethConfig) int32 { return txPool(g).AccountSlots }),
+	int32Flag("--txpool.globalslots", func(g *optimismv1alpha1.OpGethConfig) int32 { return txPool(g).GlobalSlots }),
+	int32Flag("--txpool.accountqueue", func(g *optimismv1alpha1.OpGethConfig) int32 { return txPool(g).AccountQueue }),
+	int32Flag("--txpool.globalqueue", func(g *optimismv1alpha1.OpGethConfig) int32 { return txPool(g).GlobalQueue }),
+
+	boolFlag("--rollup.disabletxpoolgossip", func(g *optimismv1alpha1.OpGethConfig) bool { return rollup(g).DisableTxPoolGossip }),
+	boolFlag("--rollup.computependingblock", func(g *optimismv1alpha1.OpGethConfig) bool { return rollup(g).ComputePendingBlock }),
+
+	int32Flag("--port", func(g *optimismv1alpha1.OpGethConfig) int32 { return gethP2P(g).Port }),
+	int32Flag("--maxpeers", func(g *optimismv1alpha1.OpGethConfig) int32 { return gethP2P(g).MaxPeers }),
+	boolFlag("--nodiscover", func(g *optimismv1alpha1.OpGethConfig) bool { return gethP2P(g).NoDiscovery }),
+	stringFlag("--netrestrict", func(g *optimismv1alpha1.OpGethConfig) string { return gethP2P(g).NetRestrict }),
+	boolFlag("--config="+gethConfigDir+"/"+gethConfigFile, func(g *optimismv1alpha1.OpGethConfig) bool { return len(gethP2P(g).Static) > 0 }),
+}
+
+// opGethTuningArgs renders the op-geth tuning flags of an OpNode
+func opGethTuningArgs(geth *optimismv1alpha1.OpGethConfig) []string {
+	var args []string
+	for _, flag := range opGethTuningFlags {
+		args = append(args, flag(geth)...)
+	}
+	return args
+}
+
+// OpGethStateScheme returns the state scheme op-geth runs with. Archive nodes need the
+// hash scheme, so it is selected for them when no scheme is configured.
+func OpGethStateScheme(geth *optimismv1alpha1.OpGethConfig) string {
+	if geth.StateScheme == "" && geth.GCMode == "archive" {
+		return "hash"
+	}
+	return geth.StateScheme
+}
+
+// opGethConfigTOML renders the op-geth TOML config for settings that have no flag; empty when none apply
+func opGethConfigTOML(geth *optimismv1alpha1.OpGethConfig) string {
+	static := gethP2P(geth).Static
+	if len(static) == 0 {
+		return ""
+	}
+	quoted := make([]string, len(static))
+	for i, node := range static {
+		quoted[i] = strconv.Quote(node)
+	}
+	return fmt.Sprintf("[Node.P2P]\nStaticNodes = [%s]\n", strings.Join(quoted, ", "))
+}
+
+// ValidateOpGethConfig checks the op-geth settings that are rendered into flags
+func ValidateOpGethConfig(path *field.Path, geth *optimismv1alpha1.OpGethConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, setting := range []struct {
+		name    string
+		value   string
+		allowed []string
+	}{
+		{"syncMode", geth.SyncMode, OpGethSyncModes},
+		{"gcMode", geth.GCMode, OpGethGCModes},
+		{"stateScheme", geth.StateScheme, OpGethStateSchemes},
+		{"dbEngine", geth.DBEngine, OpGethDBEngines},
+	} {
+		if setting.value != "" && !slices.Contains(setting.allowed, setting.value) {
+			allErrs = append(allErrs, field.NotSupported(path.Child(setting.name), setting.value, setting.allowed))
+		}
+	}
+	if geth.GCMode == "archive" && geth.StateScheme == "path" {
+		allErrs = append(allErrs, field.Invalid(path.Child("stateScheme"), geth.StateScheme, "archive nodes require the hash state scheme"))
+	}
+	if geth.Cache < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("cache"), geth.Cache, "must not be negative"))
+	}
+
+	if txPool := geth.TxPool; txPool != nil {
+		txPoolPath := path.Child("txpool")
+		for i, account := range txPool.Locals {
+			if !common.IsHexAddress(account) {
+				allErrs = append(allErrs, field.Invalid(txPoolPath.Child("locals").Index(i), account, "must be a hex-encoded address"))
+			}
+		}
+		if txPool.Lifetime != "" {
+			if lifetime, err := time.ParseDuration(txPool.Lifetime); err != nil || lifetime <= 0 {
+				allErrs = append(allErrs, field.Invalid(txPoolPath.Child("lifetime"), txPool.Lifetime, "must be a positive duration"))
+			}
+		}
+		for _, limit := range []struct {
+			name  string
+			value int32
+		}{
+			{"priceBump", txPool.PriceBump}, {"accountSlots", txPool.AccountSlots}, {"globalSlots", txPool.GlobalSlots},
+			{"accountQueue", txPool.AccountQueue}, {"globalQueue", txPool.GlobalQueue},
+		} {
+			if limit.value < 0 {
+				allErrs = append(allErrs, field.Invalid(txPoolPath.Child(limit.name), limit.value, "must not be negative"))
+			}
+		}
+	}
+
+	if networking := geth.Networking; networking != nil && networking.P2P != nil {
+		p2p, p2pPath := networking.P2P, path.Child("networking", "p2p")
+		if p2p.MaxPeers < 0 {
+			allErrs = append(allErrs, field.Invalid(p2pPath.Child("maxPeers"), p2p.MaxPeers, "must not be negative"))
+		}
+		if p2p.NetRestrict != "" {
+			for _, cidr := range strings.Split(p2p.NetRestrict, ",") {
+				if _, _, err := net.ParseCIDR(strings.TrimSpace(cidr)); err != nil {
+					allErrs = append(allErrs, field.Invalid(p2pPath.Child("netRestrict"), p2p.NetRestrict, "must be a comma-separated list of CIDR masks"))
+					break
+				}
+			}
+		}
+		for i, node := range p2p.Static {
+			if !strings.HasPrefix(node, "enode://") {
+				allErrs = append(allErrs, field.Invalid(p2pPath.Child("static").Index(i), node, "must be an enode URL"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// txPool returns the txpool settings, empty when unset
+func txPool(geth *optimismv1alpha1.OpGethConfig) *optimismv1alpha1.TxPoolConfig {
+	if geth.TxPool == nil {
+		return &optimismv1alpha1.TxPoolConfig{}
+	}
+	return geth.TxPool
+}
+
+// rollup returns the rollup settings, empty when unset
+func rollup(geth *optimismv1alpha1.OpGethConfig) *optimismv1alpha1.RollupConfig {
+	if geth.Rollup == nil {
+		return &optimismv1alpha1.RollupConfig{}
+	}
+	return geth.Rollup
+}
+
+// gethP2P returns the P2P settings, empty when unset
+func gethP2P(geth *optimismv1alpha1.OpGethConfig) *optimismv1alpha1.GethP2PConfig {
+	if geth.Networking == nil || geth.Networking.P2P == nil {
+		return &optimismv1alpha1.GethP2PConfig{}
+	}
+	return geth.Networking.P2P
+}
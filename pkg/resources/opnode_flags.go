@@ -0,0 +1,132 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+// Pod annotations read by the CNI bandwidth plugin
+const (
+	IngressBandwidthAnnotation = "kubernetes.io/ingress-bandwidth"
+	EgressBandwidthAnnotation  = "kubernetes.io/egress-bandwidth"
+)
+
+// Accepted values of the op-node enum settings
+var (
+	OpNodeSyncModes = []string{"consensus-layer", "execution-layer"}
+	OpNodeRPCKinds  = []string{"alchemy", "quicknode", "infura", "parity", "nethermind", "debug_geth", "erigon", "basic", "any", "standard"}
+)
+
+// ExternalEngine reports whether op-node drives an external execution engine instead of a local op-geth
+func ExternalEngine(opNode *optimismv1alpha1.OpNode) bool {
+	return opNode.Spec.OpNode.Engine != nil && opNode.Spec.OpNode.Engine.Endpoint != ""
+}
+
+// opNodeEngineEndpoint returns the Engine API endpoint op-node drives
+func opNodeEngineEndpoint(opNode *optimismv1alpha1.OpNode) string {
+	if ExternalEngine(opNode) {
+		return opNode.Spec.OpNode.Engine.Endpoint
+	}
+	return fmt.Sprintf("http://127.0.0.1:%d", getAuthRPCPort(opNode))
+}
+
+// jwtSecretRef returns the user-provided Engine API secret, if any
+func jwtSecretRef(opNode *optimismv1alpha1.OpNode) *optimismv1alpha1.SecretKeyRef {
+	if engine := opNode.Spec.OpNode.Engine; engine != nil && engine.JWTSecret != nil && engine.JWTSecret.SecretRef != nil {
+		return engine.JWTSecret
+	}
+	return nil
+}
+
+// GeneratesJWTSecret reports whether the operator generates the Engine API secret of an OpNode
+func GeneratesJWTSecret(opNode *optimismv1alpha1.OpNode) bool {
+	return jwtSecretRef(opNode) == nil
+}
+
+// bandwidthAnnotations returns the pod annotations enforcing the pod bandwidth limit
+func bandwidthAnnotations(opNode *optimismv1alpha1.OpNode) map[string]string {
+	if opNode.Spec.PodBandwidthLimit == "" {
+		return nil
+	}
+	return map[string]string{
+		IngressBandwidthAnnotation: opNode.Spec.PodBandwidthLimit,
+		EgressBandwidthAnnotation:  opNode.Spec.PodBandwidthLimit,
+	}
+}
+
+// ValidatePodBandwidthLimit checks the pod bandwidth limit is a quantity the CNI bandwidth plugin accepts
+func ValidatePodBandwidthLimit(path *field.Path, limit string) field.ErrorList {
+	if limit == "" {
+		return nil
+	}
+	if quantity, err := resource.ParseQuantity(limit); err != nil || quantity.Sign() <= 0 {
+		return field.ErrorList{field.Invalid(path, limit, "must be a positive quantity such as 100M")}
+	}
+	return nil
+}
+
+// ValidateOpNodeConfig checks the op-node settings that are rendered into flags
+func ValidateOpNodeConfig(path *field.Path, config *optimismv1alpha1.OpNodeConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if config.SyncMode != "" && !slices.Contains(OpNodeSyncModes, config.SyncMode) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("syncMode"), config.SyncMode, OpNodeSyncModes))
+	}
+	if l1 := config.L1; l1 != nil && l1.RPCKind != "" && !slices.Contains(OpNodeRPCKinds, l1.RPCKind) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("l1", "rpcKind"), l1.RPCKind, OpNodeRPCKinds))
+	}
+
+	if p2p := config.P2P; p2p != nil {
+		p2pPath := path.Child("p2p")
+		if discovery := p2p.Discovery; discovery != nil {
+			for i, bootnode := range discovery.Bootnodes {
+				if !strings.HasPrefix(bootnode, "enr:") && !strings.HasPrefix(bootnode, "enode://") {
+					allErrs = append(allErrs, field.Invalid(p2pPath.Child("discovery", "bootnodes").Index(i), bootnode, "must be an ENR or enode URL"))
+				}
+			}
+		}
+		for i, peer := range p2p.Static {
+			if !strings.HasPrefix(peer, "/") {
+				allErrs = append(allErrs, field.Invalid(p2pPath.Child("static").Index(i), peer, "must be a multiaddr"))
+			}
+		}
+	}
+
+	if sequencer := config.Sequencer; sequencer != nil && sequencer.MaxTxPerBlock < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("sequencer", "maxTxPerBlock"), sequencer.MaxTxPerBlock, "must not be negative"))
+	}
+
+	if engine := config.Engine; engine != nil && engine.Endpoint != "" {
+		if !slices.ContainsFunc([]string{"http://", "https://", "ws://", "wss://"}, func(scheme string) bool {
+			return strings.HasPrefix(engine.Endpoint, scheme)
+		}) {
+			allErrs = append(allErrs, field.Invalid(path.Child("engine", "endpoint"), engine.Endpoint, "must be an HTTP or WebSocket URL"))
+		}
+	}
+	if engine := config.Engine; engine != nil && engine.JWTSecret != nil && engine.JWTSecret.SecretRef != nil && engine.JWTSecret.Generate {
+		allErrs = append(allErrs, field.Invalid(path.Child("engine", "jwtSecret"), "generate", "secretRef and generate are mutually exclusive"))
+	}
+	return allErrs
+}
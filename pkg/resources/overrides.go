@@ -0,0 +1,201 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
+)
+
+// applyOpNodeOverrides merges the extra container settings, volumes, sidecars and pod template
+// overrides of an OpNode into its pod spec. Overrides that collide with operator-managed
+// settings are skipped; the returned messages describe them.
+func applyOpNodeOverrides(opNode *optimismv1alpha1.OpNode, podSpec *corev1.PodSpec) []string {
+	var conflicts []string
+	specPath := field.NewPath("spec")
+
+	containerOverrides := []struct {
+		name      string
+		path      *field.Path
+		overrides *optimismv1alpha1.ContainerOverrides
+	}{
+		{"op-node", specPath.Child("opNode"), &opNode.Spec.OpNode.ContainerOverrides},
+		{"op-geth", specPath.Child("opGeth"), &opNode.Spec.OpGeth.ContainerOverrides},
+	}
+	for _, c := range containerOverrides {
+		index := slices.IndexFunc(podSpec.Containers, func(container corev1.Container) bool {
+			return container.Name == c.name
+		})
+		if index < 0 {
+			if hasContainerOverrides(c.overrides) {
+				conflicts = append(conflicts, fmt.Sprintf("%s: no %s container runs in the pod", c.path, c.name))
+			}
+			continue
+		}
+		conflicts = append(conflicts, applyContainerOverrides(c.path, &podSpec.Containers[index], c.overrides)...)
+	}
+
+	for i, volume := range opNode.Spec.ExtraVolumes {
+		if slices.ContainsFunc(podSpec.Volumes, func(v corev1.Volume) bool { return v.Name == volume.Name }) {
+			conflicts = append(conflicts, fmt.Sprintf("%s: volume %s is managed by the operator",
+				specPath.Child("extraVolumes").Index(i), volume.Name))
+			continue
+		}
+		podSpec.Volumes = append(podSpec.Volumes, volume)
+	}
+
+	for i, sidecar := range opNode.Spec.Sidecars {
+		taken := func(c corev1.Container) bool { return c.Name == sidecar.Name }
+		if slices.ContainsFunc(podSpec.Containers, taken) || slices.ContainsFunc(podSpec.InitContainers, taken) {
+			conflicts = append(conflicts, fmt.Sprintf("%s: container %s is managed by the operator",
+				specPath.Child("sidecars").Index(i), sidecar.Name))
+			continue
+		}
+		podSpec.Containers = append(podSpec.Containers, sidecar)
+	}
+
+	if opNode.Spec.PodTemplate != nil {
+		if err := mergePodTemplate(podSpec, opNode.Spec.PodTemplate); err != nil {
+			conflicts = append(conflicts, fmt.Sprintf("%s: %v", specPath.Child("podTemplate"), err))
+		}
+	}
+	return conflicts
+}
+
+// hasContainerOverrides reports whether any extra container setting is configured
+func hasContainerOverrides(overrides *optimismv1alpha1.ContainerOverrides) bool {
+	return len(overrides.ExtraArgs) > 0 || len(overrides.ExtraEnv) > 0 || len(overrides.ExtraVolumeMounts) > 0
+}
+
+// applyContainerOverrides appends the extra arguments, environment and volume mounts to a
+// container, skipping flags, variables and mount paths the operator already sets
+func applyContainerOverrides(path *field.Path, container *corev1.Container, overrides *optimismv1alpha1.ContainerOverrides) []string {
+	var conflicts []string
+
+	managedFlags := map[string]bool{}
+	for _, arg := range container.Args {
+		if name, ok := flagName(arg); ok {
+			managedFlags[name] = true
+		}
+	}
+	args := overrides.ExtraArgs
+	for i := 0; i < len(args); i++ {
+		name, ok := flagName(args[i])
+		if !ok || !managedFlags[name] {
+			container.Args = append(container.Args, args[i])
+			continue
+		}
+		conflicts = append(conflicts, fmt.Sprintf("%s: flag --%s is managed by the operator", path.Child("extraArgs").Index(i), name))
+		// Skip the value of a flag given as two arguments, e.g. "--syncmode full"
+		if !strings.Contains(args[i], "=") && i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+			i++
+		}
+	}
+
+	for i, env := range overrides.ExtraEnv {
+		if slices.ContainsFunc(container.Env, func(e corev1.EnvVar) bool { return e.Name == env.Name }) {
+			conflicts = append(conflicts, fmt.Sprintf("%s: variable %s is managed by the operator", path.Child("extraEnv").Index(i), env.Name))
+			continue
+		}
+		container.Env = append(container.Env, env)
+	}
+
+	for i, mount := range overrides.ExtraVolumeMounts {
+		if slices.ContainsFunc(container.VolumeMounts, func(m corev1.VolumeMount) bool { return m.MountPath == mount.MountPath }) {
+			conflicts = append(conflicts, fmt.Sprintf("%s: mount path %s is managed by the operator", path.Child("extraVolumeMounts").Index(i), mount.MountPath))
+			continue
+		}
+		container.VolumeMounts = append(container.VolumeMounts, mount)
+	}
+	return conflicts
+}
+
+// flagName returns the name of a command-line flag without dashes or value
+func flagName(arg string) (string, bool) {
+	if !strings.HasPrefix(arg, "-") {
+		return "", false
+	}
+	name, _, _ := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+	return name, name != ""
+}
+
+// mergePodTemplate strategically merges the pod template overrides into a pod spec. The
+// override field names match the PodSpec ones, so the overrides serve as the patch.
+func mergePodTemplate(podSpec *corev1.PodSpec, overrides *optimismv1alpha1.PodTemplateOverrides) error {
+	original, err := json.Marshal(podSpec)
+	if err != nil {
+		return err
+	}
+	patch, err := json.Marshal(overrides)
+	if err != nil {
+		return err
+	}
+	merged, err := strategicpatch.StrategicMergePatch(original, patch, corev1.PodSpec{})
+	if err != nil {
+		return err
+	}
+	var result corev1.PodSpec
+	if err := json.Unmarshal(merged, &result); err != nil {
+		return err
+	}
+	*podSpec = result
+	return nil
+}
+
+// ValidateOpNodeOverrides checks the extra volumes and sidecars of an OpNode for duplicates
+// and its extra arguments for empty entries
+func ValidateOpNodeOverrides(path *field.Path, spec *optimismv1alpha1.OpNodeSpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, c := range []struct {
+		path      *field.Path
+		overrides *optimismv1alpha1.ContainerOverrides
+	}{
+		{path.Child("opNode"), &spec.OpNode.ContainerOverrides},
+		{path.Child("opGeth"), &spec.OpGeth.ContainerOverrides},
+	} {
+		for i, arg := range c.overrides.ExtraArgs {
+			if strings.TrimSpace(arg) == "" {
+				allErrs = append(allErrs, field.Invalid(c.path.Child("extraArgs").Index(i), arg, "must not be empty"))
+			}
+		}
+	}
+
+	volumes := map[string]bool{}
+	for i, volume := range spec.ExtraVolumes {
+		if volumes[volume.Name] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("extraVolumes").Index(i).Child("name"), volume.Name))
+		}
+		volumes[volume.Name] = true
+	}
+	sidecars := map[string]bool{}
+	for i, sidecar := range spec.Sidecars {
+		if sidecars[sidecar.Name] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("sidecars").Index(i).Child("name"), sidecar.Name))
+		}
+		sidecars[sidecar.Name] = true
+	}
+	return allErrs
+}
@@ -24,17 +24,10 @@ import (
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
 )
 
-// CreateOpNodeService creates a Kubernetes Service for OpNode
+// CreateOpNodeService creates a Kubernetes Service for OpNode. It balances RPC
+// traffic across all pods of the node.
 func CreateOpNodeService(opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.OptimismNetwork) *corev1.Service {
-	labels := map[string]string{
-		"app.kubernetes.io/name":       "opnode",
-		"app.kubernetes.io/instance":   opNode.Name,
-		"app.kubernetes.io/component":  "consensus-layer",
-		"app.kubernetes.io/part-of":    "op-stack",
-		"app.kubernetes.io/managed-by": "op-stack-operator",
-		"optimism.io/network":          network.Spec.NetworkName,
-		"optimism.io/node-type":        opNode.Spec.NodeType,
-	}
+	labels := OpNodeLabels(opNode, network)
 
 	// Default service type
 	serviceType := corev1.ServiceTypeClusterIP
@@ -68,30 +61,40 @@ func CreateOpNodeService(opNode *optimismv1alpha1.OpNode, network *optimismv1alp
 	return service
 }
 
+// HeadlessServiceName returns the name of the headless Service governing the OpNode StatefulSet
+func HeadlessServiceName(opNode *optimismv1alpha1.OpNode) string {
+	return opNode.Name + "-headless"
+}
+
+// CreateOpNodeHeadlessService creates the headless Service that gives each OpNode pod
+// a stable DNS name. Pods are published before they are ready so peers can reach
+// them while they sync.
+func CreateOpNodeHeadlessService(opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.OptimismNetwork) *corev1.Service {
+	labels := OpNodeLabels(opNode, network)
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      HeadlessServiceName(opNode),
+			Namespace: opNode.Namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:                     corev1.ServiceTypeClusterIP,
+			ClusterIP:                corev1.ClusterIPNone,
+			PublishNotReadyAddresses: true,
+			Selector:                 labels,
+			Ports:                    append(buildDefaultServicePorts(opNode), buildConductorServicePorts(opNode)...),
+		},
+	}
+}
+
 // buildServicePorts builds the service ports based on OpNode configuration
 func buildServicePorts(opNode *optimismv1alpha1.OpNode) []corev1.ServicePort {
 	var ports []corev1.ServicePort
 
 	// If custom ports are specified, use them
 	if opNode.Spec.Service != nil && len(opNode.Spec.Service.Ports) > 0 {
-		for _, portConfig := range opNode.Spec.Service.Ports {
-			port := corev1.ServicePort{
-				Name:       portConfig.Name,
-				Port:       portConfig.Port,
-				Protocol:   corev1.ProtocolTCP,
-				TargetPort: intstr.FromInt32(portConfig.Port),
-			}
-
-			if portConfig.TargetPort.IntVal != 0 || portConfig.TargetPort.StrVal != "" {
-				port.TargetPort = portConfig.TargetPort
-			}
-
-			if portConfig.Protocol != "" {
-				port.Protocol = portConfig.Protocol
-			}
-
-			ports = append(ports, port)
-		}
+		ports = buildCustomServicePorts(opNode.Spec.Service.Ports)
 	} else {
 		// Default ports based on configuration
 		ports = buildDefaultServicePorts(opNode)
@@ -100,6 +103,30 @@ func buildServicePorts(opNode *optimismv1alpha1.OpNode) []corev1.ServicePort {
 	return ports
 }
 
+// buildCustomServicePorts converts user-specified port configuration into service ports
+func buildCustomServicePorts(portConfigs []optimismv1alpha1.ServicePortConfig) []corev1.ServicePort {
+	var ports []corev1.ServicePort
+	for _, portConfig := range portConfigs {
+		port := corev1.ServicePort{
+			Name:       portConfig.Name,
+			Port:       portConfig.Port,
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt32(portConfig.Port),
+		}
+
+		if portConfig.TargetPort.IntVal != 0 || portConfig.TargetPort.StrVal != "" {
+			port.TargetPort = portConfig.TargetPort
+		}
+
+		if portConfig.Protocol != "" {
+			port.Protocol = portConfig.Protocol
+		}
+
+		ports = append(ports, port)
+	}
+	return ports
+}
+
 // buildDefaultServicePorts creates default service ports based on OpNode configuration
 func buildDefaultServicePorts(opNode *optimismv1alpha1.OpNode) []corev1.ServicePort {
 	var ports []corev1.ServicePort
@@ -108,7 +135,7 @@ func buildDefaultServicePorts(opNode *optimismv1alpha1.OpNode) []corev1.ServiceP
 	if opNode.Spec.OpGeth.Networking != nil &&
 		opNode.Spec.OpGeth.Networking.HTTP != nil &&
 		opNode.Spec.OpGeth.Networking.HTTP.Enabled {
-		port := getDefaultInt32(opNode.Spec.OpGeth.Networking.HTTP.Port, 8545)
+		port := getDefaultInt32(opNode.Spec.OpGeth.Networking.HTTP.Port, DefaultOpGethHTTPPort)
 		ports = append(ports, corev1.ServicePort{
 			Name:       "geth-http",
 			Port:       port,
@@ -121,7 +148,7 @@ func buildDefaultServicePorts(opNode *optimismv1alpha1.OpNode) []corev1.ServiceP
 	if opNode.Spec.OpGeth.Networking != nil &&
 		opNode.Spec.OpGeth.Networking.WS != nil &&
 		opNode.Spec.OpGeth.Networking.WS.Enabled {
-		port := getDefaultInt32(opNode.Spec.OpGeth.Networking.WS.Port, 8546)
+		port := getDefaultInt32(opNode.Spec.OpGeth.Networking.WS.Port, DefaultOpGethWSPort)
 		ports = append(ports, corev1.ServicePort{
 			Name:       "geth-ws",
 			Port:       port,
@@ -132,7 +159,7 @@ func buildDefaultServicePorts(opNode *optimismv1alpha1.OpNode) []corev1.ServiceP
 
 	// op-geth P2P port
 	if opNode.Spec.OpGeth.Networking != nil && opNode.Spec.OpGeth.Networking.P2P != nil {
-		port := getDefaultInt32(opNode.Spec.OpGeth.Networking.P2P.Port, 30303)
+		port := getDefaultInt32(opNode.Spec.OpGeth.Networking.P2P.Port, DefaultOpGethP2PPort)
 		ports = append(ports, corev1.ServicePort{
 			Name:       "geth-p2p",
 			Port:       port,
@@ -143,7 +170,7 @@ func buildDefaultServicePorts(opNode *optimismv1alpha1.OpNode) []corev1.ServiceP
 
 	// op-node RPC port
 	if opNode.Spec.OpNode.RPC != nil && opNode.Spec.OpNode.RPC.Enabled {
-		port := getDefaultInt32(opNode.Spec.OpNode.RPC.Port, 9545)
+		port := getDefaultInt32(opNode.Spec.OpNode.RPC.Port, DefaultOpNodeRPCPort)
 		ports = append(ports, corev1.ServicePort{
 			Name:       "node-rpc",
 			Port:       port,
@@ -154,7 +181,7 @@ func buildDefaultServicePorts(opNode *optimismv1alpha1.OpNode) []corev1.ServiceP
 
 	// op-node P2P port
 	if opNode.Spec.OpNode.P2P != nil && opNode.Spec.OpNode.P2P.Enabled {
-		port := getDefaultInt32(opNode.Spec.OpNode.P2P.ListenPort, 9003)
+		port := getDefaultInt32(opNode.Spec.OpNode.P2P.ListenPort, DefaultOpNodeP2PPort)
 		ports = append(ports, corev1.ServicePort{
 			Name:       "node-p2p",
 			Port:       port,
@@ -174,3 +201,86 @@ func buildDefaultServicePorts(opNode *optimismv1alpha1.OpNode) []corev1.ServiceP
 
 	return ports
 }
+
+// CreateOpBatcherService creates a Kubernetes Service for OpBatcher
+func CreateOpBatcherService(opBatcher *optimismv1alpha1.OpBatcher, network *optimismv1alpha1.OptimismNetwork) *corev1.Service {
+	labels := OpBatcherLabels(opBatcher, network)
+
+	// Default service type
+	serviceType := corev1.ServiceTypeClusterIP
+	if opBatcher.Spec.Service != nil && opBatcher.Spec.Service.Type != "" {
+		serviceType = opBatcher.Spec.Service.Type
+	}
+
+	// Default annotations
+	annotations := make(map[string]string)
+	if opBatcher.Spec.Service != nil && len(opBatcher.Spec.Service.Annotations) > 0 {
+		annotations = opBatcher.Spec.Service.Annotations
+	}
+
+	var ports []corev1.ServicePort
+	if opBatcher.Spec.Service != nil && len(opBatcher.Spec.Service.Ports) > 0 {
+		ports = buildCustomServicePorts(opBatcher.Spec.Service.Ports)
+	} else {
+		metricsPort := GetOpBatcherMetricsPort(opBatcher)
+		ports = append(ports, corev1.ServicePort{
+			Name:       "metrics",
+			Port:       metricsPort,
+			TargetPort: intstr.FromInt32(metricsPort),
+			Protocol:   corev1.ProtocolTCP,
+		})
+		if opBatcher.Spec.RPC != nil && opBatcher.Spec.RPC.Enabled {
+			rpcPort := GetOpBatcherRPCPort(opBatcher)
+			ports = append(ports, corev1.ServicePort{
+				Name:       "rpc",
+				Port:       rpcPort,
+				TargetPort: intstr.FromInt32(rpcPort),
+				Protocol:   corev1.ProtocolTCP,
+			})
+		}
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        opBatcher.Name,
+			Namespace:   opBatcher.Namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     serviceType,
+			Selector: labels,
+			Ports:    ports,
+		},
+	}
+
+	return service
+}
+
+// CreateOpChallengerService creates the governing Service for the OpChallenger StatefulSet
+func CreateOpChallengerService(opChallenger *optimismv1alpha1.OpChallenger, network *optimismv1alpha1.OptimismNetwork) *corev1.Service {
+	labels := OpChallengerLabels(opChallenger, network)
+	metricsPort := GetOpChallengerMetricsPort(opChallenger)
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opChallenger.Name,
+			Namespace: opChallenger.Namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeClusterIP,
+			Selector: labels,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "metrics",
+					Port:       metricsPort,
+					TargetPort: intstr.FromInt32(metricsPort),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
+
+	return service
+}
@@ -26,29 +26,84 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	optimismv1alpha1 "github.com/ethereum-optimism/op-stack-operator/api/v1alpha1"
-	"github.com/ethereum-optimism/op-stack-operator/pkg/config"
 )
 
-// CreateOpNodeStatefulSet creates a StatefulSet for OpNode (op-geth + op-node)
-func CreateOpNodeStatefulSet(
-	opNode *optimismv1alpha1.OpNode,
-	network *optimismv1alpha1.OptimismNetwork,
-) *appsv1.StatefulSet {
-	labels := map[string]string{
+// NodeTypeLabel carries the OpNode node type. It is part of the StatefulSet
+// selector, so a node type change requires recreating the StatefulSet.
+const NodeTypeLabel = "optimism.io/node-type"
+
+// P2P keys of generated secrets are stored per pod ordinal. Pods read their ordinal
+// from the pod index label the StatefulSet controller sets (Kubernetes 1.28+).
+const (
+	P2PKeyPrefix  = "private-key-"
+	podIndexLabel = "apps.kubernetes.io/pod-index"
+)
+
+// P2PKeyName returns the P2P secret key holding the private key of a pod ordinal
+func P2PKeyName(ordinal int32) string {
+	return fmt.Sprintf("%s%d", P2PKeyPrefix, ordinal)
+}
+
+// OpNodeReplicas returns the number of pods of an OpNode. Sequencers are pinned to one,
+// unless they run as an op-conductor cluster with one pod per member.
+func OpNodeReplicas(opNode *optimismv1alpha1.OpNode) int32 {
+	if HAEnabled(opNode) {
+		return ConductorMembers(opNode)
+	}
+	if opNode.Spec.NodeType == "sequencer" || opNode.Spec.Replicas == nil {
+		return 1
+	}
+	return *opNode.Spec.Replicas
+}
+
+// OpGethStorageSize returns the requested size of the op-geth data volumes
+func OpGethStorageSize(opNode *optimismv1alpha1.OpNode) resource.Quantity {
+	if opNode.Spec.OpGeth.Storage != nil && !opNode.Spec.OpGeth.Storage.Size.IsZero() {
+		return opNode.Spec.OpGeth.Storage.Size
+	}
+	return resource.MustParse(DefaultOpGethStorageSize)
+}
+
+// OpNodeLabels returns the labels shared by the OpNode StatefulSet, pods and Services
+func OpNodeLabels(opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.OptimismNetwork) map[string]string {
+	return map[string]string{
 		"app.kubernetes.io/name":       "opnode",
 		"app.kubernetes.io/instance":   opNode.Name,
 		"app.kubernetes.io/component":  "consensus-layer",
 		"app.kubernetes.io/part-of":    "op-stack",
 		"app.kubernetes.io/managed-by": "op-stack-operator",
 		"optimism.io/network":          network.Spec.NetworkName,
-		"optimism.io/node-type":        opNode.Spec.NodeType,
+		NodeTypeLabel:                  opNode.Spec.NodeType,
 	}
+}
 
-	// Default storage size if not specified
-	storageSize := resource.MustParse("1Ti")
-	if opNode.Spec.OpGeth.Storage != nil && !opNode.Spec.OpGeth.Storage.Size.IsZero() {
-		storageSize = opNode.Spec.OpGeth.Storage.Size
-	}
+// CreateOpNodeStatefulSet creates a StatefulSet for OpNode (op-geth + op-node)
+func CreateOpNodeStatefulSet(
+	opNode *optimismv1alpha1.OpNode,
+	network *optimismv1alpha1.OptimismNetwork,
+) *appsv1.StatefulSet {
+	statefulSet, _ := createOpNodeStatefulSet(opNode, network)
+	return statefulSet
+}
+
+// OpNodeOverrideConflicts describes the user overrides of an OpNode that collide with
+// operator-managed settings and are therefore left out of its StatefulSet
+func OpNodeOverrideConflicts(
+	opNode *optimismv1alpha1.OpNode,
+	network *optimismv1alpha1.OptimismNetwork,
+) []string {
+	_, conflicts := createOpNodeStatefulSet(opNode, network)
+	return conflicts
+}
+
+// createOpNodeStatefulSet renders the OpNode StatefulSet and merges the user overrides into it
+func createOpNodeStatefulSet(
+	opNode *optimismv1alpha1.OpNode,
+	network *optimismv1alpha1.OptimismNetwork,
+) (*appsv1.StatefulSet, []string) {
+	labels := OpNodeLabels(opNode, network)
+
+	storageSize := OpGethStorageSize(opNode)
 
 	// Default storage class
 	storageClass := "fast-ssd"
@@ -62,6 +117,37 @@ func CreateOpNodeStatefulSet(
 		accessMode = corev1.PersistentVolumeAccessMode(opNode.Spec.OpGeth.Storage.AccessMode)
 	}
 
+	// An external execution engine replaces the local op-geth and its data volume
+	var containers []corev1.Container
+	var initContainers []corev1.Container
+	var volumeClaimTemplates []corev1.PersistentVolumeClaim
+	if !ExternalEngine(opNode) {
+		containers = append(containers, createOpGethContainer(opNode, network))
+		initContainers = createInitContainers(opNode, network)
+		volumeClaimTemplates = []corev1.PersistentVolumeClaim{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "geth-data",
+					Labels: labels,
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{accessMode},
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceStorage: storageSize,
+						},
+					},
+					StorageClassName: &storageClass,
+					DataSource:       opGethDataSource(opNode),
+				},
+			},
+		}
+	}
+	containers = append(containers, createOpNodeContainer(opNode, network))
+	if HAEnabled(opNode) {
+		containers = append(containers, createOpConductorContainer(opNode, network))
+	}
+
 	statefulSet := &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      opNode.Name,
@@ -69,45 +155,41 @@ func CreateOpNodeStatefulSet(
 			Labels:    labels,
 		},
 		Spec: appsv1.StatefulSetSpec{
-			Replicas:    int32Ptr(1),
-			ServiceName: opNode.Name,
+			Replicas:    int32Ptr(OpNodeReplicas(opNode)),
+			ServiceName: HeadlessServiceName(opNode),
 			Selector: &metav1.LabelSelector{
 				MatchLabels: labels,
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: labels,
+					Labels:      labels,
+					Annotations: podAnnotations(opNode),
 				},
 				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{
-						createOpGethContainer(opNode, network),
-						createOpNodeContainer(opNode, network),
-					},
+					InitContainers:  initContainers,
+					Containers:      containers,
 					Volumes:         createVolumes(opNode, network),
 					SecurityContext: createPodSecurityContext(network),
 				},
 			},
-			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:   "geth-data",
-						Labels: labels,
-					},
-					Spec: corev1.PersistentVolumeClaimSpec{
-						AccessModes: []corev1.PersistentVolumeAccessMode{accessMode},
-						Resources: corev1.VolumeResourceRequirements{
-							Requests: corev1.ResourceList{
-								corev1.ResourceStorage: storageSize,
-							},
-						},
-						StorageClassName: &storageClass,
-					},
-				},
-			},
+			VolumeClaimTemplates: volumeClaimTemplates,
 		},
 	}
 
-	return statefulSet
+	conflicts := applyOpNodeOverrides(opNode, &statefulSet.Spec.Template.Spec)
+	return statefulSet, conflicts
+}
+
+// podAnnotations returns the pod annotations carrying the op-geth TOML config and the pod bandwidth limit
+func podAnnotations(opNode *optimismv1alpha1.OpNode) map[string]string {
+	annotations := bandwidthAnnotations(opNode)
+	if config := opGethConfigTOML(&opNode.Spec.OpGeth); config != "" && !ExternalEngine(opNode) {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[GethConfigAnnotation] = config
+	}
+	return annotations
 }
 
 // createOpGethContainer creates the op-geth container
@@ -141,7 +223,7 @@ func createOpGethContainer(
 	}
 
 	// Default data directory
-	dataDir := "/data/geth"
+	dataDir := DefaultOpGethDataDir
 	if opNode.Spec.OpGeth.DataDir != "" {
 		dataDir = opNode.Spec.OpGeth.DataDir
 	}
@@ -154,20 +236,27 @@ func createOpGethContainer(
 	}
 
 	// Add sync mode
-	syncMode := "snap"
+	syncMode := DefaultOpGethSyncMode
 	if opNode.Spec.OpGeth.SyncMode != "" {
 		syncMode = opNode.Spec.OpGeth.SyncMode
 	}
 	args = append(args, "--syncmode="+syncMode)
 
+	// A registered chain's auto-discovered genesis is loaded from the op-geth registry
+	geth := opNode.Spec.OpGeth
+	if geth.Network == "" {
+		geth.Network = BundledGenesisNetwork(network)
+	}
+	args = append(args, opGethTuningArgs(&geth)...)
+
 	// Add HTTP RPC configuration
 	if opNode.Spec.OpGeth.Networking != nil &&
 		opNode.Spec.OpGeth.Networking.HTTP != nil &&
 		opNode.Spec.OpGeth.Networking.HTTP.Enabled {
 		httpConfig := opNode.Spec.OpGeth.Networking.HTTP
 		args = append(args, "--http")
-		args = append(args, "--http.addr="+getDefaultString(httpConfig.Host, "0.0.0.0"))
-		args = append(args, "--http.port="+fmt.Sprintf("%d", getDefaultInt32(httpConfig.Port, 8545)))
+		args = append(args, "--http.addr="+getDefaultString(httpConfig.Host, DefaultListenHost))
+		args = append(args, "--http.port="+fmt.Sprintf("%d", getDefaultInt32(httpConfig.Port, DefaultOpGethHTTPPort)))
 		if len(httpConfig.APIs) > 0 {
 			args = append(args, "--http.api="+joinStrings(httpConfig.APIs))
 		}
@@ -182,8 +271,8 @@ func createOpGethContainer(
 		opNode.Spec.OpGeth.Networking.WS.Enabled {
 		wsConfig := opNode.Spec.OpGeth.Networking.WS
 		args = append(args, "--ws")
-		args = append(args, "--ws.addr="+getDefaultString(wsConfig.Host, "0.0.0.0"))
-		args = append(args, "--ws.port="+fmt.Sprintf("%d", getDefaultInt32(wsConfig.Port, 8546)))
+		args = append(args, "--ws.addr="+getDefaultString(wsConfig.Host, DefaultListenHost))
+		args = append(args, "--ws.port="+fmt.Sprintf("%d", getDefaultInt32(wsConfig.Port, DefaultOpGethWSPort)))
 		if len(wsConfig.APIs) > 0 {
 			args = append(args, "--ws.api="+joinStrings(wsConfig.APIs))
 		}
@@ -195,15 +284,28 @@ func createOpGethContainer(
 	// Add auth RPC configuration
 	if opNode.Spec.OpGeth.Networking != nil && opNode.Spec.OpGeth.Networking.AuthRPC != nil {
 		authConfig := opNode.Spec.OpGeth.Networking.AuthRPC
-		args = append(args, "--authrpc.addr="+getDefaultString(authConfig.Host, "127.0.0.1"))
-		args = append(args, "--authrpc.port="+fmt.Sprintf("%d", getDefaultInt32(authConfig.Port, 8551)))
+		args = append(args, "--authrpc.addr="+getDefaultString(authConfig.Host, DefaultAuthRPCHost))
+		args = append(args, "--authrpc.port="+fmt.Sprintf("%d", getDefaultInt32(authConfig.Port, DefaultOpGethAuthRPCPort)))
 		args = append(args, "--authrpc.jwtsecret=/secrets/jwt/jwt")
 	}
 
+	volumeMounts := []corev1.VolumeMount{
+		{Name: "geth-data", MountPath: dataDir},
+		{Name: "jwt-secret", MountPath: "/secrets/jwt", ReadOnly: true},
+		{Name: "rollup-config", MountPath: "/config", ReadOnly: true},
+	}
+	if _, ok := GenesisSelector(network); ok {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: "l2-genesis", MountPath: "/genesis", ReadOnly: true})
+	}
+	if opGethConfigTOML(&opNode.Spec.OpGeth) != "" {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: "geth-config", MountPath: gethConfigDir, ReadOnly: true})
+	}
+
+	images := imagesFor(network, opNode)
 	container := corev1.Container{
 		Name:            "op-geth",
-		Image:           config.DefaultImages.OpGeth,
-		ImagePullPolicy: corev1.PullIfNotPresent,
+		Image:           images.OpGeth,
+		ImagePullPolicy: images.PullPolicy,
 		Command:         []string{"geth"},
 		Args:            args,
 		Resources:       resources,
@@ -211,13 +313,9 @@ func createOpGethContainer(
 			{Name: "http", ContainerPort: 8545, Protocol: corev1.ProtocolTCP},
 			{Name: "ws", ContainerPort: 8546, Protocol: corev1.ProtocolTCP},
 			{Name: "authrpc", ContainerPort: 8551, Protocol: corev1.ProtocolTCP},
-			{Name: "p2p", ContainerPort: 30303, Protocol: corev1.ProtocolTCP},
-		},
-		VolumeMounts: []corev1.VolumeMount{
-			{Name: "geth-data", MountPath: dataDir},
-			{Name: "jwt-secret", MountPath: "/secrets/jwt", ReadOnly: true},
-			{Name: "rollup-config", MountPath: "/config", ReadOnly: true},
+			{Name: "p2p", ContainerPort: getDefaultInt32(gethP2P(&opNode.Spec.OpGeth).Port, DefaultOpGethP2PPort), Protocol: corev1.ProtocolTCP},
 		},
+		VolumeMounts: volumeMounts,
 		LivenessProbe: &corev1.Probe{
 			ProbeHandler: corev1.ProbeHandler{
 				HTTPGet: &corev1.HTTPGetAction{
@@ -279,13 +377,25 @@ func createOpNodeContainer(
 		resources = *opNode.Spec.Resources.OpNode
 	}
 
-	// Build command args
-	authRPCPort := getAuthRPCPort(opNode)
-	args := []string{
-		"--l1=" + network.Spec.L1RpcUrl,
-		"--l2=http://127.0.0.1:" + fmt.Sprintf("%d", authRPCPort),
+	// Build command args; L1 URLs kept in Secrets are passed through the environment
+	args, env := l1RpcSettings(network, "--l1", "OP_NODE_L1_ETH_RPC")
+	args = append(args,
+		"--l2="+opNodeEngineEndpoint(opNode),
 		"--l2.jwt-secret=/secrets/jwt/jwt",
-		"--rollup.config=/config/rollup.json",
+		"--rollup.config=/config/"+RollupConfigKey,
+	)
+
+	// Post-Ecotone blocks are derived from blobs served by the L1 beacon node
+	beaconArgs, beaconEnv := l1BeaconSettings(network, "--l1.beacon", "OP_NODE_L1_BEACON")
+	args = append(args, beaconArgs...)
+	env = append(env, beaconEnv...)
+	if l1 := opNode.Spec.OpNode.L1; l1 != nil {
+		if l1.RPCKind != "" {
+			args = append(args, "--l1.rpckind="+l1.RPCKind)
+		}
+		if l1.TrustRPC {
+			args = append(args, "--l1.trustrpc")
+		}
 	}
 
 	// Add network name if provided
@@ -293,12 +403,17 @@ func createOpNodeContainer(
 		args = append(args, "--network="+network.Spec.NetworkName)
 	}
 
+	if opNode.Spec.OpNode.SyncMode != "" {
+		args = append(args, "--syncmode="+opNode.Spec.OpNode.SyncMode)
+	}
+
 	// Add RPC configuration
 	if opNode.Spec.OpNode.RPC != nil && opNode.Spec.OpNode.RPC.Enabled {
 		rpcConfig := opNode.Spec.OpNode.RPC
-		args = append(args, "--rpc.addr="+getDefaultString(rpcConfig.Host, "0.0.0.0"))
-		args = append(args, "--rpc.port="+fmt.Sprintf("%d", getDefaultInt32(rpcConfig.Port, 9545)))
-		if rpcConfig.EnableAdmin {
+		args = append(args, "--rpc.addr="+getDefaultString(rpcConfig.Host, DefaultListenHost))
+		args = append(args, "--rpc.port="+fmt.Sprintf("%d", getDefaultInt32(rpcConfig.Port, DefaultOpNodeRPCPort)))
+		// op-conductor starts and stops the sequencer through the admin API
+		if rpcConfig.EnableAdmin || HAEnabled(opNode) {
 			args = append(args, "--rpc.enable-admin")
 		}
 	}
@@ -306,11 +421,21 @@ func createOpNodeContainer(
 	// Add P2P configuration
 	if opNode.Spec.OpNode.P2P != nil && opNode.Spec.OpNode.P2P.Enabled {
 		p2pConfig := opNode.Spec.OpNode.P2P
-		args = append(args, "--p2p.listen.tcp="+fmt.Sprintf("%d", getDefaultInt32(p2pConfig.ListenPort, 9003)))
+		args = append(args, "--p2p.listen.tcp="+fmt.Sprintf("%d", getDefaultInt32(p2pConfig.ListenPort, DefaultOpNodeP2PPort)))
 
 		if p2pConfig.Discovery != nil && !p2pConfig.Discovery.Enabled {
 			args = append(args, "--p2p.no-discovery")
 		}
+		if p2pConfig.Discovery != nil && len(p2pConfig.Discovery.Bootnodes) > 0 {
+			args = append(args, "--p2p.bootnodes="+joinStrings(p2pConfig.Discovery.Bootnodes))
+		}
+		if p2pConfig.PeerScoring != nil {
+			scoring := "none"
+			if p2pConfig.PeerScoring.Enabled {
+				scoring = "light"
+			}
+			args = append(args, "--p2p.scoring="+scoring)
+		}
 
 		if len(p2pConfig.Static) > 0 {
 			for _, peer := range p2pConfig.Static {
@@ -321,7 +446,7 @@ func createOpNodeContainer(
 		// Add P2P private key path if either auto-generated or user-provided
 		if p2pConfig.PrivateKey != nil &&
 			(p2pConfig.PrivateKey.Generate || p2pConfig.PrivateKey.SecretRef != nil) {
-			args = append(args, "--p2p.priv.path=/secrets/p2p/private-key")
+			args = append(args, "--p2p.priv.path=/secrets/p2p/"+P2PKeyPrefix+"$(POD_INDEX)")
 		}
 	}
 
@@ -333,6 +458,13 @@ func createOpNodeContainer(
 		}
 	}
 
+	// Hand sequencer leadership to the op-conductor sidecar, which starts the sequencer of the leader
+	if HAEnabled(opNode) {
+		args = append(args, "--sequencer.stopped")
+		args = append(args, "--conductor.enabled")
+		args = append(args, fmt.Sprintf("--conductor.rpc=http://127.0.0.1:%d", ConductorRPCPort(opNode)))
+	}
+
 	// Add logging configuration
 	if network.Spec.SharedConfig != nil && network.Spec.SharedConfig.Logging != nil {
 		logging := network.Spec.SharedConfig.Logging
@@ -351,7 +483,7 @@ func createOpNodeContainer(
 		metrics := network.Spec.SharedConfig.Metrics
 		args = append(args, "--metrics.enabled")
 		args = append(args, "--metrics.addr=0.0.0.0")
-		args = append(args, "--metrics.port="+fmt.Sprintf("%d", getDefaultInt32(metrics.Port, 7300)))
+		args = append(args, "--metrics.port="+fmt.Sprintf("%d", getDefaultInt32(metrics.Port, DefaultMetricsPort)))
 	}
 
 	volumeMounts := []corev1.VolumeMount{
@@ -360,20 +492,28 @@ func createOpNodeContainer(
 	}
 
 	// Add P2P key mount if either auto-generated or user-provided
-	if opNode.Spec.OpNode.P2P != nil &&
+	hasP2PKey := opNode.Spec.OpNode.P2P != nil &&
 		opNode.Spec.OpNode.P2P.PrivateKey != nil &&
-		(opNode.Spec.OpNode.P2P.PrivateKey.Generate || opNode.Spec.OpNode.P2P.PrivateKey.SecretRef != nil) {
+		(opNode.Spec.OpNode.P2P.PrivateKey.Generate || opNode.Spec.OpNode.P2P.PrivateKey.SecretRef != nil)
+	if hasP2PKey {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name: "p2p-key", MountPath: "/secrets/p2p", ReadOnly: true,
 		})
 	}
 
+	// Resolves $(POD_INDEX) in the P2P key path
+	if hasP2PKey {
+		env = append(env, podIndexEnv())
+	}
+
+	images := imagesFor(network, opNode)
 	container := corev1.Container{
 		Name:            "op-node",
-		Image:           config.DefaultImages.OpNode,
-		ImagePullPolicy: corev1.PullIfNotPresent,
+		Image:           images.OpNode,
+		ImagePullPolicy: images.PullPolicy,
 		Command:         []string{"op-node"},
 		Args:            args,
+		Env:             env,
 		Resources:       resources,
 		Ports: []corev1.ContainerPort{
 			{Name: "rpc", ContainerPort: 9545, Protocol: corev1.ProtocolTCP},
@@ -410,27 +550,128 @@ func createOpNodeContainer(
 	return container
 }
 
+// Network configuration files as mounted into OpNode pods
+const (
+	RollupConfigKey = "rollup.json"
+	GenesisKey      = "genesis.json"
+
+	// ConfigHashAnnotation records a hash of the mounted network configuration
+	// on the pod template so content changes roll the pods
+	ConfigHashAnnotation = "optimism.io/config-hash"
+)
+
+// RollupConfigMapName returns the name of the operator-managed rollup config ConfigMap
+func RollupConfigMapName(network *optimismv1alpha1.OptimismNetwork) string {
+	return network.Name + "-rollup-config"
+}
+
+// GenesisConfigMapName returns the name of the operator-managed L2 genesis ConfigMap
+func GenesisConfigMapName(network *optimismv1alpha1.OptimismNetwork) string {
+	return network.Name + "-genesis"
+}
+
+// RollupConfigSelector returns the ConfigMap key holding the network's rollup.json,
+// either the user's ConfigMapRef or the operator-managed ConfigMap
+func RollupConfigSelector(network *optimismv1alpha1.OptimismNetwork) corev1.ConfigMapKeySelector {
+	if network.Spec.RollupConfig != nil && network.Spec.RollupConfig.ConfigMapRef != nil {
+		return *network.Spec.RollupConfig.ConfigMapRef
+	}
+	return corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: RollupConfigMapName(network)},
+		Key:                  RollupConfigKey,
+	}
+}
+
+// GenesisSelector returns the ConfigMap key holding the network's L2 genesis,
+// or false when the network does not provide one or op-geth bundles it
+func GenesisSelector(network *optimismv1alpha1.OptimismNetwork) (corev1.ConfigMapKeySelector, bool) {
+	if network.Spec.L2Genesis == nil || BundledGenesisNetwork(network) != "" {
+		return corev1.ConfigMapKeySelector{}, false
+	}
+	if network.Spec.L2Genesis.ConfigMapRef != nil {
+		return *network.Spec.L2Genesis.ConfigMapRef, true
+	}
+	return corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: GenesisConfigMapName(network)},
+		Key:                  GenesisKey,
+	}, true
+}
+
+// BundledGenesisNetwork returns the op-geth network providing the auto-discovered L2 genesis
+// of a registered chain, as resolved by the network controller, or an empty string
+func BundledGenesisNetwork(network *optimismv1alpha1.OptimismNetwork) string {
+	if network.Spec.L2Genesis == nil || !network.Spec.L2Genesis.AutoDiscover || network.Status.NetworkInfo == nil {
+		return ""
+	}
+	return network.Status.NetworkInfo.OpGethNetwork
+}
+
+// networkConfigVolumeSource projects the selected ConfigMap key to a fixed file name
+func networkConfigVolumeSource(selector corev1.ConfigMapKeySelector, path string) corev1.VolumeSource {
+	return corev1.VolumeSource{
+		ConfigMap: &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: selector.LocalObjectReference,
+			Items:                []corev1.KeyToPath{{Key: selector.Key, Path: path}},
+		},
+	}
+}
+
 // createVolumes creates the volumes for the pod
 func createVolumes(opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.OptimismNetwork) []corev1.Volume {
+	// A user-provided Engine API secret is projected to the path of the generated one
+	jwtSource := corev1.SecretVolumeSource{SecretName: opNode.Name + "-jwt"}
+	if ref := jwtSecretRef(opNode); ref != nil {
+		jwtSource = corev1.SecretVolumeSource{
+			SecretName: ref.SecretRef.Name,
+			Items:      []corev1.KeyToPath{{Key: ref.SecretRef.Key, Path: "jwt"}},
+		}
+	}
 	volumes := []corev1.Volume{
 		{
-			Name: "jwt-secret",
+			Name:         "jwt-secret",
+			VolumeSource: corev1.VolumeSource{Secret: &jwtSource},
+		},
+		{
+			Name:         "rollup-config",
+			VolumeSource: networkConfigVolumeSource(RollupConfigSelector(network), RollupConfigKey),
+		},
+	}
+
+	if genesis, ok := GenesisSelector(network); ok {
+		volumes = append(volumes, corev1.Volume{
+			Name:         "l2-genesis",
+			VolumeSource: networkConfigVolumeSource(genesis, GenesisKey),
+		})
+	}
+
+	// The op-geth TOML config is projected from a pod annotation, so changes roll the pods
+	if opGethConfigTOML(&opNode.Spec.OpGeth) != "" && !ExternalEngine(opNode) {
+		volumes = append(volumes, corev1.Volume{
+			Name: "geth-config",
 			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: opNode.Name + "-jwt",
+				DownwardAPI: &corev1.DownwardAPIVolumeSource{
+					Items: []corev1.DownwardAPIVolumeFile{{
+						Path:     gethConfigFile,
+						FieldRef: &corev1.ObjectFieldSelector{FieldPath: fmt.Sprintf("metadata.annotations['%s']", GethConfigAnnotation)},
+					}},
 				},
 			},
-		},
-		{
-			Name: "rollup-config",
+		})
+	}
+
+	// op-conductor reads the Raft bootstrap decision from the pod annotations
+	if HAEnabled(opNode) {
+		volumes = append(volumes, corev1.Volume{
+			Name: "pod-info",
 			VolumeSource: corev1.VolumeSource{
-				ConfigMap: &corev1.ConfigMapVolumeSource{
-					LocalObjectReference: corev1.LocalObjectReference{
-						Name: network.Name + "-rollup-config",
-					},
+				DownwardAPI: &corev1.DownwardAPIVolumeSource{
+					Items: []corev1.DownwardAPIVolumeFile{{
+						Path:     "annotations",
+						FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.annotations"},
+					}},
 				},
 			},
-		},
+		})
 	}
 
 	// Add P2P key volume if either auto-generated or user-provided
@@ -438,23 +679,20 @@ func createVolumes(opNode *optimismv1alpha1.OpNode, network *optimismv1alpha1.Op
 		opNode.Spec.OpNode.P2P.PrivateKey != nil &&
 		(opNode.Spec.OpNode.P2P.PrivateKey.Generate || opNode.Spec.OpNode.P2P.PrivateKey.SecretRef != nil) {
 
-		// Determine the secret name based on how the key is managed
-		var secretName string
+		// Generated secrets hold a key per ordinal; a user-provided key serves the
+		// single pod a node may run with it as ordinal 0
+		var source corev1.SecretVolumeSource
 		if opNode.Spec.OpNode.P2P.PrivateKey.Generate {
-			// Use the auto-generated secret name pattern
-			secretName = opNode.Name + "-p2p"
-		} else if opNode.Spec.OpNode.P2P.PrivateKey.SecretRef != nil {
-			// Use the user-provided secret name
-			secretName = opNode.Spec.OpNode.P2P.PrivateKey.SecretRef.Name
+			source.SecretName = opNode.Name + "-p2p"
+		} else {
+			secretRef := opNode.Spec.OpNode.P2P.PrivateKey.SecretRef
+			source.SecretName = secretRef.Name
+			source.Items = []corev1.KeyToPath{{Key: secretRef.Key, Path: P2PKeyName(0)}}
 		}
 
 		volumes = append(volumes, corev1.Volume{
-			Name: "p2p-key",
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: secretName,
-				},
-			},
+			Name:         "p2p-key",
+			VolumeSource: corev1.VolumeSource{Secret: &source},
 		})
 	}
 
@@ -492,6 +730,310 @@ func createPodSecurityContext(network *optimismv1alpha1.OptimismNetwork) *corev1
 	return securityContext
 }
 
+// CreateOpChallengerStatefulSet creates a StatefulSet for OpChallenger with a PVC for game data
+func CreateOpChallengerStatefulSet(
+	opChallenger *optimismv1alpha1.OpChallenger,
+	network *optimismv1alpha1.OptimismNetwork,
+	rollupNode *optimismv1alpha1.OpNode,
+) *appsv1.StatefulSet {
+	labels := OpChallengerLabels(opChallenger, network)
+
+	// Default storage size if not specified
+	storageSize := resource.MustParse("50Gi")
+	if opChallenger.Spec.Storage != nil && !opChallenger.Spec.Storage.Size.IsZero() {
+		storageSize = opChallenger.Spec.Storage.Size
+	}
+
+	// Default storage class
+	storageClass := "fast-ssd"
+	if opChallenger.Spec.Storage != nil && opChallenger.Spec.Storage.StorageClass != "" {
+		storageClass = opChallenger.Spec.Storage.StorageClass
+	}
+
+	// Default access mode
+	accessMode := corev1.ReadWriteOnce
+	if opChallenger.Spec.Storage != nil && opChallenger.Spec.Storage.AccessMode != "" {
+		accessMode = corev1.PersistentVolumeAccessMode(opChallenger.Spec.Storage.AccessMode)
+	}
+
+	statefulSet := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opChallenger.Name,
+			Namespace: opChallenger.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			// Concurrent challengers would race on the same nonce
+			Replicas:    int32Ptr(1),
+			ServiceName: opChallenger.Name,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						createOpChallengerContainer(opChallenger, network, rollupNode),
+					},
+					Volumes:         createOpChallengerVolumes(opChallenger),
+					SecurityContext: createPodSecurityContext(network),
+				},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "challenger-data",
+						Labels: labels,
+					},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{accessMode},
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceStorage: storageSize,
+							},
+						},
+						StorageClassName: &storageClass,
+					},
+				},
+			},
+		},
+	}
+
+	return statefulSet
+}
+
+// OpChallengerLabels returns the labels applied to all OpChallenger resources
+func OpChallengerLabels(opChallenger *optimismv1alpha1.OpChallenger, network *optimismv1alpha1.OptimismNetwork) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "opchallenger",
+		"app.kubernetes.io/instance":   opChallenger.Name,
+		"app.kubernetes.io/component":  "challenger",
+		"app.kubernetes.io/part-of":    "op-stack",
+		"app.kubernetes.io/managed-by": "op-stack-operator",
+		"optimism.io/network":          network.Spec.NetworkName,
+	}
+}
+
+// OpChallengerUsesCannon reports whether any configured trace type runs the cannon VM
+func OpChallengerUsesCannon(opChallenger *optimismv1alpha1.OpChallenger) bool {
+	for _, traceType := range opChallenger.Spec.TraceTypes {
+		if traceType == optimismv1alpha1.TraceTypeCannon || traceType == optimismv1alpha1.TraceTypePermissioned {
+			return true
+		}
+	}
+	return false
+}
+
+// OpChallengerUsesAsterisc reports whether any configured trace type runs the asterisc VM
+func OpChallengerUsesAsterisc(opChallenger *optimismv1alpha1.OpChallenger) bool {
+	for _, traceType := range opChallenger.Spec.TraceTypes {
+		if traceType == optimismv1alpha1.TraceTypeAsterisc {
+			return true
+		}
+	}
+	return false
+}
+
+// createOpChallengerContainer creates the op-challenger container
+func createOpChallengerContainer(
+	opChallenger *optimismv1alpha1.OpChallenger,
+	network *optimismv1alpha1.OptimismNetwork,
+	rollupNode *optimismv1alpha1.OpNode,
+) corev1.Container {
+	// Default resource requirements for op-challenger; trace generation is CPU and memory heavy
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("1"),
+			corev1.ResourceMemory: resource.MustParse("2Gi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("4"),
+			corev1.ResourceMemory: resource.MustParse("8Gi"),
+		},
+	}
+
+	// Override with user-specified resources
+	if opChallenger.Spec.Resources != nil {
+		resources = *opChallenger.Spec.Resources
+	}
+
+	traceTypes := make([]string, 0, len(opChallenger.Spec.TraceTypes))
+	for _, traceType := range opChallenger.Spec.TraceTypes {
+		traceTypes = append(traceTypes, string(traceType))
+	}
+
+	// Build command args; L1 URLs kept in Secrets are passed through the environment
+	args, env := l1RpcSettings(network, "--l1-eth-rpc", "OP_CHALLENGER_L1_ETH_RPC")
+	beaconArgs, beaconEnv := l1BeaconSettings(network, "--l1-beacon", "OP_CHALLENGER_L1_BEACON")
+	args = append(args, beaconArgs...)
+	env = append(env, beaconEnv...)
+	args = append(args,
+		"--l2-eth-rpc="+GetOpGethRPCEndpoint(rollupNode),
+		"--rollup-rpc="+GetOpNodeRPCEndpoint(rollupNode),
+		"--game-factory-address="+GetOpChallengerGameFactory(network),
+		"--trace-type="+joinStrings(traceTypes),
+		"--datadir=/data",
+	)
+
+	// Add network name if provided
+	if network.Spec.NetworkName != "" {
+		args = append(args, "--network="+network.Spec.NetworkName)
+	}
+
+	if OpChallengerUsesCannon(opChallenger) {
+		args = append(args, buildVMTraceArgs("cannon", opChallenger.Spec.Cannon, DefaultCannonBinaryPath, DefaultCannonServerPath)...)
+	}
+	if OpChallengerUsesAsterisc(opChallenger) {
+		args = append(args, buildVMTraceArgs("asterisc", opChallenger.Spec.Asterisc, "", "")...)
+	}
+
+	if opChallenger.Spec.MaxConcurrency > 0 {
+		args = append(args, fmt.Sprintf("--max-concurrency=%d", opChallenger.Spec.MaxConcurrency))
+	}
+
+	// Add L1 transaction manager configuration
+	args = append(args, buildTxManagerArgs(opChallenger.Spec.L1Transaction)...)
+
+	// Add metrics configuration
+	metricsPort := GetOpChallengerMetricsPort(opChallenger)
+	metricsEnabled := opChallenger.Spec.Metrics == nil || opChallenger.Spec.Metrics.Enabled
+	if metricsEnabled {
+		args = append(args, "--metrics.enabled")
+		args = append(args, "--metrics.addr=0.0.0.0")
+		args = append(args, fmt.Sprintf("--metrics.port=%d", metricsPort))
+	}
+
+	// Add logging configuration
+	args = append(args, buildLoggingArgs(network)...)
+
+	volumeMounts := []corev1.VolumeMount{
+		{Name: "challenger-data", MountPath: "/data"},
+		{Name: "tmp", MountPath: "/tmp"},
+	}
+	if opChallenger.Spec.Cannon != nil && opChallenger.Spec.Cannon.Prestate != nil && opChallenger.Spec.Cannon.Prestate.ConfigMapRef != nil {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: "cannon-prestate", MountPath: "/prestates/cannon", ReadOnly: true})
+	}
+	if opChallenger.Spec.Asterisc != nil && opChallenger.Spec.Asterisc.Prestate != nil && opChallenger.Spec.Asterisc.Prestate.ConfigMapRef != nil {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{Name: "asterisc-prestate", MountPath: "/prestates/asterisc", ReadOnly: true})
+	}
+
+	images := imagesFor(network, nil)
+	container := corev1.Container{
+		Name:            "op-challenger",
+		Image:           images.OpChallenger,
+		ImagePullPolicy: images.PullPolicy,
+		Command:         []string{"op-challenger"},
+		Args:            args,
+		Env: append([]corev1.EnvVar{
+			{
+				// The private key is never rendered into the args
+				Name: "OP_CHALLENGER_PRIVATE_KEY",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: opChallenger.Spec.PrivateKey.SecretRef,
+				},
+			},
+		}, env...),
+		Resources: resources,
+		Ports: []corev1.ContainerPort{
+			{Name: "metrics", ContainerPort: metricsPort, Protocol: corev1.ProtocolTCP},
+		},
+		VolumeMounts:    volumeMounts,
+		SecurityContext: createContainerSecurityContext(),
+	}
+
+	if metricsEnabled {
+		container.LivenessProbe, container.ReadinessProbe = createMetricsProbes(metricsPort)
+	}
+
+	return container
+}
+
+// buildVMTraceArgs builds the binary, server and prestate flags for a fault proof VM
+func buildVMTraceArgs(vm string, vmConfig *optimismv1alpha1.VMTraceConfig, defaultBinary, defaultServer string) []string {
+	binaryPath, serverPath := defaultBinary, defaultServer
+	if vmConfig != nil {
+		binaryPath = getDefaultString(vmConfig.BinaryPath, binaryPath)
+		serverPath = getDefaultString(vmConfig.ServerPath, serverPath)
+	}
+
+	var args []string
+	if binaryPath != "" {
+		args = append(args, fmt.Sprintf("--%s-bin=%s", vm, binaryPath))
+	}
+	if serverPath != "" {
+		args = append(args, fmt.Sprintf("--%s-server=%s", vm, serverPath))
+	}
+
+	if vmConfig != nil && vmConfig.Prestate != nil {
+		prestate := vmConfig.Prestate
+		if prestate.ConfigMapRef != nil {
+			args = append(args, fmt.Sprintf("--%s-prestate=/prestates/%s/%s", vm, vm, prestate.ConfigMapRef.Key))
+		} else if prestate.URL != "" {
+			args = append(args, fmt.Sprintf("--%s-prestates-url=%s", vm, prestate.URL))
+		}
+	}
+
+	return args
+}
+
+// createOpChallengerVolumes creates the non-persistent volumes for the op-challenger pod
+func createOpChallengerVolumes(opChallenger *optimismv1alpha1.OpChallenger) []corev1.Volume {
+	volumes := []corev1.Volume{
+		{
+			// Scratch space for the VMs with a read-only root filesystem
+			Name: "tmp",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+
+	prestateSources := []struct {
+		name     string
+		vmConfig *optimismv1alpha1.VMTraceConfig
+	}{
+		{name: "cannon-prestate", vmConfig: opChallenger.Spec.Cannon},
+		{name: "asterisc-prestate", vmConfig: opChallenger.Spec.Asterisc},
+	}
+	for _, source := range prestateSources {
+		if source.vmConfig == nil || source.vmConfig.Prestate == nil || source.vmConfig.Prestate.ConfigMapRef == nil {
+			continue
+		}
+		ref := source.vmConfig.Prestate.ConfigMapRef
+		volumes = append(volumes, corev1.Volume{
+			Name: source.name,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: ref.LocalObjectReference,
+					Items: []corev1.KeyToPath{
+						{Key: ref.Key, Path: ref.Key},
+					},
+				},
+			},
+		})
+	}
+
+	return volumes
+}
+
+// GetOpChallengerGameFactory returns the DisputeGameFactory address discovered for the network
+func GetOpChallengerGameFactory(network *optimismv1alpha1.OptimismNetwork) string {
+	if network.Status.NetworkInfo == nil || network.Status.NetworkInfo.DiscoveredContracts == nil {
+		return ""
+	}
+	return network.Status.NetworkInfo.DiscoveredContracts.DisputeGameFactoryAddr
+}
+
+// GetOpChallengerMetricsPort returns the configured metrics port for op-challenger
+func GetOpChallengerMetricsPort(opChallenger *optimismv1alpha1.OpChallenger) int32 {
+	if opChallenger.Spec.Metrics != nil {
+		return getDefaultInt32(opChallenger.Spec.Metrics.Port, DefaultMetricsPort)
+	}
+	return 7300
+}
+
 // Helper functions
 func int32Ptr(i int32) *int32 {
 	return &i
@@ -577,15 +1119,23 @@ func getSequencerEndpoint(opNode *optimismv1alpha1.OpNode, network *optimismv1al
 func getOpGethHTTPPort(opNode *optimismv1alpha1.OpNode) int32 {
 	if opNode.Spec.OpGeth.Networking != nil &&
 		opNode.Spec.OpGeth.Networking.HTTP != nil {
-		return getDefaultInt32(opNode.Spec.OpGeth.Networking.HTTP.Port, 8545)
+		return getDefaultInt32(opNode.Spec.OpGeth.Networking.HTTP.Port, DefaultOpGethHTTPPort)
 	}
 	return 8545
 }
 
+// getOpNodeRPCPort returns the configured RPC port for op-node
+func getOpNodeRPCPort(opNode *optimismv1alpha1.OpNode) int32 {
+	if opNode.Spec.OpNode.RPC != nil {
+		return getDefaultInt32(opNode.Spec.OpNode.RPC.Port, DefaultOpNodeRPCPort)
+	}
+	return DefaultOpNodeRPCPort
+}
+
 // getAuthRPCPort returns the configured AuthRPC port for op-geth
 func getAuthRPCPort(opNode *optimismv1alpha1.OpNode) int32 {
 	if opNode.Spec.OpGeth.Networking != nil && opNode.Spec.OpGeth.Networking.AuthRPC != nil {
-		return getDefaultInt32(opNode.Spec.OpGeth.Networking.AuthRPC.Port, 8551)
+		return getDefaultInt32(opNode.Spec.OpGeth.Networking.AuthRPC.Port, DefaultOpGethAuthRPCPort)
 	}
 	return 8551
 }
@@ -0,0 +1,234 @@
+package rollup
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/ethereum-optimism/op-stack-operator/pkg/utils"
+)
+
+// Defaults for chain parameters that are not recorded on L1
+const (
+	DefaultBlockTime         = 2
+	DefaultMaxSequencerDrift = 600
+	DefaultSeqWindowSize     = 3600
+	DefaultChannelTimeout    = 300
+)
+
+// systemConfigABI covers the SystemConfig getters that seed the genesis system config
+const systemConfigABI = `[
+	{"type":"function","name":"startBlock","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"batcherHash","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"function","name":"gasLimit","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint64"}]},
+	{"type":"function","name":"overhead","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"scalar","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+// L1Client is the subset of an L1 RPC client needed to build a rollup config
+type L1Client interface {
+	ethereum.ContractCaller
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Hardforks are optional hardfork activation timestamps
+type Hardforks struct {
+	Regolith, Canyon, Delta, Ecotone, Fjord, Granite, Holocene, Isthmus *uint64
+}
+
+// Inputs are the chain parameters and contract addresses a rollup config is built from
+type Inputs struct {
+	L1ChainID uint64
+	L2ChainID uint64
+
+	SystemConfig     common.Address
+	OptimismPortal   common.Address
+	BatchInbox       common.Address
+	ProtocolVersions *common.Address
+
+	// L2Genesis is the L2 genesis block; L2GenesisTime defaults to the L1 start block time
+	L2Genesis     BlockID
+	L2GenesisTime uint64
+
+	BlockTime         uint64
+	MaxSequencerDrift uint64
+	SeqWindowSize     uint64
+	ChannelTimeout    uint64
+
+	Hardforks Hardforks
+}
+
+// ReadGenesis reads the genesis anchor of a chain from L1: the SystemConfig start block and the
+// system config as it was at that block. Later SystemConfig updates reach op-node through
+// ConfigUpdate events and must not be part of the genesis, so the getters are called against
+// historical state, which the L1 node has to serve for the start block.
+func ReadGenesis(ctx context.Context, l1 L1Client, in Inputs) (Genesis, error) {
+	contractABI, err := abi.JSON(strings.NewReader(systemConfigABI))
+	if err != nil {
+		return Genesis{}, err
+	}
+
+	call := func(method string, block *big.Int, required bool) (interface{}, error) {
+		data, err := contractABI.Pack(method)
+		if err != nil {
+			return nil, err
+		}
+		result, err := l1.CallContract(ctx, ethereum.CallMsg{To: &in.SystemConfig, Data: data}, block)
+		if err != nil {
+			// Only a revert means the getter is missing on this SystemConfig version
+			if !required && utils.IsExecutionReverted(err) {
+				return nil, nil
+			}
+			if block != nil {
+				return nil, fmt.Errorf("SystemConfig.%s() call at L1 block %s failed, the L1 RPC must serve state of that block: %w", method, block, err)
+			}
+			return nil, fmt.Errorf("SystemConfig.%s() call failed: %w", method, err)
+		}
+		out, err := contractABI.Unpack(method, result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unpack SystemConfig.%s() result: %w", method, err)
+		}
+		return out[0], nil
+	}
+
+	value, err := call("startBlock", nil, true)
+	if err != nil {
+		return Genesis{}, err
+	}
+	startBlock := value.(*big.Int)
+
+	batcherHash, err := call("batcherHash", startBlock, true)
+	if err != nil {
+		return Genesis{}, err
+	}
+	gasLimit, err := call("gasLimit", startBlock, true)
+	if err != nil {
+		return Genesis{}, err
+	}
+
+	systemConfig := SystemConfig{
+		BatcherAddr: common.BytesToAddress(common.Hash(batcherHash.([32]byte)).Bytes()),
+		GasLimit:    gasLimit.(uint64),
+	}
+	// overhead and scalar are deprecated on newer SystemConfig versions
+	for method, target := range map[string]*common.Hash{"overhead": &systemConfig.Overhead, "scalar": &systemConfig.Scalar} {
+		value, err := call(method, startBlock, false)
+		if err != nil {
+			return Genesis{}, err
+		}
+		if value != nil {
+			*target = common.BigToHash(value.(*big.Int))
+		}
+	}
+
+	l1Origin, err := l1.HeaderByNumber(ctx, startBlock)
+	if err != nil {
+		return Genesis{}, fmt.Errorf("failed to fetch L1 start block %s: %w", startBlock, err)
+	}
+
+	l2Time := in.L2GenesisTime
+	if l2Time == 0 {
+		l2Time = l1Origin.Time
+	}
+
+	return Genesis{
+		L1:           BlockID{Hash: l1Origin.Hash(), Number: l1Origin.Number.Uint64()},
+		L2:           in.L2Genesis,
+		L2Time:       l2Time,
+		SystemConfig: systemConfig,
+	}, nil
+}
+
+// Assemble builds a rollup config from its inputs and the genesis read by ReadGenesis.
+// The result is checked before it is returned.
+func Assemble(in Inputs, genesis Genesis) (*Config, error) {
+	cfg := &Config{
+		Genesis:                 genesis,
+		BlockTime:               valueOrDefault(in.BlockTime, DefaultBlockTime),
+		MaxSequencerDrift:       valueOrDefault(in.MaxSequencerDrift, DefaultMaxSequencerDrift),
+		SeqWindowSize:           valueOrDefault(in.SeqWindowSize, DefaultSeqWindowSize),
+		ChannelTimeout:          valueOrDefault(in.ChannelTimeout, DefaultChannelTimeout),
+		L1ChainID:               in.L1ChainID,
+		L2ChainID:               in.L2ChainID,
+		BatchInboxAddress:       in.BatchInbox,
+		DepositContractAddress:  in.OptimismPortal,
+		L1SystemConfigAddress:   in.SystemConfig,
+		ProtocolVersionsAddress: in.ProtocolVersions,
+	}
+
+	if err := applyHardforks(cfg, in.Hardforks); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Check(); err != nil {
+		return nil, fmt.Errorf("generated rollup config is invalid: %w", err)
+	}
+	return cfg, nil
+}
+
+// valueOrDefault returns value, or def when value is unset
+func valueOrDefault(value, def uint64) uint64 {
+	if value == 0 {
+		return def
+	}
+	return value
+}
+
+// applyHardforks sets the fork activation times. A chain without any schedule starts with every
+// fork up to Holocene active at genesis. Otherwise only scheduled forks activate: Regolith
+// defaults to genesis, forks after the last scheduled one stay inactive, and an unscheduled fork
+// before a scheduled one is rejected instead of being given a time nobody chose.
+func applyHardforks(cfg *Config, forks Hardforks) error {
+	schedule := []struct {
+		name      string
+		scheduled *uint64
+		target    **uint64
+	}{
+		{"regolith", forks.Regolith, &cfg.RegolithTime},
+		{"canyon", forks.Canyon, &cfg.CanyonTime},
+		{"delta", forks.Delta, &cfg.DeltaTime},
+		{"ecotone", forks.Ecotone, &cfg.EcotoneTime},
+		{"fjord", forks.Fjord, &cfg.FjordTime},
+		{"granite", forks.Granite, &cfg.GraniteTime},
+		{"holocene", forks.Holocene, &cfg.HoloceneTime},
+		{"isthmus", forks.Isthmus, &cfg.IsthmusTime},
+	}
+
+	if forks == (Hardforks{}) {
+		for _, fork := range schedule {
+			if fork.name == "isthmus" {
+				break
+			}
+			genesis := uint64(0)
+			*fork.target = &genesis
+		}
+		return nil
+	}
+
+	var unscheduled string
+	for i, fork := range schedule {
+		activation := fork.scheduled
+		if activation == nil && i == 0 {
+			genesis := uint64(0)
+			activation = &genesis
+		}
+		if activation == nil {
+			if unscheduled == "" {
+				unscheduled = fork.name
+			}
+			continue
+		}
+		if unscheduled != "" {
+			return fmt.Errorf("hardfork %s is scheduled but %s is not; every fork before %s needs an activation time", fork.name, unscheduled, fork.name)
+		}
+		value := *activation
+		*fork.target = &value
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Package rollup builds and validates op-node rollup configurations (rollup.json).
+package rollup
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BlockID identifies a block by hash and number
+type BlockID struct {
+	Hash   common.Hash `json:"hash"`
+	Number uint64      `json:"number"`
+}
+
+// SystemConfig is the genesis system configuration of the rollup
+type SystemConfig struct {
+	BatcherAddr       common.Address `json:"batcherAddr"`
+	Overhead          common.Hash    `json:"overhead"`
+	Scalar            common.Hash    `json:"scalar"`
+	GasLimit          uint64         `json:"gasLimit"`
+	EIP1559Params     *string        `json:"eip1559Params,omitempty"`
+	OperatorFeeParams *common.Hash   `json:"operatorFeeParams,omitempty"`
+}
+
+// Genesis anchors the rollup to its L1 origin and L2 genesis block
+type Genesis struct {
+	L1           BlockID      `json:"l1"`
+	L2           BlockID      `json:"l2"`
+	L2Time       uint64       `json:"l2_time"`
+	SystemConfig SystemConfig `json:"system_config"`
+}
+
+// ChainOpConfig holds the EIP-1559 parameters of the L2
+type ChainOpConfig struct {
+	EIP1559Elasticity        uint64  `json:"eip1559Elasticity"`
+	EIP1559Denominator       uint64  `json:"eip1559Denominator"`
+	EIP1559DenominatorCanyon *uint64 `json:"eip1559DenominatorCanyon,omitempty"`
+}
+
+// Config mirrors the parts of the rollup.json schema op-node accepts for --rollup.config
+// that the operator generates or checks
+type Config struct {
+	Genesis           Genesis `json:"genesis"`
+	BlockTime         uint64  `json:"block_time"`
+	MaxSequencerDrift uint64  `json:"max_sequencer_drift"`
+	SeqWindowSize     uint64  `json:"seq_window_size"`
+	ChannelTimeout    uint64  `json:"channel_timeout"`
+	L1ChainID         uint64  `json:"l1_chain_id"`
+	L2ChainID         uint64  `json:"l2_chain_id"`
+
+	RegolithTime           *uint64 `json:"regolith_time,omitempty"`
+	CanyonTime             *uint64 `json:"canyon_time,omitempty"`
+	DeltaTime              *uint64 `json:"delta_time,omitempty"`
+	EcotoneTime            *uint64 `json:"ecotone_time,omitempty"`
+	FjordTime              *uint64 `json:"fjord_time,omitempty"`
+	GraniteTime            *uint64 `json:"granite_time,omitempty"`
+	HoloceneTime           *uint64 `json:"holocene_time,omitempty"`
+	PectraBlobScheduleTime *uint64 `json:"pectra_blob_schedule_time,omitempty"`
+	IsthmusTime            *uint64 `json:"isthmus_time,omitempty"`
+	JovianTime             *uint64 `json:"jovian_time,omitempty"`
+	InteropTime            *uint64 `json:"interop_time,omitempty"`
+
+	BatchInboxAddress       common.Address  `json:"batch_inbox_address"`
+	DepositContractAddress  common.Address  `json:"deposit_contract_address"`
+	L1SystemConfigAddress   common.Address  `json:"l1_system_config_address"`
+	ProtocolVersionsAddress *common.Address `json:"protocol_versions_address,omitempty"`
+
+	ChainOpConfig *ChainOpConfig  `json:"chain_op_config,omitempty"`
+	AltDAConfig   json.RawMessage `json:"alt_da,omitempty"`
+}
+
+// Parse decodes a rollup.json document. Only the fields the operator reads are decoded;
+// others, such as legacy plasma fields or fields of newer op-node releases, are left for
+// op-node to validate.
+func Parse(data []byte) (*Config, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, errors.New("empty rollup config")
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid rollup config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// Marshal renders the config as indented rollup.json
+func (c *Config) Marshal() (string, error) {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// Check applies the same sanity checks op-node runs before accepting a rollup config
+func (c *Config) Check() error {
+	var errs []error
+	if c.BlockTime == 0 {
+		errs = append(errs, errors.New("block_time cannot be 0"))
+	}
+	if c.ChannelTimeout == 0 {
+		errs = append(errs, errors.New("channel_timeout cannot be 0"))
+	}
+	if c.SeqWindowSize < 2 {
+		errs = append(errs, errors.New("seq_window_size must be at least 2"))
+	}
+	if c.MaxSequencerDrift == 0 {
+		errs = append(errs, errors.New("max_sequencer_drift cannot be 0"))
+	}
+	if c.Genesis.L1.Hash == (common.Hash{}) {
+		errs = append(errs, errors.New("genesis L1 hash cannot be empty"))
+	}
+	if c.Genesis.L2.Hash == (common.Hash{}) {
+		errs = append(errs, errors.New("genesis L2 hash cannot be empty"))
+	}
+	if c.Genesis.L2Time == 0 {
+		errs = append(errs, errors.New("genesis l2_time cannot be 0"))
+	}
+	if c.Genesis.SystemConfig.BatcherAddr == (common.Address{}) {
+		errs = append(errs, errors.New("genesis batcher address cannot be empty"))
+	}
+	if c.Genesis.SystemConfig.GasLimit == 0 {
+		errs = append(errs, errors.New("genesis gas limit cannot be 0"))
+	}
+	if c.BatchInboxAddress == (common.Address{}) {
+		errs = append(errs, errors.New("batch_inbox_address cannot be empty"))
+	}
+	if c.DepositContractAddress == (common.Address{}) {
+		errs = append(errs, errors.New("deposit_contract_address cannot be empty"))
+	}
+	if c.L1ChainID == 0 {
+		errs = append(errs, errors.New("l1_chain_id cannot be 0"))
+	}
+	if c.L2ChainID == 0 {
+		errs = append(errs, errors.New("l2_chain_id cannot be 0"))
+	}
+	if c.L1ChainID != 0 && c.L1ChainID == c.L2ChainID {
+		errs = append(errs, errors.New("l1_chain_id and l2_chain_id must differ"))
+	}
+	if err := c.checkForkOrder(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// checkForkOrder ensures each hardfork activates no earlier than its predecessor
+// and that no fork is scheduled while a predecessor is left unscheduled
+func (c *Config) checkForkOrder() error {
+	forks := []struct {
+		name string
+		time *uint64
+	}{
+		{"regolith", c.RegolithTime},
+		{"canyon", c.CanyonTime},
+		{"delta", c.DeltaTime},
+		{"ecotone", c.EcotoneTime},
+		{"fjord", c.FjordTime},
+		{"granite", c.GraniteTime},
+		{"holocene", c.HoloceneTime},
+		{"isthmus", c.IsthmusTime},
+		{"jovian", c.JovianTime},
+	}
+
+	for i := 1; i < len(forks); i++ {
+		prev, next := forks[i-1], forks[i]
+		if next.time == nil {
+			continue
+		}
+		if prev.time == nil {
+			return fmt.Errorf("%s_time is set but %s_time is not", next.name, prev.name)
+		}
+		if *prev.time > *next.time {
+			return fmt.Errorf("%s_time (%d) must not be before %s_time (%d)", next.name, *next.time, prev.name, *prev.time)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+// Package superchain provides an offline, embedded snapshot of the
+// superchain-registry chain list, contract addresses and rollup configs.
+//
+// The snapshot is generated by hack/superchain-export (see go:generate below) and
+// only lists chains the default op-node and op-geth images bundle. Instead of the
+// large genesis allocations it carries a genesis reference per chain: the op-geth
+// --op-network that provides it. Full genesis files may be embedded for selected
+// chains or supplied through an override directory.
+package superchain
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Data file layout shared by the embedded snapshot and override directories.
+// The flat layout lets an override be mounted directly from a ConfigMap.
+const (
+	ChainListFile   = "chainList.json"
+	AddressesFile   = "addresses.json"
+	GenesisRefsFile = "genesisRefs.json"
+	rollupPrefix    = "rollup-"
+	genesisPrefix   = "genesis-"
+)
+
+// Address keys used in addresses.json, matching the superchain-registry naming
+const (
+	SystemConfigProxy           = "SystemConfigProxy"
+	OptimismPortalProxy         = "OptimismPortalProxy"
+	L1CrossDomainMessengerProxy = "L1CrossDomainMessengerProxy"
+	L1StandardBridgeProxy       = "L1StandardBridgeProxy"
+	L2OutputOracleProxy         = "L2OutputOracleProxy"
+	DisputeGameFactoryProxy     = "DisputeGameFactoryProxy"
+	BatchSubmitter              = "BatchSubmitter"
+)
+
+//go:generate go run ../../hack/superchain-export -ref $SUPERCHAIN_REGISTRY_REF -out data
+
+//go:embed data/*.json
+var embedded embed.FS
+
+// Chain is a single chain entry of the registry
+type Chain struct {
+	Name       string
+	Identifier string
+	ChainID    int64
+	// Superchain is the L1 the chain settles on (e.g. "mainnet", "sepolia")
+	Superchain string
+	Addresses  map[string]string
+	// RollupConfig is the op-node rollup.json for the chain, if known
+	RollupConfig json.RawMessage
+	// Genesis is the op-geth genesis.json for the chain, if embedded or supplied by an override
+	Genesis json.RawMessage
+	// GenesisRef names the op-geth network bundling the genesis, if known
+	GenesisRef *GenesisRef
+}
+
+// GenesisRef references the L2 genesis of a chain bundled with op-geth
+type GenesisRef struct {
+	// OpGethNetwork is the op-geth --op-network name of the chain, e.g. op-sepolia
+	OpGethNetwork string `json:"opGethNetwork"`
+	// L2GenesisHash is the hash of the L2 genesis block op-node expects
+	L2GenesisHash string `json:"l2GenesisHash"`
+}
+
+// OpNetworkName returns the op-node and op-geth network name of a registry
+// identifier, e.g. op-sepolia for sepolia/op
+func OpNetworkName(identifier string) string {
+	superchainName, name, ok := strings.Cut(identifier, "/")
+	if !ok {
+		return identifier
+	}
+	return name + "-" + superchainName
+}
+
+// Address returns the named contract address, or an empty string if unknown
+func (c *Chain) Address(name string) string {
+	return c.Addresses[name]
+}
+
+// Registry is an offline index of superchain-registry chains keyed by chain ID.
+// A loaded registry is read-only and safe for concurrent use.
+type Registry struct {
+	chains map[int64]*Chain
+}
+
+// chainListEntry mirrors an entry of the superchain-registry chainList.json
+type chainListEntry struct {
+	Name       string `json:"name"`
+	Identifier string `json:"identifier"`
+	ChainID    int64  `json:"chainId"`
+	Parent     struct {
+		Type  string `json:"type"`
+		Chain string `json:"chain"`
+	} `json:"parent"`
+}
+
+// embeddedOnce loads the embedded snapshot once; the result is shared by every caller
+var embeddedOnce = sync.OnceValues(loadEmbedded)
+
+// Embedded returns the registry snapshot compiled into the operator. It is loaded on
+// first use and shared, so callers must not modify it.
+func Embedded() (*Registry, error) {
+	return embeddedOnce()
+}
+
+// loadEmbedded parses the embedded snapshot into a new registry
+func loadEmbedded() (*Registry, error) {
+	data, err := fs.Sub(embedded, "data")
+	if err != nil {
+		return nil, err
+	}
+	r := &Registry{chains: make(map[int64]*Chain)}
+	if err := r.load(data); err != nil {
+		return nil, fmt.Errorf("failed to load embedded superchain registry: %w", err)
+	}
+	if err := r.checkComplete(); err != nil {
+		return nil, fmt.Errorf("embedded superchain registry is incomplete: %w", err)
+	}
+	return r, nil
+}
+
+// checkComplete verifies that every chain resolves its addresses, rollup config and genesis offline
+func (r *Registry) checkComplete() error {
+	for _, chainID := range r.ChainIDs() {
+		chain := r.chains[chainID]
+		switch {
+		case chain.Identifier == "":
+			return fmt.Errorf("chain %d is missing from %s", chainID, ChainListFile)
+		case len(chain.Addresses) == 0:
+			return fmt.Errorf("chain %d has no entry in %s", chainID, AddressesFile)
+		case len(chain.RollupConfig) == 0:
+			return fmt.Errorf("chain %d has no %s%d.json", chainID, rollupPrefix, chainID)
+		case len(chain.Genesis) == 0 && (chain.GenesisRef == nil || chain.GenesisRef.OpGethNetwork == ""):
+			return fmt.Errorf("chain %d has neither %s%d.json nor an entry in %s", chainID, genesisPrefix, chainID, GenesisRefsFile)
+		}
+	}
+	return nil
+}
+
+// NewRegistry loads the embedded snapshot and, when overridePath is set,
+// overlays the files found in that directory. Overrides replace embedded
+// entries per chain ID and may add chains missing from the snapshot.
+func NewRegistry(overridePath string) (*Registry, error) {
+	if overridePath == "" {
+		return Embedded()
+	}
+	r, err := loadEmbedded()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(os.DirFS(overridePath)); err != nil {
+		return nil, fmt.Errorf("failed to load superchain registry override from %s: %w", overridePath, err)
+	}
+	return r, nil
+}
+
+// ChainByID returns the chain registered under the given chain ID
+func (r *Registry) ChainByID(chainID int64) (*Chain, bool) {
+	chain, ok := r.chains[chainID]
+	return chain, ok
+}
+
+// ChainIDs returns the registered chain IDs in ascending order
+func (r *Registry) ChainIDs() []int64 {
+	ids := make([]int64, 0, len(r.chains))
+	for id := range r.chains {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// load merges the registry files present in fsys. Every file is optional so
+// an override may carry only the pieces it replaces.
+func (r *Registry) load(fsys fs.FS) error {
+	if raw, err := fs.ReadFile(fsys, ChainListFile); err == nil {
+		var entries []chainListEntry
+		if err := json.Unmarshal(raw, &entries); err != nil {
+			return fmt.Errorf("invalid %s: %w", ChainListFile, err)
+		}
+		for _, entry := range entries {
+			if entry.ChainID <= 0 {
+				return fmt.Errorf("invalid %s: chain %q has no chainId", ChainListFile, entry.Name)
+			}
+			chain := r.chain(entry.ChainID)
+			chain.Name = entry.Name
+			chain.Identifier = entry.Identifier
+			chain.Superchain = entry.Parent.Chain
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if raw, err := fs.ReadFile(fsys, AddressesFile); err == nil {
+		var byChain map[string]map[string]string
+		if err := json.Unmarshal(raw, &byChain); err != nil {
+			return fmt.Errorf("invalid %s: %w", AddressesFile, err)
+		}
+		for key, addresses := range byChain {
+			chainID, err := strconv.ParseInt(key, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid %s: chain ID %q: %w", AddressesFile, key, err)
+			}
+			r.chain(chainID).Addresses = addresses
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if raw, err := fs.ReadFile(fsys, GenesisRefsFile); err == nil {
+		var byChain map[string]GenesisRef
+		if err := json.Unmarshal(raw, &byChain); err != nil {
+			return fmt.Errorf("invalid %s: %w", GenesisRefsFile, err)
+		}
+		for key, ref := range byChain {
+			chainID, err := strconv.ParseInt(key, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid %s: chain ID %q: %w", GenesisRefsFile, key, err)
+			}
+			r.chain(chainID).GenesisRef = &ref
+		}
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if err := r.loadPerChain(fsys, rollupPrefix, func(c *Chain, raw json.RawMessage) { c.RollupConfig = raw }); err != nil {
+		return err
+	}
+	return r.loadPerChain(fsys, genesisPrefix, func(c *Chain, raw json.RawMessage) { c.Genesis = raw })
+}
+
+// loadPerChain reads <prefix><chainID>.json files and hands each document to set
+func (r *Registry) loadPerChain(fsys fs.FS, prefix string, set func(*Chain, json.RawMessage)) error {
+	matches, err := fs.Glob(fsys, prefix+"*.json")
+	if err != nil {
+		return err
+	}
+	for _, name := range matches {
+		chainID, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(path.Base(name), prefix), ".json"), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unexpected registry file %s: %w", name, err)
+		}
+		raw, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		if !json.Valid(raw) {
+			return fmt.Errorf("registry file %s is not valid JSON", name)
+		}
+		set(r.chain(chainID), json.RawMessage(raw))
+	}
+	return nil
+}
+
+// chain returns the entry for chainID, creating it if needed
+func (r *Registry) chain(chainID int64) *Chain {
+	chain, ok := r.chains[chainID]
+	if !ok {
+		chain = &Chain{ChainID: chainID}
+		r.chains[chainID] = chain
+	}
+	return chain
+}
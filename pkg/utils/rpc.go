@@ -0,0 +1,20 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// IsExecutionReverted reports whether an eth_call failed because the contract reverted, for
+// example on a selector it does not implement. Timeouts, rate limits and transport errors are
+// not reverts. Execution clients answer a revert with a JSON-RPC error whose message starts
+// with "execution reverted".
+func IsExecutionReverted(err error) bool {
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	return strings.HasPrefix(strings.ToLower(rpcErr.Error()), "execution reverted")
+}
@@ -84,9 +84,6 @@ var _ = Describe("OpNode Integration", func() {
 					RollupConfig: &optimismv1alpha1.ConfigSource{
 						AutoDiscover: true,
 					},
-					L2Genesis: &optimismv1alpha1.ConfigSource{
-						AutoDiscover: true,
-					},
 					ContractAddresses: &optimismv1alpha1.ContractAddressConfig{
 						DiscoveryMethod: "well-known",
 						CacheTimeout:    24 * time.Hour,
@@ -250,10 +247,12 @@ var _ = Describe("OpNode Integration", func() {
 					OpGeth: optimismv1alpha1.OpGethConfig{
 						DataDir:  "/data/geth",
 						SyncMode: "snap",
-						Storage: &optimismv1alpha1.StorageConfig{
-							Size:         resource.MustParse("100Gi"),
-							StorageClass: "standard",
-							AccessMode:   "ReadWriteOnce",
+						Storage: &optimismv1alpha1.GethStorageConfig{
+							StorageConfig: optimismv1alpha1.StorageConfig{
+								Size:         resource.MustParse("100Gi"),
+								StorageClass: "standard",
+								AccessMode:   "ReadWriteOnce",
+							},
 						},
 						Networking: &optimismv1alpha1.GethNetworkingConfig{
 							HTTP: &optimismv1alpha1.HTTPConfig{
@@ -461,10 +460,12 @@ var _ = Describe("OpNode Integration", func() {
 					OpGeth: optimismv1alpha1.OpGethConfig{
 						DataDir:  "/data/geth",
 						SyncMode: "snap",
-						Storage: &optimismv1alpha1.StorageConfig{
-							Size:         resource.MustParse("500Gi"),
-							StorageClass: "fast-ssd",
-							AccessMode:   "ReadWriteOnce",
+						Storage: &optimismv1alpha1.GethStorageConfig{
+							StorageConfig: optimismv1alpha1.StorageConfig{
+								Size:         resource.MustParse("500Gi"),
+								StorageClass: "fast-ssd",
+								AccessMode:   "ReadWriteOnce",
+							},
 						},
 						Networking: &optimismv1alpha1.GethNetworkingConfig{
 							HTTP: &optimismv1alpha1.HTTPConfig{
@@ -143,9 +143,6 @@ var _ = Describe("OptimismNetwork Integration", func() {
 					RollupConfig: &optimismv1alpha1.ConfigSource{
 						AutoDiscover: true,
 					},
-					L2Genesis: &optimismv1alpha1.ConfigSource{
-						AutoDiscover: true,
-					},
 					ContractAddresses: &optimismv1alpha1.ContractAddressConfig{
 						DiscoveryMethod: "well-known",
 						CacheTimeout:    24 * time.Hour,
@@ -395,7 +392,7 @@ var _ = Describe("OptimismNetwork Integration", func() {
 		})
 
 		It("Should generate ConfigMaps for rollup config and genesis", func() {
-			By("Creating an OptimismNetwork with auto-discover configs")
+			By("Creating an OptimismNetwork with an auto-discovered rollup config and an inline genesis")
 			network := &optimismv1alpha1.OptimismNetwork{
 				TypeMeta: metav1.TypeMeta{
 					APIVersion: "optimism.optimism.io/v1alpha1",
@@ -414,7 +411,7 @@ var _ = Describe("OptimismNetwork Integration", func() {
 						AutoDiscover: true,
 					},
 					L2Genesis: &optimismv1alpha1.ConfigSource{
-						AutoDiscover: true,
+						Inline: `{"config": {"chainId": 11155420}}`,
 					},
 					ContractAddresses: &optimismv1alpha1.ContractAddressConfig{
 						DiscoveryMethod: "well-known",